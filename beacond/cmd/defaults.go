@@ -47,6 +47,10 @@ func DefaultComponents() []any {
 		components.ProvideBlockStore[
 			*BeaconBlock, *BeaconBlockBody, *BeaconBlockHeader, *Logger,
 		],
+		components.ProvideBlockReplayer[
+			*BeaconBlock, *BeaconState, *BeaconStateMarshallable,
+			*ExecutionPayload, *ExecutionPayloadHeader, *Logger, *StorageBackend,
+		],
 		components.ProvideBlockStoreService[
 			*BeaconBlock, *BeaconBlockBody, *BeaconBlockHeader,
 			*BlockStore, *Logger,
@@ -68,6 +72,9 @@ func DefaultComponents() []any {
 		components.ProvideNode,
 		components.ProvideChainSpec,
 		components.ProvideConfig,
+		components.ProvideConfigReloader[
+			*BlockStore, *LocalBuilder, *Logger, *ValidatorService,
+		],
 		components.ProvideServerConfig,
 		// components.ProvideConsensusEngine[
 		// 	*AvailabilityStore, *BeaconBlockHeader, *BeaconState,
@@ -104,6 +111,11 @@ func DefaultComponents() []any {
 			*BeaconBlockHeader, *BeaconState, *BeaconStateMarshallable,
 			*ExecutionPayload, *ExecutionPayloadHeader, *KVStore, *Logger,
 		],
+		components.ProvideOperationPool[
+			*BeaconBlock, *BeaconBlockBody, *BeaconBlockHeader, *BeaconState,
+			*Deposit, *ExecutionPayload, *Logger,
+		],
+		components.ProvidePayloadIDCache,
 		components.ProvideReportingService[*Logger],
 		components.ProvideCometBFTService[*Logger],
 		components.ProvideServiceRegistry[
@@ -119,6 +131,10 @@ func DefaultComponents() []any {
 		components.ProvideSidecarFactory[
 			*BeaconBlock, *BeaconBlockBody, *BeaconBlockHeader,
 		],
+		components.ProvideStateExporter[
+			*BeaconState, *BeaconStateMarshallable, *ExecutionPayloadHeader,
+			*Logger, *StorageBackend,
+		],
 		components.ProvideStateProcessor[
 			*Logger, *BeaconBlock, *BeaconBlockBody, *BeaconBlockHeader,
 			*BeaconState, *BeaconStateMarshallable, *Deposit, *ExecutionPayload,
@@ -145,12 +161,18 @@ func DefaultComponents() []any {
 	}
 	c = append(c,
 		components.ProvideNodeAPIServer[*Logger, NodeAPIContext],
-		components.ProvideNodeAPIEngine,
+		components.ProvideNodeAPIEngine[
+			*BeaconBlock, *BeaconBlockBody, *BeaconBlockHeader,
+		],
+		components.ProvideNodeAPIResponseCache[
+			*BeaconBlock, *BeaconBlockBody, *BeaconBlockHeader, *Logger,
+		],
 		components.ProvideNodeAPIBackend[
 			*AvailabilityStore, *BeaconBlock, *BeaconBlockBody,
 			*BeaconBlockHeader, *BlockStore, *BeaconState,
 			*BeaconStateMarshallable, *BlobSidecars, *Deposit, *DepositStore,
-			*ExecutionPayloadHeader, *KVStore, *CometBFTService, *StorageBackend,
+			*EngineClient, *ExecutionPayloadHeader, *KVStore, *CometBFTService,
+			*StorageBackend, *ValidatorService,
 		],
 	)
 
@@ -159,18 +181,37 @@ func DefaultComponents() []any {
 			*BeaconBlockHeader, *BeaconState, *BeaconStateMarshallable,
 			*ExecutionPayloadHeader, *KVStore, NodeAPIContext,
 		],
+		components.ProvideNodeAPIAdminHandler[
+			*AvailabilityStore,
+			*ConsensusBlock, *BeaconBlock, *BeaconBlockBody,
+			*BeaconBlockHeader, *BeaconState, *BeaconStateMarshallable,
+			*BlobSidecars, *Deposit, *ExecutionPayload, *ExecutionPayloadHeader,
+			*Genesis, *KVStore, *Logger, NodeAPIContext,
+		],
 		components.ProvideNodeAPIBeaconHandler[
 			*BeaconBlockHeader, *BeaconState, *CometBFTService, NodeAPIContext,
 		],
-		components.ProvideNodeAPIBuilderHandler[NodeAPIContext],
-		components.ProvideNodeAPIConfigHandler[NodeAPIContext],
-		components.ProvideNodeAPIDebugHandler[NodeAPIContext],
+		components.ProvideNodeAPIBuilderHandler[
+			*BeaconBlockHeader, *BeaconState, *CometBFTService, NodeAPIContext,
+		],
+		components.ProvideNodeAPIConfigHandler[
+			*BeaconBlockHeader, *BeaconState, *CometBFTService, NodeAPIContext,
+		],
+		components.ProvideNodeAPIDebugHandler[
+			*BeaconBlockHeader, *BeaconState, *BeaconStateMarshallable,
+			*ExecutionPayloadHeader, *KVStore, *CometBFTService, NodeAPIContext,
+		],
 		components.ProvideNodeAPIEventsHandler[NodeAPIContext],
-		components.ProvideNodeAPINodeHandler[NodeAPIContext],
+		components.ProvideNodeAPINodeHandler[
+			*BeaconBlockHeader, *BeaconState, *CometBFTService, NodeAPIContext,
+		],
 		components.ProvideNodeAPIProofHandler[
 			*BeaconBlockHeader, *BeaconState, *BeaconStateMarshallable,
 			*ExecutionPayloadHeader, *KVStore, *CometBFTService, NodeAPIContext,
 		],
+		components.ProvideNodeAPIValidatorHandler[
+			*BeaconBlockHeader, *BeaconState, *CometBFTService, NodeAPIContext,
+		],
 	)
 
 	return c
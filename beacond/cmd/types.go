@@ -23,6 +23,7 @@ package main
 import (
 	"cosmossdk.io/core/appmodule/v2"
 	"github.com/berachain/beacon-kit/mod/beacon/blockchain"
+	"github.com/berachain/beacon-kit/mod/beacon/pool"
 	"github.com/berachain/beacon-kit/mod/beacon/validator"
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
 	cometbft "github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service"
@@ -161,6 +162,16 @@ type (
 	// NodeAPIServer is a type alias for the node API server.
 	NodeAPIServer = server.Server[NodeAPIContext]
 
+	// OperationPool is a type alias for the operation pool.
+	OperationPool = pool.OperationPool[
+		*BeaconBlock,
+		*BeaconBlockBody,
+		*BeaconState,
+		*SignedBLSToExecutionChange,
+		*ProposerSlashing,
+		*SignedVoluntaryExit,
+	]
+
 	// ReportingService is a type alias for the reporting service.
 	ReportingService = version.ReportingService
 
@@ -334,6 +345,7 @@ type (
 		*BeaconStateMarshallable,
 		*BlobSidecars,
 		*BlockStore,
+		*SignedBLSToExecutionChange,
 		sdk.Context,
 		*Deposit,
 		*DepositStore,
@@ -341,10 +353,12 @@ type (
 		*ExecutionPayloadHeader,
 		*Fork,
 		*CometBFTService,
+		*ProposerSlashing,
 		*KVStore,
 		*StorageBackend,
 		*Validator,
 		Validators,
+		*SignedVoluntaryExit,
 		*Withdrawal,
 		WithdrawalCredentials,
 	]
@@ -358,6 +372,16 @@ type (
 	// PayloadID is a type alias for the payload ID.
 	PayloadID = engineprimitives.PayloadID
 
+	// ProposerSlashing is a type alias for the proposer slashing.
+	ProposerSlashing = types.ProposerSlashing
+
+	// SignedBLSToExecutionChange is a type alias for the signed BLS to
+	// execution change.
+	SignedBLSToExecutionChange = types.SignedBLSToExecutionChange
+
+	// SignedVoluntaryExit is a type alias for the signed voluntary exit.
+	SignedVoluntaryExit = types.SignedVoluntaryExit
+
 	// SlashingInfo is a type alias for the slashing info.
 	SlashingInfo = types.SlashingInfo
 
@@ -20,6 +20,8 @@ IPC_PATH = .tmp/eth-home/eth-engine.ipc
 HTTP_URL = localhost:8551
 IPC_PREFIX = ipc://
 HTTP_PREFIX = http://
+TESTNET_DIR = .tmp/testnet
+TESTNET_VALIDATORS ?= 4
 
 #################
 #    bartio     #
@@ -245,6 +247,16 @@ start-ethereumjs:
 	--rpc \
 	--rpcAddr 0.0.0.0
 
+start-testnet: ## start a local testnet of `beacond` and `geth` nodes with docker
+	rm -rf ${TESTNET_DIR} ${TESTNET_DIR}-home
+	@$(MAKE) build
+	CHAIN_SPEC=$(DEVNET_CHAIN_SPEC) ./build/bin/beacond testnet init \
+	--validators ${TESTNET_VALIDATORS} \
+	--output ${TESTNET_DIR} \
+	--eth-genesis ${ETH_GENESIS_PATH} \
+	--home ${TESTNET_DIR}-home
+	docker compose -f ${TESTNET_DIR}/docker-compose.yml up
+
 SHORT_FUZZ_TIME=10s
 MEDIUM_FUZZ_TIME=30s
 LONG_FUZZ_TIME=3m
@@ -276,6 +288,23 @@ test-unit-fuzz: ## run fuzz tests
 	go test ./mod/payload/pkg/cache/... -fuzz=FuzzPayloadIDCacheConcurrency -fuzztime=${SHORT_FUZZ_TIME}
 	go test -fuzz=FuzzHashTreeRoot ./mod/primitives/pkg/merkle -fuzztime=${MEDIUM_FUZZ_TIME}
 
+# The simulated execution backend links against go-ethereum internals that the
+# linker rejects by default.
+test-simulated: ## run tests against a simulated execution backend
+	@echo "Running simulated backend tests..."
+	go test -tags simulated -ldflags=-checklinkname=0 \
+		./mod/cli/pkg/commands/deposit/...
+
+# Extract the general and mainnet tarballs of a release of
+# https://github.com/ethereum/consensus-spec-tests into this directory.
+CONSENSUS_SPEC_TESTS_DIR ?= .tmp/consensus-spec-tests
+
+test-spec: ## run the consensus-spec-tests conformance runner
+	@echo "Running consensus-spec-tests conformance..."
+	@CONSENSUS_SPEC_TESTS_DIR=$(abspath ${CONSENSUS_SPEC_TESTS_DIR}) \
+		go test ./mod/state-transition/pkg/core/. \
+		-run ^TestSpecConformance$$ -v
+
 test-e2e: ## run e2e tests
 	@$(MAKE) build-docker VERSION=kurtosis-local test-e2e-no-build
 
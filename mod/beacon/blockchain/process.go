@@ -29,15 +29,22 @@ import (
 )
 
 // ProcessGenesisData processes the genesis state and initializes the beacon
-// state.
+// state, either from the full beacon state of a previous chain or from the
+// premined deposits.
 func (s *Service[
 	_, _, _, _, _, _, _, _, _, GenesisT, _,
 ]) ProcessGenesisData(
 	ctx context.Context,
 	genesisData GenesisT,
 ) (transition.ValidatorUpdates, error) {
+	st := s.storageBackend.StateFromContext(ctx)
+	if state := genesisData.GetState(); len(state) > 0 {
+		return s.stateProcessor.InitializeBeaconStateFromGenesisState(
+			st, state,
+		)
+	}
 	return s.stateProcessor.InitializePreminedBeaconStateFromEth1(
-		s.storageBackend.StateFromContext(ctx),
+		st,
 		genesisData.GetDeposits(),
 		genesisData.GetExecutionPayloadHeader(),
 		genesisData.GetForkVersion(),
@@ -130,6 +137,8 @@ func (s *Service[
 
 			ProposerAddress: blk.GetProposerAddress(),
 			ConsensusTime:   blk.GetConsensusTime(),
+			LastCommitVotes: blk.GetLastCommitVotes(),
+			Finalizing:      true,
 		},
 		st,
 		blk.GetBeaconBlock(),
@@ -170,5 +170,25 @@ func (s *Service[
 func (s *Service[
 	_, _, _, _, _, _, _, _, _, _, _,
 ]) shouldBuildOptimisticPayloads() bool {
-	return s.optimisticPayloadBuilds && s.localBuilder.Enabled()
+	return s.optimisticPayloadBuilds.Load() && s.localBuilder.Enabled()
+}
+
+// OptimisticPayloadBuilds returns whether optimistic payload builds are
+// currently enabled.
+func (s *Service[
+	_, _, _, _, _, _, _, _, _, _, _,
+]) OptimisticPayloadBuilds() bool {
+	return s.optimisticPayloadBuilds.Load()
+}
+
+// SetOptimisticPayloadBuilds enables or disables optimistic payload builds at
+// runtime.
+func (s *Service[
+	_, _, _, _, _, _, _, _, _, _, _,
+]) SetOptimisticPayloadBuilds(enabled bool) {
+	if s.optimisticPayloadBuilds.Swap(enabled) != enabled {
+		s.logger.Info(
+			"Optimistic payload builds toggled", "enabled", enabled,
+		)
+	}
 }
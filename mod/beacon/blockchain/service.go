@@ -23,6 +23,7 @@ package blockchain
 import (
 	"context"
 	"sync"
+	"sync/atomic"
 
 	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
 	"github.com/berachain/beacon-kit/mod/log"
@@ -76,8 +77,8 @@ type Service[
 	// metrics is the metrics for the service.
 	metrics *chainMetrics
 	// optimisticPayloadBuilds is a flag used when the optimistic payload
-	// builder is enabled.
-	optimisticPayloadBuilds bool
+	// builder is enabled. It may be toggled at runtime.
+	optimisticPayloadBuilds atomic.Bool
 	// forceStartupSyncOnce is used to force a sync of the startup head.
 	forceStartupSyncOnce *sync.Once
 
@@ -130,26 +131,27 @@ func NewService[
 	BeaconStateT, DepositT, ExecutionPayloadT, ExecutionPayloadHeaderT,
 	GenesisT, PayloadAttributesT,
 ] {
-	return &Service[
+	s := &Service[
 		AvailabilityStoreT,
 		ConsensusBlockT, BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
 		BeaconStateT, DepositT, ExecutionPayloadT, ExecutionPayloadHeaderT,
 		GenesisT, PayloadAttributesT,
 	]{
-		storageBackend:          storageBackend,
-		logger:                  logger,
-		chainSpec:               chainSpec,
-		dispatcher:              dispatcher,
-		executionEngine:         executionEngine,
-		localBuilder:            localBuilder,
-		stateProcessor:          stateProcessor,
-		metrics:                 newChainMetrics(telemetrySink),
-		optimisticPayloadBuilds: optimisticPayloadBuilds,
-		forceStartupSyncOnce:    new(sync.Once),
-		subFinalBlkReceived:     make(chan async.Event[ConsensusBlockT]),
-		subBlockReceived:        make(chan async.Event[ConsensusBlockT]),
-		subGenDataReceived:      make(chan async.Event[GenesisT]),
+		storageBackend:       storageBackend,
+		logger:               logger,
+		chainSpec:            chainSpec,
+		dispatcher:           dispatcher,
+		executionEngine:      executionEngine,
+		localBuilder:         localBuilder,
+		stateProcessor:       stateProcessor,
+		metrics:              newChainMetrics(telemetrySink),
+		forceStartupSyncOnce: new(sync.Once),
+		subFinalBlkReceived:  make(chan async.Event[ConsensusBlockT]),
+		subBlockReceived:     make(chan async.Event[ConsensusBlockT]),
+		subGenDataReceived:   make(chan async.Event[GenesisT]),
 	}
+	s.optimisticPayloadBuilds.Store(optimisticPayloadBuilds)
+	return s
 }
 
 // Name returns the name of the service.
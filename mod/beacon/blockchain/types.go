@@ -52,6 +52,10 @@ type ConsensusBlock[BeaconBlockT any] interface {
 	// GetConsensusTime returns the timestamp of current consensus request.
 	// It is used to build next payload and to validate currentpayload.
 	GetConsensusTime() math.U64
+
+	// GetLastCommitVotes returns the votes of the validators in the
+	// commit of the previous block. It is only set for finalized blocks.
+	GetLastCommitVotes() []transition.CommitVote
 }
 
 // BeaconBlock represents a beacon block interface.
@@ -125,6 +129,9 @@ type Genesis[DepositT any, ExecutionPayloadHeaderT any] interface {
 	GetDeposits() []DepositT
 	// GetExecutionPayloadHeader returns the execution payload header.
 	GetExecutionPayloadHeader() ExecutionPayloadHeaderT
+	// GetState returns the JSON encoded full beacon state the chain is
+	// restarted from, if any.
+	GetState() []byte
 }
 
 // LocalBuilder is the interface for the builder service.
@@ -199,6 +206,12 @@ type StateProcessor[
 		ExecutionPayloadHeaderT,
 		common.Version,
 	) (transition.ValidatorUpdates, error)
+	// InitializeBeaconStateFromGenesisState initializes the beacon state
+	// from the JSON encoded full beacon state of a previous chain.
+	InitializeBeaconStateFromGenesisState(
+		BeaconStateT,
+		[]byte,
+	) (transition.ValidatorUpdates, error)
 	// ProcessSlots processes the state transition for a range of slots.
 	ProcessSlots(
 		BeaconStateT, math.Slot,
@@ -0,0 +1,410 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package pool
+
+import (
+	"slices"
+	"sync"
+
+	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/async"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+)
+
+// OperationPool holds voluntary exits, proposer slashings and BLS to
+// execution changes received from the API until they are included in a
+// block or are no longer valid against the finalized state. Operations are
+// keyed by validator index, so the pool holds at most one operation of each
+// kind per validator. Submissions are rejected before the Electra fork.
+type OperationPool[
+	BeaconBlockT BeaconBlock[BeaconBlockBodyT],
+	BeaconBlockBodyT BeaconBlockBody[
+		BLSToExecutionChangeT, ProposerSlashingT, VoluntaryExitT,
+	],
+	BeaconStateT BeaconState,
+	BLSToExecutionChangeT Operation,
+	ProposerSlashingT Operation,
+	VoluntaryExitT Operation,
+] struct {
+	// logger is used for logging information and errors.
+	logger log.Logger
+	// chainSpec is the chain spec.
+	chainSpec common.ChainSpec
+	// validator validates operations against a beacon state.
+	validator OperationValidator[
+		BeaconStateT, BLSToExecutionChangeT, ProposerSlashingT, VoluntaryExitT,
+	]
+	// sb provides the post-state of the finalized blocks.
+	sb StorageBackend[BeaconStateT]
+	// dispatcher is the dispatcher for the service.
+	dispatcher asynctypes.EventDispatcher
+	// mu protects the pooled operations.
+	mu sync.RWMutex
+	// proposerSlashings holds the pooled proposer slashings.
+	proposerSlashings map[math.ValidatorIndex]ProposerSlashingT
+	// voluntaryExits holds the pooled voluntary exits.
+	voluntaryExits map[math.ValidatorIndex]VoluntaryExitT
+	// blsToExecutionChanges holds the pooled BLS to execution changes.
+	blsToExecutionChanges map[math.ValidatorIndex]BLSToExecutionChangeT
+	// subFinalizedBlkEvents is a channel holding BeaconBlockFinalized
+	// events.
+	subFinalizedBlkEvents chan async.Event[BeaconBlockT]
+}
+
+// NewOperationPool creates a new operation pool.
+func NewOperationPool[
+	BeaconBlockT BeaconBlock[BeaconBlockBodyT],
+	BeaconBlockBodyT BeaconBlockBody[
+		BLSToExecutionChangeT, ProposerSlashingT, VoluntaryExitT,
+	],
+	BeaconStateT BeaconState,
+	BLSToExecutionChangeT Operation,
+	ProposerSlashingT Operation,
+	VoluntaryExitT Operation,
+](
+	logger log.Logger,
+	chainSpec common.ChainSpec,
+	validator OperationValidator[
+		BeaconStateT, BLSToExecutionChangeT, ProposerSlashingT, VoluntaryExitT,
+	],
+	sb StorageBackend[BeaconStateT],
+	dispatcher asynctypes.EventDispatcher,
+) *OperationPool[
+	BeaconBlockT, BeaconBlockBodyT, BeaconStateT,
+	BLSToExecutionChangeT, ProposerSlashingT, VoluntaryExitT,
+] {
+	return &OperationPool[
+		BeaconBlockT, BeaconBlockBodyT, BeaconStateT,
+		BLSToExecutionChangeT, ProposerSlashingT, VoluntaryExitT,
+	]{
+		logger:     logger,
+		chainSpec:  chainSpec,
+		validator:  validator,
+		sb:         sb,
+		dispatcher: dispatcher,
+		proposerSlashings: make(
+			map[math.ValidatorIndex]ProposerSlashingT,
+		),
+		voluntaryExits: make(map[math.ValidatorIndex]VoluntaryExitT),
+		blsToExecutionChanges: make(
+			map[math.ValidatorIndex]BLSToExecutionChangeT,
+		),
+		subFinalizedBlkEvents: make(chan async.Event[BeaconBlockT]),
+	}
+}
+
+// AddProposerSlashing validates the proposer slashing against the given
+// state and adds it to the pool. A slashing for a proposer that already has
+// one pooled is ignored.
+func (p *OperationPool[
+	_, _, BeaconStateT, _, ProposerSlashingT, _,
+]) AddProposerSlashing(
+	st BeaconStateT,
+	slashing ProposerSlashingT,
+) error {
+	if err := p.checkFork(st); err != nil {
+		return err
+	}
+	if err := p.validator.ValidateProposerSlashing(st, slashing); err != nil {
+		return err
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	addOperation(p.proposerSlashings, slashing)
+	return nil
+}
+
+// AddVoluntaryExit validates the voluntary exit against the given state and
+// adds it to the pool. An exit for a validator that already has one pooled
+// is ignored.
+func (p *OperationPool[
+	_, _, BeaconStateT, _, _, VoluntaryExitT,
+]) AddVoluntaryExit(
+	st BeaconStateT,
+	exit VoluntaryExitT,
+) error {
+	if err := p.checkFork(st); err != nil {
+		return err
+	}
+	if err := p.validator.ValidateVoluntaryExit(st, exit); err != nil {
+		return err
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	addOperation(p.voluntaryExits, exit)
+	return nil
+}
+
+// AddBLSToExecutionChange validates the BLS to execution change against the
+// given state and adds it to the pool. A change for a validator that already
+// has one pooled is ignored.
+func (p *OperationPool[
+	_, _, BeaconStateT, BLSToExecutionChangeT, _, _,
+]) AddBLSToExecutionChange(
+	st BeaconStateT,
+	change BLSToExecutionChangeT,
+) error {
+	if err := p.checkFork(st); err != nil {
+		return err
+	}
+	if err := p.validator.ValidateBLSToExecutionChange(
+		st, change,
+	); err != nil {
+		return err
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	addOperation(p.blsToExecutionChanges, change)
+	return nil
+}
+
+// checkFork rejects operations until the block following the given state is
+// an Electra block, since blocks before Electra cannot include them.
+func (p *OperationPool[_, _, BeaconStateT, _, _, _]) checkFork(
+	st BeaconStateT,
+) error {
+	slot, err := st.GetSlot()
+	if err != nil {
+		return err
+	}
+	if p.chainSpec.ActiveForkVersionForSlot(slot+1) < version.Electra {
+		return ErrBeforeElectra
+	}
+	return nil
+}
+
+// ProposerSlashings returns the pooled proposer slashings, ordered by
+// validator index.
+func (p *OperationPool[
+	_, _, _, _, ProposerSlashingT, _,
+]) ProposerSlashings() []ProposerSlashingT {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return sortedOperations(p.proposerSlashings)
+}
+
+// VoluntaryExits returns the pooled voluntary exits, ordered by validator
+// index.
+func (p *OperationPool[
+	_, _, _, _, _, VoluntaryExitT,
+]) VoluntaryExits() []VoluntaryExitT {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return sortedOperations(p.voluntaryExits)
+}
+
+// BLSToExecutionChanges returns the pooled BLS to execution changes, ordered
+// by validator index.
+func (p *OperationPool[
+	_, _, _, BLSToExecutionChangeT, _, _,
+]) BLSToExecutionChanges() []BLSToExecutionChangeT {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return sortedOperations(p.blsToExecutionChanges)
+}
+
+// PackOperations sets the pooled operations that are valid against the
+// given state on the block body, up to the per-block maximums of the chain
+// spec. Operations that are no longer valid are evicted from the pool.
+func (p *OperationPool[
+	_, BeaconBlockBodyT, BeaconStateT, _, _, _,
+]) PackOperations(st BeaconStateT, body BeaconBlockBodyT) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.pack(st, body, true)
+}
+
+// PeekOperations sets the pooled operations that PackOperations would set
+// on the block body, without evicting the invalid ones from the pool.
+func (p *OperationPool[
+	_, BeaconBlockBodyT, BeaconStateT, _, _, _,
+]) PeekOperations(st BeaconStateT, body BeaconBlockBodyT) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	p.pack(st, body, false)
+}
+
+// pack sets the pooled operations that are valid against the given state
+// on the block body, evicting the invalid ones if evict is set. The caller
+// must hold the lock of the pool.
+func (p *OperationPool[
+	_, BeaconBlockBodyT, BeaconStateT,
+	BLSToExecutionChangeT, ProposerSlashingT, VoluntaryExitT,
+]) pack(st BeaconStateT, body BeaconBlockBodyT, evict bool) {
+	slashings := packOperations(
+		p.proposerSlashings,
+		p.chainSpec.MaxProposerSlashingsPerBlock(),
+		evict,
+		func(slashing ProposerSlashingT) error {
+			return p.validator.ValidateProposerSlashing(st, slashing)
+		},
+	)
+	body.SetProposerSlashings(slashings)
+
+	// A validator slashed in this block is already exiting, so an exit for
+	// it would make the block invalid. Such exits are kept in the pool and
+	// evicted once the slashing is finalized.
+	slashed := make(map[math.ValidatorIndex]struct{}, len(slashings))
+	for _, slashing := range slashings {
+		slashed[slashing.GetValidatorIndex()] = struct{}{}
+	}
+	exits := packOperations(
+		p.voluntaryExits,
+		p.chainSpec.MaxVoluntaryExitsPerBlock(),
+		evict,
+		func(exit VoluntaryExitT) error {
+			if _, ok := slashed[exit.GetValidatorIndex()]; ok {
+				return errSkipOperation
+			}
+			return p.validator.ValidateVoluntaryExit(st, exit)
+		},
+	)
+	body.SetVoluntaryExits(exits)
+
+	body.SetBlsToExecutionChanges(packOperations(
+		p.blsToExecutionChanges,
+		p.chainSpec.MaxBlsToExecutionChangesPerBlock(),
+		evict,
+		func(change BLSToExecutionChangeT) error {
+			return p.validator.ValidateBLSToExecutionChange(st, change)
+		},
+	))
+}
+
+// onFinalizeBlock evicts the operations included in the finalized block,
+// then revalidates the remaining ones against the post-state of the block
+// and evicts those that can no longer be applied, e.g. exits and slashings
+// for validators that the block slashed or exited.
+func (p *OperationPool[
+	BeaconBlockT, _, BeaconStateT,
+	BLSToExecutionChangeT, ProposerSlashingT, VoluntaryExitT,
+]) onFinalizeBlock(st BeaconStateT, blk BeaconBlockT) {
+	body := blk.GetBody()
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for _, change := range body.GetBlsToExecutionChanges() {
+		delete(p.blsToExecutionChanges, change.GetValidatorIndex())
+	}
+	for _, slashing := range body.GetProposerSlashings() {
+		delete(p.proposerSlashings, slashing.GetValidatorIndex())
+		delete(p.voluntaryExits, slashing.GetValidatorIndex())
+	}
+	for _, exit := range body.GetVoluntaryExits() {
+		delete(p.voluntaryExits, exit.GetValidatorIndex())
+		delete(p.proposerSlashings, exit.GetValidatorIndex())
+	}
+
+	pruneOperations(
+		p.proposerSlashings,
+		func(slashing ProposerSlashingT) error {
+			return p.validator.ValidateProposerSlashing(st, slashing)
+		},
+	)
+	pruneOperations(
+		p.voluntaryExits,
+		func(exit VoluntaryExitT) error {
+			return p.validator.ValidateVoluntaryExit(st, exit)
+		},
+	)
+	pruneOperations(
+		p.blsToExecutionChanges,
+		func(change BLSToExecutionChangeT) error {
+			return p.validator.ValidateBLSToExecutionChange(st, change)
+		},
+	)
+}
+
+// addOperation adds the operation to the pool unless the validator already
+// has one pooled.
+func addOperation[OperationT Operation](
+	ops map[math.ValidatorIndex]OperationT,
+	op OperationT,
+) {
+	if _, ok := ops[op.GetValidatorIndex()]; !ok {
+		ops[op.GetValidatorIndex()] = op
+	}
+}
+
+// sortedOperations returns the pooled operations ordered by validator index.
+func sortedOperations[OperationT Operation](
+	ops map[math.ValidatorIndex]OperationT,
+) []OperationT {
+	sorted := make([]OperationT, 0, len(ops))
+	for _, idx := range sortedIndices(ops) {
+		sorted = append(sorted, ops[idx])
+	}
+	return sorted
+}
+
+// packOperations returns up to limit pooled operations, in validator index
+// order, that pass the validate function. If evict is set, operations that
+// fail with any error other than errSkipOperation are evicted from the pool.
+func packOperations[OperationT Operation](
+	ops map[math.ValidatorIndex]OperationT,
+	limit uint64,
+	evict bool,
+	validate func(OperationT) error,
+) []OperationT {
+	packed := make([]OperationT, 0, min(limit, uint64(len(ops))))
+	for _, idx := range sortedIndices(ops) {
+		if uint64(len(packed)) == limit {
+			break
+		}
+		switch err := validate(ops[idx]); {
+		case err == nil:
+			packed = append(packed, ops[idx])
+		case evict && !errors.Is(err, errSkipOperation):
+			delete(ops, idx)
+		}
+	}
+	return packed
+}
+
+// pruneOperations evicts the pooled operations that fail the validate
+// function.
+func pruneOperations[OperationT Operation](
+	ops map[math.ValidatorIndex]OperationT,
+	validate func(OperationT) error,
+) {
+	for idx, op := range ops {
+		if validate(op) != nil {
+			delete(ops, idx)
+		}
+	}
+}
+
+// sortedIndices returns the validator indices of the pooled operations in
+// ascending order.
+func sortedIndices[OperationT Operation](
+	ops map[math.ValidatorIndex]OperationT,
+) []math.ValidatorIndex {
+	indices := make([]math.ValidatorIndex, 0, len(ops))
+	for idx := range ops {
+		indices = append(indices, idx)
+	}
+	slices.Sort(indices)
+	return indices
+}
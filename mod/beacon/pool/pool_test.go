@@ -0,0 +1,261 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package pool_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/async/pkg/dispatcher"
+	"github.com/berachain/beacon-kit/mod/beacon/pool"
+	"github.com/berachain/beacon-kit/mod/chain-spec/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/config/pkg/spec"
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/async"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+var errInvalidOperation = errors.New("invalid operation")
+
+type operation struct {
+	index math.ValidatorIndex
+	tag   string
+}
+
+func (o *operation) GetValidatorIndex() math.ValidatorIndex {
+	return o.index
+}
+
+type body struct {
+	slashings []*operation
+	exits     []*operation
+	changes   []*operation
+}
+
+func (b *body) GetProposerSlashings() []*operation  { return b.slashings }
+func (b *body) SetProposerSlashings(s []*operation) { b.slashings = s }
+func (b *body) GetVoluntaryExits() []*operation     { return b.exits }
+func (b *body) SetVoluntaryExits(e []*operation)    { b.exits = e }
+func (b *body) GetBlsToExecutionChanges() []*operation {
+	return b.changes
+}
+func (b *body) SetBlsToExecutionChanges(c []*operation) { b.changes = c }
+
+type block struct{ body *body }
+
+type state struct{ slot math.Slot }
+
+func (s *state) GetSlot() (math.Slot, error) { return s.slot, nil }
+
+// electraState is a state whose next block is an Electra block.
+//
+//nolint:gochecknoglobals // test only.
+var electraState = &state{slot: 31}
+
+func (b *block) GetBody() *body { return b.body }
+
+// storageBackend serves the same state for every context.
+type storageBackend struct{ st *state }
+
+func (sb *storageBackend) StateFromContext(context.Context) *state {
+	return sb.st
+}
+
+// validator rejects operations for the validator indices in invalid.
+type validator struct {
+	invalid map[math.ValidatorIndex]bool
+}
+
+func (v *validator) validate(op *operation) error {
+	if v.invalid[op.index] {
+		return errInvalidOperation
+	}
+	return nil
+}
+
+func (v *validator) ValidateProposerSlashing(_ *state, op *operation) error {
+	return v.validate(op)
+}
+
+func (v *validator) ValidateVoluntaryExit(_ *state, op *operation) error {
+	return v.validate(op)
+}
+
+func (v *validator) ValidateBLSToExecutionChange(
+	_ *state, op *operation,
+) error {
+	return v.validate(op)
+}
+
+// chainSpec returns a chain spec that activates Electra at slot 32.
+func chainSpec() common.ChainSpec {
+	data := spec.BaseSpec()
+	data.DenebPlusForkEpoch = 0
+	data.ElectraForkEpoch = 1
+	return chain.NewChainSpec(data)
+}
+
+func newPool(v *validator) *pool.OperationPool[
+	*block, *body, *state, *operation, *operation, *operation,
+] {
+	return pool.NewOperationPool[
+		*block, *body, *state, *operation, *operation, *operation,
+	](
+		noop.NewLogger[any](), chainSpec(), v,
+		&storageBackend{st: electraState}, nil,
+	)
+}
+
+func TestOperationPoolAdd(t *testing.T) {
+	v := &validator{invalid: map[math.ValidatorIndex]bool{7: true}}
+	p := newPool(v)
+	st := electraState
+
+	require.NoError(t, p.AddVoluntaryExit(st, &operation{index: 3, tag: "a"}))
+	require.NoError(t, p.AddVoluntaryExit(st, &operation{index: 1}))
+	// A second exit for the same validator is ignored.
+	require.NoError(t, p.AddVoluntaryExit(st, &operation{index: 3, tag: "b"}))
+	require.ErrorIs(
+		t,
+		p.AddVoluntaryExit(st, &operation{index: 7}),
+		errInvalidOperation,
+	)
+
+	exits := p.VoluntaryExits()
+	require.Len(t, exits, 2)
+	require.Equal(t, math.ValidatorIndex(1), exits[0].index)
+	require.Equal(t, math.ValidatorIndex(3), exits[1].index)
+	require.Equal(t, "a", exits[1].tag)
+}
+
+func TestOperationPoolAddBeforeElectra(t *testing.T) {
+	p := newPool(&validator{})
+	st := &state{slot: electraState.slot - 1}
+
+	require.ErrorIs(
+		t, p.AddVoluntaryExit(st, &operation{index: 1}), pool.ErrBeforeElectra,
+	)
+	require.ErrorIs(
+		t,
+		p.AddProposerSlashing(st, &operation{index: 1}),
+		pool.ErrBeforeElectra,
+	)
+	require.ErrorIs(
+		t,
+		p.AddBLSToExecutionChange(st, &operation{index: 1}),
+		pool.ErrBeforeElectra,
+	)
+	require.Empty(t, p.VoluntaryExits())
+	require.Empty(t, p.ProposerSlashings())
+	require.Empty(t, p.BLSToExecutionChanges())
+}
+
+func TestOperationPoolPackOperations(t *testing.T) {
+	v := &validator{invalid: map[math.ValidatorIndex]bool{}}
+	p := newPool(v)
+	st := electraState
+	cs := chainSpec()
+
+	numExits := cs.MaxVoluntaryExitsPerBlock() + 4
+	for i := range numExits {
+		require.NoError(t, p.AddVoluntaryExit(
+			st, &operation{index: math.ValidatorIndex(i)},
+		))
+	}
+	require.NoError(t, p.AddProposerSlashing(st, &operation{index: 2}))
+	require.NoError(t, p.AddBLSToExecutionChange(st, &operation{index: 9}))
+
+	// The exit for validator 5 is no longer valid when the block is built.
+	v.invalid[5] = true
+
+	// Peeking packs the same operations but keeps the invalid exit pooled.
+	peeked := &body{}
+	p.PeekOperations(st, peeked)
+	require.Len(t, peeked.exits, int(cs.MaxVoluntaryExitsPerBlock()))
+	require.Len(t, p.VoluntaryExits(), int(numExits))
+
+	b := &body{}
+	p.PackOperations(st, b)
+	require.Equal(t, peeked, b)
+	require.Len(t, b.slashings, 1)
+	require.Len(t, b.changes, 1)
+	require.Len(t, b.exits, int(cs.MaxVoluntaryExitsPerBlock()))
+	for _, exit := range b.exits {
+		// Validator 2 is slashed in the same block, so its exit is skipped.
+		require.NotEqual(t, math.ValidatorIndex(2), exit.index)
+		require.NotEqual(t, math.ValidatorIndex(5), exit.index)
+	}
+
+	// The invalid exit is evicted while the skipped one stays pooled.
+	exits := p.VoluntaryExits()
+	require.Len(t, exits, int(numExits)-1)
+	for _, exit := range exits {
+		require.NotEqual(t, math.ValidatorIndex(5), exit.index)
+	}
+}
+
+func TestOperationPoolFinalizeBlock(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	d, err := dispatcher.New(
+		noop.NewLogger[log.Logger](),
+		dispatcher.WithEvent[async.Event[*block]](async.BeaconBlockFinalized),
+	)
+	require.NoError(t, err)
+	require.NoError(t, d.Start(ctx))
+
+	v := &validator{invalid: map[math.ValidatorIndex]bool{}}
+	p := pool.NewOperationPool[
+		*block, *body, *state, *operation, *operation, *operation,
+	](
+		noop.NewLogger[any](), chainSpec(), v,
+		&storageBackend{st: electraState}, d,
+	)
+	require.NoError(t, p.Start(ctx))
+
+	st := electraState
+	for i := range math.ValidatorIndex(4) {
+		require.NoError(t, p.AddVoluntaryExit(st, &operation{index: i}))
+	}
+	require.NoError(t, p.AddProposerSlashing(st, &operation{index: 1}))
+	require.NoError(t, p.AddBLSToExecutionChange(st, &operation{index: 2}))
+
+	// The finalized block includes the exit of validator 0 and leaves the
+	// operations of validators 1 and 3 invalid.
+	v.invalid[1] = true
+	v.invalid[3] = true
+	require.NoError(t, d.Publish(async.NewEvent(
+		ctx, async.BeaconBlockFinalized,
+		&block{body: &body{exits: []*operation{{index: 0}}}},
+	)))
+
+	require.Eventually(t, func() bool {
+		return len(p.VoluntaryExits()) == 1
+	}, time.Second, 10*time.Millisecond)
+	require.Equal(t, math.ValidatorIndex(2), p.VoluntaryExits()[0].index)
+	require.Empty(t, p.ProposerSlashings())
+	require.Len(t, p.BLSToExecutionChanges(), 1)
+}
@@ -0,0 +1,59 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package pool
+
+import (
+	"context"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/async"
+)
+
+// Name returns the name of the service.
+func (p *OperationPool[_, _, _, _, _, _]) Name() string {
+	return "operation-pool"
+}
+
+// Start subscribes the operation pool to BeaconBlockFinalized events and
+// starts the main event loop to handle them.
+func (p *OperationPool[_, _, _, _, _, _]) Start(ctx context.Context) error {
+	if err := p.dispatcher.Subscribe(
+		async.BeaconBlockFinalized, p.subFinalizedBlkEvents,
+	); err != nil {
+		p.logger.Error("failed to subscribe to block events", "error", err)
+		return err
+	}
+	go p.eventLoop(ctx)
+	return nil
+}
+
+// eventLoop is the main event loop for the operation pool.
+func (p *OperationPool[_, _, _, _, _, _]) eventLoop(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event := <-p.subFinalizedBlkEvents:
+			p.onFinalizeBlock(
+				p.sb.StateFromContext(event.Context()), event.Data(),
+			)
+		}
+	}
+}
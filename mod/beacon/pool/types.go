@@ -0,0 +1,88 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package pool
+
+import (
+	"context"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// BeaconState represents the beacon state the operations are validated
+// against.
+type BeaconState interface {
+	// GetSlot returns the slot of the state.
+	GetSlot() (math.Slot, error)
+}
+
+// BeaconBlock represents a beacon block interface.
+type BeaconBlock[BeaconBlockBodyT any] interface {
+	// GetBody returns the body of the beacon block.
+	GetBody() BeaconBlockBodyT
+}
+
+// BeaconBlockBody represents a beacon block body interface.
+type BeaconBlockBody[
+	BLSToExecutionChangeT, ProposerSlashingT, VoluntaryExitT any,
+] interface {
+	// GetProposerSlashings returns the proposer slashings of the body.
+	GetProposerSlashings() []ProposerSlashingT
+	// SetProposerSlashings sets the proposer slashings of the body.
+	SetProposerSlashings([]ProposerSlashingT)
+	// GetVoluntaryExits returns the voluntary exits of the body.
+	GetVoluntaryExits() []VoluntaryExitT
+	// SetVoluntaryExits sets the voluntary exits of the body.
+	SetVoluntaryExits([]VoluntaryExitT)
+	// GetBlsToExecutionChanges returns the BLS to execution changes of the
+	// body.
+	GetBlsToExecutionChanges() []BLSToExecutionChangeT
+	// SetBlsToExecutionChanges sets the BLS to execution changes of the body.
+	SetBlsToExecutionChanges([]BLSToExecutionChangeT)
+}
+
+// Operation is the interface for an operation signed by a validator. There
+// is at most one pooled operation of each kind per validator.
+type Operation interface {
+	// GetValidatorIndex returns the index of the validator the operation
+	// applies to.
+	GetValidatorIndex() math.ValidatorIndex
+}
+
+// OperationValidator validates operations against a beacon state.
+type OperationValidator[
+	BeaconStateT, BLSToExecutionChangeT, ProposerSlashingT, VoluntaryExitT any,
+] interface {
+	// ValidateProposerSlashing checks that a proposer slashing can be
+	// applied to the state.
+	ValidateProposerSlashing(BeaconStateT, ProposerSlashingT) error
+	// ValidateVoluntaryExit checks that a voluntary exit can be applied to
+	// the state.
+	ValidateVoluntaryExit(BeaconStateT, VoluntaryExitT) error
+	// ValidateBLSToExecutionChange checks that a BLS to execution change can
+	// be applied to the state.
+	ValidateBLSToExecutionChange(BeaconStateT, BLSToExecutionChangeT) error
+}
+
+// StorageBackend provides the beacon state of a context.
+type StorageBackend[BeaconStateT any] interface {
+	// StateFromContext retrieves the beacon state from the given context.
+	StateFromContext(context.Context) BeaconStateT
+}
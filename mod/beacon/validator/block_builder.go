@@ -47,7 +47,6 @@ func (s *Service[
 		blk       BeaconBlockT
 		sidecars  BlobSidecarsT
 		startTime = time.Now()
-		g, _      = errgroup.WithContext(ctx)
 	)
 
 	defer s.metrics.measureRequestBlockForProposalTime(startTime)
@@ -59,44 +58,90 @@ func (s *Service[
 	// and safe block hashes to the execution client.
 	st := s.sb.StateFromContext(ctx)
 
+	proposal, err := s.buildProposal(ctx, st, slotData, nil, false)
+	if err != nil {
+		return blk, sidecars, err
+	}
+
+	s.logger.Info(
+		"Beacon block successfully built",
+		"slot", slotData.GetSlot().Base10(),
+		"state_root", proposal.Block.GetStateRoot(),
+		"duration", time.Since(startTime).String(),
+	)
+
+	return proposal.Block, proposal.Sidecars, nil
+}
+
+// buildProposal builds a block and its sidecars for the slot of the slot
+// data on top of the given state, which it processes up to that slot. The
+// randao reveal is built with the signer unless one is given. A dry run
+// keeps its payload ID apart from the ones of proposed payloads, and leaves
+// the operation pool untouched.
+func (s *Service[
+	AttestationDataT, BeaconBlockT, _, BeaconStateT, BlobSidecarsT, _, _, _, _,
+	_, _, SlashingInfoT, _,
+]) buildProposal(
+	ctx context.Context,
+	st BeaconStateT,
+	slotData SlotData[AttestationDataT, SlashingInfoT],
+	reveal *crypto.BLSSignature,
+	dryRun bool,
+) (*transition.Proposal[BeaconBlockT, BlobSidecarsT], error) {
+	var (
+		proposal  = &transition.Proposal[BeaconBlockT, BlobSidecarsT]{}
+		startTime = time.Now()
+		g, _      = errgroup.WithContext(ctx)
+	)
+
 	// Prepare the state such that it is ready to build a block for
 	// the requested slot
 	if _, err := s.stateProcessor.ProcessSlots(
 		st,
 		slotData.GetSlot(),
 	); err != nil {
-		return blk, sidecars, err
+		return nil, err
 	}
+	proposal.Timings.ProcessSlots = time.Since(startTime)
 
 	// Build the reveal for the current slot.
 	// TODO: We can optimize to pre-compute this in parallel?
-	reveal, err := s.buildRandaoReveal(st, slotData.GetSlot())
-	if err != nil {
-		return blk, sidecars, err
+	if reveal == nil {
+		signed, err := s.buildRandaoReveal(st, slotData.GetSlot())
+		if err != nil {
+			return nil, err
+		}
+		reveal = &signed
 	}
 
 	// Create a new empty block from the current state.
-	blk, err = s.getEmptyBeaconBlockForSlot(st, slotData.GetSlot())
+	blk, err := s.getEmptyBeaconBlockForSlot(st, slotData.GetSlot())
 	if err != nil {
-		return blk, sidecars, err
+		return nil, err
 	}
 
 	// Get the payload for the block.
-	envelope, err := s.retrieveExecutionPayload(ctx, st, blk, slotData)
+	stepTime := time.Now()
+	envelope, err := s.retrieveExecutionPayload(
+		ctx, st, blk, slotData, dryRun,
+	)
 	if err != nil {
-		return blk, sidecars, err
+		return nil, err
 	}
 	if envelope == nil {
-		return blk, sidecars, ErrNilPayload
+		return nil, ErrNilPayload
 	}
+	proposal.Timings.Payload = time.Since(stepTime)
 
 	// We have to assemble the block body prior to producing the sidecars
 	// since we need to generate the inclusion proofs.
+	stepTime = time.Now()
 	if err = s.buildBlockBody(
-		ctx, st, blk, reveal, envelope, slotData,
+		ctx, st, blk, *reveal, envelope, slotData, dryRun,
 	); err != nil {
-		return blk, sidecars, err
+		return nil, err
 	}
+	proposal.Timings.Body = time.Since(stepTime)
 
 	// Produce blob sidecars, we produce them in parallel to computing the state
 	// root as an optimization.
@@ -104,37 +149,38 @@ func (s *Service[
 	// TODO: Figure out a clean way to break "BlockAndSidecars" into two
 	// functions
 	// without giving up the parallelization benefits.
+	stepTime = time.Now()
 	g.Go(func() error {
-		sidecars, err = s.blobFactory.BuildSidecars(
+		var sidecarsErr error
+		proposal.Sidecars, sidecarsErr = s.blobFactory.BuildSidecars(
 			blk, envelope.GetBlobsBundle(),
 		)
-		return err
+		proposal.Timings.Sidecars = time.Since(stepTime)
+		return sidecarsErr
 	})
 
 	// Compute the state root for the block.
 	g.Go(func() error {
-		return s.computeAndSetStateRoot(
+		stateRootErr := s.computeAndSetStateRoot(
 			ctx,
 			slotData.GetProposerAddress(),
 			slotData.GetConsensusTime(),
 			st,
 			blk,
 		)
+		proposal.Timings.StateRoot = time.Since(stepTime)
+		return stateRootErr
 	})
 
 	// Wait for all the goroutines to finish.
 	if err = g.Wait(); err != nil {
-		return blk, sidecars, err
+		return nil, err
 	}
 
-	s.logger.Info(
-		"Beacon block successfully built",
-		"slot", slotData.GetSlot().Base10(),
-		"state_root", blk.GetStateRoot(),
-		"duration", time.Since(startTime).String(),
-	)
-
-	return blk, sidecars, nil
+	proposal.Block = blk
+	proposal.PayloadValue = envelope.GetValue()
+	proposal.Timings.Total = time.Since(startTime)
+	return proposal, nil
 }
 
 // getEmptyBeaconBlockForSlot creates a new empty block.
@@ -199,51 +245,56 @@ func (s *Service[
 
 // retrieveExecutionPayload retrieves the execution payload for the block.
 func (s *Service[
-	_, BeaconBlockT, _, BeaconStateT, _, _, _, _,
-	ExecutionPayloadT, ExecutionPayloadHeaderT, _, _, SlotDataT,
+	AttestationDataT, BeaconBlockT, _, BeaconStateT, _, _, _, _,
+	ExecutionPayloadT, ExecutionPayloadHeaderT, _, SlashingInfoT, _,
 ]) retrieveExecutionPayload(
 	ctx context.Context,
 	st BeaconStateT,
 	blk BeaconBlockT,
-	slotData SlotDataT,
+	slotData SlotData[AttestationDataT, SlashingInfoT],
+	dryRun bool,
 ) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error) {
-	//
-	// TODO: Add external block builders to this flow.
-	//
-	// Get the payload for the block.
-	envelope, err := s.localPayloadBuilder.
-		RetrievePayload(
-			ctx,
+	requestPayload := s.localPayloadBuilder.RequestPayloadDryRun
+	if !dryRun {
+		//
+		// TODO: Add external block builders to this flow.
+		//
+		// Get the payload for the block.
+		envelope, err := s.localPayloadBuilder.
+			RetrievePayload(
+				ctx,
+				blk.GetSlot(),
+				blk.GetParentBlockRoot(),
+			)
+		if err == nil {
+			return envelope, nil
+		}
+
+		// If we failed to retrieve the payload, request a synchronous
+		// payload.
+		//
+		// NOTE: The state here is properly configured by the
+		// prepareStateForBuilding
+		//
+		// call that needs to be called before requesting the Payload.
+		// TODO: We should decouple the PayloadBuilder from BeaconState to
+		// make this less confusing.
+
+		s.metrics.failedToRetrievePayload(
 			blk.GetSlot(),
-			blk.GetParentBlockRoot(),
+			err,
 		)
-	if err == nil {
-		return envelope, nil
+		requestPayload = s.localPayloadBuilder.RequestPayloadSync
 	}
 
-	// If we failed to retrieve the payload, request a synchronous payload.
-	//
-	// NOTE: The state here is properly configured by the
-	// prepareStateForBuilding
-	//
-	// call that needs to be called before requesting the Payload.
-	// TODO: We should decouple the PayloadBuilder from BeaconState to make
-	// this less confusing.
-
-	s.metrics.failedToRetrievePayload(
-		blk.GetSlot(),
-		err,
-	)
-
 	// The latest execution payload header will be from the previous block
 	// during the block building phase.
-	var lph ExecutionPayloadHeaderT
-	lph, err = st.GetLatestExecutionPayloadHeader()
+	lph, err := st.GetLatestExecutionPayloadHeader()
 	if err != nil {
 		return nil, err
 	}
 
-	return s.localPayloadBuilder.RequestPayloadSync(
+	return requestPayload(
 		ctx,
 		st,
 		blk.GetSlot(),
@@ -260,15 +311,16 @@ func (s *Service[
 
 // BuildBlockBody assembles the block body with necessary components.
 func (s *Service[
-	_, BeaconBlockT, _, BeaconStateT, _, _, _, Eth1DataT, ExecutionPayloadT, _,
-	_, _, SlotDataT,
+	AttestationDataT, BeaconBlockT, _, BeaconStateT, _, _, _, Eth1DataT,
+	ExecutionPayloadT, _, _, SlashingInfoT, _,
 ]) buildBlockBody(
 	_ context.Context,
 	st BeaconStateT,
 	blk BeaconBlockT,
 	reveal crypto.BLSSignature,
 	envelope engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT],
-	slotData SlotDataT,
+	slotData SlotData[AttestationDataT, SlashingInfoT],
+	dryRun bool,
 ) error {
 	// Assemble a new block with the payload.
 	body := blk.GetBody()
@@ -314,7 +366,9 @@ func (s *Service[
 	))
 
 	// Set the graffiti on the block body.
-	sizedGraffiti := bytes.ExtendToSize([]byte(s.cfg.Graffiti), bytes.B32Size)
+	sizedGraffiti := bytes.ExtendToSize(
+		[]byte(*s.graffiti.Load()), bytes.B32Size,
+	)
 	graffiti, err := bytes.ToBytes32(sizedGraffiti)
 	if err != nil {
 		return fmt.Errorf("failed processing graffiti: %w", err)
@@ -334,6 +388,23 @@ func (s *Service[
 		body.SetSlashingInfo(slotData.GetSlashingInfo())
 	}
 
+	if activeForkVersion >= version.Electra {
+		// Set the pooled operations on the block body. A dry run leaves the
+		// pool untouched.
+		if dryRun {
+			s.operationPool.PeekOperations(st, body)
+		} else {
+			s.operationPool.PackOperations(st, body)
+		}
+
+		// Set the requests triggered by the payload on the block body.
+		if err = body.SetEncodedExecutionRequests(
+			envelope.GetExecutionRequests(),
+		); err != nil {
+			return err
+		}
+	}
+
 	body.SetExecutionPayload(envelope.GetExecutionPayload())
 	return nil
 }
@@ -20,6 +20,12 @@
 
 package validator
 
+import (
+	"fmt"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+)
+
 const (
 	// defaultGraffiti is the default graffiti string.
 	defaultGraffiti = ""
@@ -48,3 +54,15 @@ func DefaultConfig() Config {
 		EnableOptimisticPayloadBuilds: defaultEnableOptimisticPayloadBuilds,
 	}
 }
+
+// Validate returns an error describing every invalid field of the
+// configuration.
+func (c Config) Validate() error {
+	if len(c.Graffiti) > bytes.B32Size {
+		return fmt.Errorf(
+			"graffiti must be at most %d bytes, got %d bytes",
+			bytes.B32Size, len(c.Graffiti),
+		)
+	}
+	return nil
+}
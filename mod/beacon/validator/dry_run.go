@@ -0,0 +1,97 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validator
+
+import (
+	"context"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+)
+
+// DryRunBlockAndSidecars builds the block and sidecars this node would
+// propose at the given slot on top of the given state, which it processes
+// up to that slot, without publishing them. The randao reveal is built with
+// the signer unless one is given. The payload ID is kept apart from the
+// proposed ones, the pooled operations are not evicted, and the block
+// carries no attestations or slashing info since those are supplied by
+// consensus.
+func (s *Service[
+	AttestationDataT, BeaconBlockT, _, BeaconStateT, BlobSidecarsT, _, _, _, _,
+	_, _, SlashingInfoT, _,
+]) DryRunBlockAndSidecars(
+	ctx context.Context,
+	st BeaconStateT,
+	slot math.Slot,
+	reveal *crypto.BLSSignature,
+) (*transition.Proposal[BeaconBlockT, BlobSidecarsT], error) {
+	proposerAddress, err := crypto.GetAddressFromPubKey(s.signer.PublicKey())
+	if err != nil {
+		return nil, err
+	}
+	return s.buildProposal(
+		ctx,
+		st,
+		&dryRunSlotData[AttestationDataT, SlashingInfoT]{
+			slot:            slot,
+			proposerAddress: proposerAddress,
+			consensusTime:   math.U64(time.Now().Unix()),
+		},
+		reveal,
+		true,
+	)
+}
+
+// dryRunSlotData is the slot data of a block built by a dry run.
+type dryRunSlotData[AttestationDataT, SlashingInfoT any] struct {
+	slot            math.Slot
+	proposerAddress []byte
+	consensusTime   math.U64
+}
+
+// GetSlot returns the slot of the block.
+func (d *dryRunSlotData[_, _]) GetSlot() math.Slot {
+	return d.slot
+}
+
+// GetAttestationData returns no attestation data.
+func (d *dryRunSlotData[
+	AttestationDataT, _,
+]) GetAttestationData() []AttestationDataT {
+	return nil
+}
+
+// GetSlashingInfo returns no slashing info.
+func (d *dryRunSlotData[_, SlashingInfoT]) GetSlashingInfo() []SlashingInfoT {
+	return nil
+}
+
+// GetProposerAddress returns the consensus address of this node.
+func (d *dryRunSlotData[_, _]) GetProposerAddress() []byte {
+	return d.proposerAddress
+}
+
+// GetConsensusTime returns the time of the dry run.
+func (d *dryRunSlotData[_, _]) GetConsensusTime() math.U64 {
+	return d.consensusTime
+}
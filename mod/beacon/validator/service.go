@@ -22,6 +22,7 @@ package validator
 
 import (
 	"context"
+	"sync/atomic"
 
 	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
 	"github.com/berachain/beacon-kit/mod/log"
@@ -51,6 +52,9 @@ type Service[
 ] struct {
 	// cfg is the validator config.
 	cfg *Config
+	// graffiti is the graffiti included in built blocks, it may be updated
+	// at runtime.
+	graffiti atomic.Pointer[string]
 	// logger is a logger.
 	logger log.Logger
 	// chainSpec is the chain spec.
@@ -78,6 +82,8 @@ type Service[
 	// remotePayloadBuilders represents a list of remote block builders, these
 	// builders are connected to other execution clients via the EngineAPI.
 	remotePayloadBuilders []PayloadBuilder[BeaconStateT, ExecutionPayloadT]
+	// operationPool provides the operations to include in built blocks.
+	operationPool OperationPool[BeaconStateT, BeaconBlockBodyT]
 	// metrics is a metrics collector.
 	metrics *validatorMetrics
 	// subNewSlot is a channel to hold NewSlot events.
@@ -116,6 +122,7 @@ func NewService[
 	blobFactory BlobFactory[BeaconBlockT, BlobSidecarsT],
 	localPayloadBuilder PayloadBuilder[BeaconStateT, ExecutionPayloadT],
 	remotePayloadBuilders []PayloadBuilder[BeaconStateT, ExecutionPayloadT],
+	operationPool OperationPool[BeaconStateT, BeaconBlockBodyT],
 	ts TelemetrySink,
 	dispatcher asynctypes.EventDispatcher,
 ) *Service[
@@ -123,7 +130,7 @@ func NewService[
 	BlobSidecarsT, DepositT, DepositStoreT, Eth1DataT, ExecutionPayloadT,
 	ExecutionPayloadHeaderT, ForkDataT, SlashingInfoT, SlotDataT,
 ] {
-	return &Service[
+	s := &Service[
 		AttestationDataT, BeaconBlockT, BeaconBlockBodyT,
 		BeaconStateT, BlobSidecarsT, DepositT, DepositStoreT, Eth1DataT,
 		ExecutionPayloadT, ExecutionPayloadHeaderT, ForkDataT, SlashingInfoT,
@@ -138,10 +145,13 @@ func NewService[
 		blobFactory:           blobFactory,
 		localPayloadBuilder:   localPayloadBuilder,
 		remotePayloadBuilders: remotePayloadBuilders,
+		operationPool:         operationPool,
 		metrics:               newValidatorMetrics(ts),
 		dispatcher:            dispatcher,
 		subNewSlot:            make(chan async.Event[SlotDataT]),
 	}
+	s.graffiti.Store(&cfg.Graffiti)
+	return s
 }
 
 // Name returns the name of the service.
@@ -151,6 +161,15 @@ func (s *Service[
 	return "validator"
 }
 
+// SetGraffiti sets the graffiti included in blocks built from now on.
+func (s *Service[
+	_, _, _, _, _, _, _, _, _, _, _, _, _,
+]) SetGraffiti(graffiti string) {
+	if *s.graffiti.Swap(&graffiti) != graffiti {
+		s.logger.Info("Graffiti updated", "graffiti", graffiti)
+	}
+}
+
 // Start listens for NewSlot events and builds a block and sidecars for the
 // requested slot data.
 func (s *Service[
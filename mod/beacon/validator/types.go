@@ -25,6 +25,7 @@ import (
 	"time"
 
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
@@ -81,6 +82,9 @@ type BeaconBlockBody[
 	// SetBlobKzgCommitments sets the blob KZG commitments of the beacon block
 	// body.
 	SetBlobKzgCommitments(eip4844.KZGCommitments[common.ExecutionHash])
+	// SetEncodedExecutionRequests sets the execution requests of the beacon
+	// block body from their encoding in the engine API.
+	SetEncodedExecutionRequests([]bytes.Bytes) error
 }
 
 // BeaconState represents a beacon state interface.
@@ -160,6 +164,16 @@ type ForkData[T any] interface {
 	) common.Root
 }
 
+// OperationPool represents the pool of operations to include in blocks.
+type OperationPool[BeaconStateT, BeaconBlockBodyT any] interface {
+	// PackOperations sets the pooled operations that are valid against the
+	// given state on the block body.
+	PackOperations(BeaconStateT, BeaconBlockBodyT)
+	// PeekOperations sets the same operations as PackOperations without
+	// evicting the invalid ones from the pool.
+	PeekOperations(BeaconStateT, BeaconBlockBodyT)
+}
+
 // PayloadBuilder represents a service that is responsible for
 // building eth1 blocks.
 type PayloadBuilder[BeaconStateT, ExecutionPayloadT any] interface {
@@ -180,6 +194,18 @@ type PayloadBuilder[BeaconStateT, ExecutionPayloadT any] interface {
 		headEth1BlockHash common.ExecutionHash,
 		finalEth1BlockHash common.ExecutionHash,
 	) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error)
+	// RequestPayloadDryRun requests a payload for the given slot and
+	// blocks until the payload is delivered, keeping its ID apart from the
+	// ones of proposed payloads.
+	RequestPayloadDryRun(
+		ctx context.Context,
+		st BeaconStateT,
+		slot math.Slot,
+		timestamp uint64,
+		parentBlockRoot common.Root,
+		headEth1BlockHash common.ExecutionHash,
+		finalEth1BlockHash common.ExecutionHash,
+	) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error)
 }
 
 // SlotData represents the slot data interface.
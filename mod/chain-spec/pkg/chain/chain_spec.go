@@ -45,6 +45,18 @@ type Spec[
 	// calculations.
 	EffectiveBalanceIncrement() uint64
 
+	// HysteresisQuotient returns the quotient used to derive the hysteresis
+	// increment from the effective balance increment.
+	HysteresisQuotient() uint64
+
+	// HysteresisDownwardMultiplier returns the number of hysteresis
+	// increments below the effective balance at which it is lowered.
+	HysteresisDownwardMultiplier() uint64
+
+	// HysteresisUpwardMultiplier returns the number of hysteresis increments
+	// above the effective balance at which it is raised.
+	HysteresisUpwardMultiplier() uint64
+
 	// Time parameters constants.
 
 	// SlotsPerEpoch returns the number of slots in an epoch.
@@ -83,6 +95,10 @@ type Spec[
 	// DomainTypeApplicationMask returns the domain for application signatures.
 	DomainTypeApplicationMask() DomainTypeT
 
+	// DomainTypeBLSToExecutionChange returns the domain for BLS to execution
+	// change signatures.
+	DomainTypeBLSToExecutionChange() DomainTypeT
+
 	// Eth1-related values.
 
 	// DepositContractAddress returns the deposit contract address.
@@ -92,6 +108,18 @@ type Spec[
 	// block.
 	MaxDepositsPerBlock() uint64
 
+	// MaxProposerSlashingsPerBlock returns the maximum number of proposer
+	// slashings per block.
+	MaxProposerSlashingsPerBlock() uint64
+
+	// MaxVoluntaryExitsPerBlock returns the maximum number of voluntary exits
+	// per block.
+	MaxVoluntaryExitsPerBlock() uint64
+
+	// MaxBlsToExecutionChangesPerBlock returns the maximum number of BLS to
+	// execution changes per block.
+	MaxBlsToExecutionChangesPerBlock() uint64
+
 	// DepositEth1ChainID returns the chain ID of the deposit contract.
 	DepositEth1ChainID() uint64
 
@@ -125,8 +153,44 @@ type Spec[
 	// registry.
 	ValidatorRegistryLimit() uint64
 
+	// ValidatorSetCap returns the maximum number of validators in the
+	// validator set sent to CometBFT.
+	ValidatorSetCap() uint64
+
+	// MinPerEpochChurnLimit returns the minimum effective balance that may be
+	// activated per epoch.
+	MinPerEpochChurnLimit() uint64
+
+	// MaxPerEpochActivationChurnLimit returns the maximum effective balance
+	// that may be activated per epoch.
+	MaxPerEpochActivationChurnLimit() uint64
+
+	// ChurnLimitQuotient returns the quotient of the total active balance
+	// that may be activated per epoch.
+	ChurnLimitQuotient() uint64
+
+	// ActivationQueueEpoch returns the epoch from which validators are
+	// activated through the activation queue.
+	ActivationQueueEpoch() EpochT
+
+	// MinValidatorWithdrawabilityDelay returns the number of epochs after its
+	// exit before a validator's balance can be withdrawn.
+	MinValidatorWithdrawabilityDelay() uint64
+
+	// ShardCommitteePeriod returns the number of epochs a validator must have
+	// been active for before it may exit voluntarily.
+	ShardCommitteePeriod() uint64
+
 	// Rewards and Penalties
 
+	// BaseRewardFactor returns the factor used to derive the base reward of
+	// a validator from its effective balance.
+	BaseRewardFactor() uint64
+
+	// ProposerRewardQuotient returns the quotient of the base reward paid to
+	// the proposer of a block.
+	ProposerRewardQuotient() uint64
+
 	// InactivityPenaltyQuotient returns the inactivity penalty quotient.
 	InactivityPenaltyQuotient() uint64
 
@@ -134,6 +198,10 @@ type Spec[
 	// slashing penalties.
 	ProportionalSlashingMultiplier() uint64
 
+	// MinSlashingPenaltyQuotient returns the quotient of the effective
+	// balance immediately taken from a slashed validator.
+	MinSlashingPenaltyQuotient() uint64
+
 	// Capella Values
 
 	// MaxWithdrawalsPerPayload returns the maximum number of withdrawals per
@@ -245,6 +313,30 @@ func (c chainSpec[
 	return c.Data.EffectiveBalanceIncrement
 }
 
+// HysteresisQuotient returns the quotient used to derive the hysteresis
+// increment from the effective balance increment.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) HysteresisQuotient() uint64 {
+	return c.Data.HysteresisQuotient
+}
+
+// HysteresisDownwardMultiplier returns the number of hysteresis increments
+// below the effective balance at which it is lowered.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) HysteresisDownwardMultiplier() uint64 {
+	return c.Data.HysteresisDownwardMultiplier
+}
+
+// HysteresisUpwardMultiplier returns the number of hysteresis increments
+// above the effective balance at which it is raised.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) HysteresisUpwardMultiplier() uint64 {
+	return c.Data.HysteresisUpwardMultiplier
+}
+
 // SlotsPerEpoch returns the number of slots per epoch.
 func (c chainSpec[
 	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
@@ -324,6 +416,14 @@ func (c chainSpec[
 	return c.Data.DomainTypeApplicationMask
 }
 
+// DomainTypeBLSToExecutionChange returns the domain for BLS to execution
+// change signatures.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) DomainTypeBLSToExecutionChange() DomainTypeT {
+	return c.Data.DomainTypeBLSToExecutionChange
+}
+
 // DepositContractAddress returns the address of the deposit contract.
 func (c chainSpec[
 	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
@@ -338,6 +438,30 @@ func (c chainSpec[
 	return c.Data.MaxDepositsPerBlock
 }
 
+// MaxProposerSlashingsPerBlock returns the maximum number of proposer
+// slashings per block.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) MaxProposerSlashingsPerBlock() uint64 {
+	return c.Data.MaxProposerSlashingsPerBlock
+}
+
+// MaxVoluntaryExitsPerBlock returns the maximum number of voluntary exits per
+// block.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) MaxVoluntaryExitsPerBlock() uint64 {
+	return c.Data.MaxVoluntaryExitsPerBlock
+}
+
+// MaxBlsToExecutionChangesPerBlock returns the maximum number of BLS to
+// execution changes per block.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) MaxBlsToExecutionChangesPerBlock() uint64 {
+	return c.Data.MaxBlsToExecutionChangesPerBlock
+}
+
 // DepositEth1ChainID returns the chain ID of the execution chain.
 func (c chainSpec[
 	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
@@ -402,6 +526,78 @@ func (c chainSpec[
 	return c.Data.ValidatorRegistryLimit
 }
 
+// ValidatorSetCap returns the maximum number of validators in the validator
+// set sent to CometBFT.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) ValidatorSetCap() uint64 {
+	return c.Data.ValidatorSetCap
+}
+
+// MinPerEpochChurnLimit returns the minimum effective balance that may be
+// activated per epoch.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) MinPerEpochChurnLimit() uint64 {
+	return c.Data.MinPerEpochChurnLimit
+}
+
+// MaxPerEpochActivationChurnLimit returns the maximum effective balance that
+// may be activated per epoch.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) MaxPerEpochActivationChurnLimit() uint64 {
+	return c.Data.MaxPerEpochActivationChurnLimit
+}
+
+// ChurnLimitQuotient returns the quotient of the total active balance that
+// may be activated per epoch.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) ChurnLimitQuotient() uint64 {
+	return c.Data.ChurnLimitQuotient
+}
+
+// ActivationQueueEpoch returns the epoch from which validators are activated
+// through the activation queue.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) ActivationQueueEpoch() EpochT {
+	return c.Data.ActivationQueueEpoch
+}
+
+// MinValidatorWithdrawabilityDelay returns the number of epochs after its exit
+// before a validator's balance can be withdrawn.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) MinValidatorWithdrawabilityDelay() uint64 {
+	return c.Data.MinValidatorWithdrawabilityDelay
+}
+
+// ShardCommitteePeriod returns the number of epochs a validator must have been
+// active for before it may exit voluntarily.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) ShardCommitteePeriod() uint64 {
+	return c.Data.ShardCommitteePeriod
+}
+
+// BaseRewardFactor returns the factor used to derive the base reward of a
+// validator from its effective balance.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) BaseRewardFactor() uint64 {
+	return c.Data.BaseRewardFactor
+}
+
+// ProposerRewardQuotient returns the quotient of the base reward paid to the
+// proposer of a block.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) ProposerRewardQuotient() uint64 {
+	return c.Data.ProposerRewardQuotient
+}
+
 // InactivityPenaltyQuotient returns the inactivity penalty quotient.
 func (c chainSpec[
 	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
@@ -416,6 +612,14 @@ func (c chainSpec[
 	return c.Data.ProportionalSlashingMultiplier
 }
 
+// MinSlashingPenaltyQuotient returns the quotient of the effective balance
+// immediately taken from a slashed validator.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) MinSlashingPenaltyQuotient() uint64 {
+	return c.Data.MinSlashingPenaltyQuotient
+}
+
 // MaxWithdrawalsPerPayload returns the maximum number of withdrawals per
 // payload.
 func (c chainSpec[
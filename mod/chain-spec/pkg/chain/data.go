@@ -42,6 +42,15 @@ type SpecData[
 	EjectionBalance uint64 `mapstructure:"ejection-balance"`
 	// EffectiveBalanceIncrement is the effective balance increment.
 	EffectiveBalanceIncrement uint64 `mapstructure:"effective-balance-increment"`
+	// HysteresisQuotient divides the effective balance increment into the
+	// hysteresis increment used when updating effective balances.
+	HysteresisQuotient uint64 `mapstructure:"hysteresis-quotient"`
+	// HysteresisDownwardMultiplier is the number of hysteresis increments a
+	// balance must drop below the effective balance to lower it.
+	HysteresisDownwardMultiplier uint64 `mapstructure:"hysteresis-downward-multiplier"`
+	// HysteresisUpwardMultiplier is the number of hysteresis increments a
+	// balance must rise above the effective balance to raise it.
+	HysteresisUpwardMultiplier uint64 `mapstructure:"hysteresis-upward-multiplier"`
 
 	// Time parameters constants.
 	//
@@ -73,6 +82,9 @@ type SpecData[
 	DomainTypeAggregateAndProof DomainTypeT `mapstructure:"domain-type-aggregate-and-proof"`
 	// DomainTypeApplicationMask is the domain for the application mask.
 	DomainTypeApplicationMask DomainTypeT `mapstructure:"domain-type-application-mask"`
+	// DomainTypeBLSToExecutionChange is the domain for BLS to execution
+	// change signatures.
+	DomainTypeBLSToExecutionChange DomainTypeT `mapstructure:"domain-type-bls-to-execution-change"`
 
 	// Eth1-related values.
 	//
@@ -108,14 +120,50 @@ type SpecData[
 	// ValidatorRegistryLimit is the maximum number of validators in the
 	// registry.
 	ValidatorRegistryLimit uint64 `mapstructure:"validator-registry-limit"`
+	// ValidatorSetCap is the maximum number of validators, by effective
+	// balance, in the validator set sent to CometBFT.
+	ValidatorSetCap uint64 `mapstructure:"validator-set-cap"`
+
+	// Validator cycle values.
+	//
+	// MinPerEpochChurnLimit is the minimum effective balance, in Gwei, that
+	// may be activated per epoch.
+	MinPerEpochChurnLimit uint64 `mapstructure:"min-per-epoch-churn-limit"`
+	// MaxPerEpochActivationChurnLimit is the maximum effective balance, in
+	// Gwei, that may be activated per epoch.
+	MaxPerEpochActivationChurnLimit uint64 `mapstructure:"max-per-epoch-activation-churn-limit"`
+	// ChurnLimitQuotient is the quotient of the total active balance that may
+	// be activated per epoch.
+	ChurnLimitQuotient uint64 `mapstructure:"churn-limit-quotient"`
+	// ActivationQueueEpoch is the epoch from which validators are activated
+	// through the activation queue. Validators that joined before it
+	// predate the queue and are activated at it.
+	ActivationQueueEpoch EpochT `mapstructure:"activation-queue-epoch"`
+	// MinValidatorWithdrawabilityDelay is the number of epochs after its exit
+	// before a validator's balance can be withdrawn.
+	MinValidatorWithdrawabilityDelay uint64 `mapstructure:"min-validator-withdrawability-delay"`
+	// ShardCommitteePeriod is the number of epochs a validator must have
+	// been active for before it may exit voluntarily.
+	ShardCommitteePeriod uint64 `mapstructure:"shard-committee-period"`
 
 	// Rewards and penalties constants.
 	//
-	// InactivityPenaltyQuotient is the inactivity penalty quotient.
+	// BaseRewardFactor is the factor used to derive the base reward of a
+	// validator from its effective balance. Rewards for signing commits and
+	// proposing blocks are disabled if it is zero.
+	BaseRewardFactor uint64 `mapstructure:"base-reward-factor"`
+	// ProposerRewardQuotient is the quotient of the base reward paid to the
+	// proposer of a block. Proposer rewards are disabled if it is zero.
+	ProposerRewardQuotient uint64 `mapstructure:"proposer-reward-quotient"`
+	// InactivityPenaltyQuotient is the inactivity penalty quotient. Inactivity
+	// penalties are disabled if it is zero.
 	InactivityPenaltyQuotient uint64 `mapstructure:"inactivity-penalty-quotient"`
 	// ProportionalSlashingMultiplier is the slashing multiplier relative to the
 	// base penalty.
 	ProportionalSlashingMultiplier uint64 `mapstructure:"proportional-slashing-multiplier"`
+	// MinSlashingPenaltyQuotient is the quotient of the effective balance
+	// that is immediately taken from a slashed validator.
+	MinSlashingPenaltyQuotient uint64 `mapstructure:"min-slashing-penalty-quotient"`
 
 	// Capella Values
 	//
@@ -144,6 +192,18 @@ type SpecData[
 	// KZGCommitmentInclusionProofDepth is the depth of the KZG inclusion proof.
 	KZGCommitmentInclusionProofDepth uint64 `mapstructure:"kzg-commitment-inclusion-proof-depth"`
 
+	// Electra Values
+	//
+	// MaxProposerSlashingsPerBlock specifies the maximum number of proposer
+	// slashings allowed per block.
+	MaxProposerSlashingsPerBlock uint64 `mapstructure:"max-proposer-slashings-per-block"`
+	// MaxVoluntaryExitsPerBlock specifies the maximum number of voluntary
+	// exits allowed per block.
+	MaxVoluntaryExitsPerBlock uint64 `mapstructure:"max-voluntary-exits-per-block"`
+	// MaxBlsToExecutionChangesPerBlock specifies the maximum number of BLS to
+	// execution changes allowed per block.
+	MaxBlsToExecutionChangesPerBlock uint64 `mapstructure:"max-bls-to-execution-changes-per-block"`
+
 	// CometValues
 	CometValues CometBFTConfigT `mapstructure:"comet-bft-config"`
 }
@@ -114,6 +114,8 @@ func (cb *CLIBuilder[
 		&cometbft.Service[LoggerT]{},
 		cb.nodeBuilderFunc,
 		chainSpec,
+		DefaultAppConfigTemplate(),
+		DefaultAppConfig(),
 	)
 
 	return rootCmd, nil
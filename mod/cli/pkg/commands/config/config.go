@@ -0,0 +1,200 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package config
+
+import (
+	"os"
+	"path/filepath"
+
+	beaconconfig "github.com/berachain/beacon-kit/mod/config"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const (
+	// FlagDryRun prints the migrated configuration instead of writing it.
+	FlagDryRun = "dry-run"
+	// ConfigFolder is the folder of the home directory holding app.toml.
+	ConfigFolder = "config"
+	// AppConfigFileName is the name of the application configuration file.
+	AppConfigFileName = "app.toml"
+	// backupSuffix is appended to the file name of the configuration file
+	// saved before a migration.
+	backupSuffix = ".bak"
+)
+
+// Commands creates a new command for managing the application configuration.
+// The given template and default configuration are the ones new
+// configuration files are rendered from.
+func Commands(appTemplate string, appConfig any) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "config",
+		Short:                      "Application configuration subcommands",
+		DisableFlagParsing:         false,
+		SuggestionsMinimumDistance: 2, //nolint:mnd // from sdk.
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		NewDiffCommand(appTemplate, appConfig),
+		NewMigrateCommand(appTemplate, appConfig),
+	)
+
+	return cmd
+}
+
+// NewDiffCommand creates a new command comparing app.toml against the
+// current configuration template.
+func NewDiffCommand(appTemplate string, appConfig any) *cobra.Command {
+	return &cobra.Command{
+		Use:   "diff",
+		Short: "Compares app.toml against the current configuration template",
+		Long: `Compares app.toml against the current configuration template and
+lists keys that are missing from the file (+), keys that are no longer
+recognized (-) and keys whose value differs from the default (~).`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			path, file, defaults, err := load(cmd, appTemplate, appConfig)
+			if err != nil {
+				return err
+			}
+
+			var outdated int
+			changes := Diff(file, defaults)
+			cmd.Printf("Comparing %s with the current template\n", path)
+			for _, change := range changes {
+				if change.Kind != Modified {
+					outdated++
+				}
+				cmd.Println(change)
+			}
+			if outdated == 0 {
+				cmd.Println("app.toml is up to date")
+				return nil
+			}
+			cmd.Printf(
+				"%d key(s) out of date, run `config migrate` to update\n",
+				outdated,
+			)
+			return nil
+		},
+	}
+}
+
+// NewMigrateCommand creates a new command rewriting app.toml from the
+// current configuration template while preserving its values.
+func NewMigrateCommand(appTemplate string, appConfig any) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate",
+		Short: "Rewrites app.toml from the current configuration template",
+		Long: `Rewrites app.toml from the current configuration template while
+preserving the values set in the file. Keys missing from the file are added
+with their default value and keys that are no longer recognized are dropped.
+The previous file is saved next to it with a .bak suffix.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			path, file, _, err := load(cmd, appTemplate, appConfig)
+			if err != nil {
+				return err
+			}
+			migrated, err := Migrate(file, appTemplate, appConfig)
+			if err != nil {
+				return err
+			}
+
+			// Make sure the node accepts the migrated configuration.
+			v, err := parse(migrated)
+			if err != nil {
+				return errors.Join(ErrInvalidMigration, err)
+			}
+			if _, err = beaconconfig.ReadConfigFromAppOpts(v); err != nil {
+				return errors.Join(ErrInvalidMigration, err)
+			}
+
+			dryRun, err := cmd.Flags().GetBool(FlagDryRun)
+			if err != nil {
+				return err
+			}
+			if dryRun {
+				cmd.Print(string(migrated))
+				return nil
+			}
+			return writeMigrated(cmd, path, migrated)
+		},
+	}
+	cmd.Flags().Bool(
+		FlagDryRun, false, "Print the migrated app.toml instead of writing it",
+	)
+	return cmd
+}
+
+// load reads app.toml from the home directory along with the configuration
+// template rendered with the default configuration.
+func load(
+	cmd *cobra.Command,
+	appTemplate string,
+	appConfig any,
+) (string, *viper.Viper, *viper.Viper, error) {
+	clientCtx, ok := cmd.Context().
+		Value(client.ClientContextKey).(*client.Context)
+	if !ok {
+		return "", nil, nil, ErrNoClientCtx
+	}
+	path := filepath.Join(clientCtx.HomeDir, ConfigFolder, AppConfigFileName)
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", nil, nil, err
+	}
+	file, err := parse(data)
+	if err != nil {
+		return "", nil, nil, errors.Wrapf(err, "failed to parse %s", path)
+	}
+
+	rendered, err := Render(appTemplate, appConfig)
+	if err != nil {
+		return "", nil, nil, err
+	}
+	defaults, err := parse(rendered)
+	if err != nil {
+		return "", nil, nil, err
+	}
+	return path, file, defaults, nil
+}
+
+// writeMigrated saves the current app.toml with a backup suffix and replaces
+// it with the migrated configuration.
+func writeMigrated(cmd *cobra.Command, path string, migrated []byte) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+	backup := path + backupSuffix
+	if err = os.Rename(path, backup); err != nil {
+		return err
+	}
+	if err = os.WriteFile(path, migrated, info.Mode().Perm()); err != nil {
+		return err
+	}
+	cmd.Printf("Migrated %s, previous version saved to %s\n", path, backup)
+	return nil
+}
@@ -0,0 +1,115 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package config
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/spf13/viper"
+)
+
+// ChangeKind describes how a key of a configuration file differs from the
+// current configuration template.
+type ChangeKind uint8
+
+const (
+	// Missing indicates that the key is in the template but not in the
+	// configuration file, so its default value is used.
+	Missing ChangeKind = iota
+	// Unknown indicates that the key is in the configuration file but no
+	// longer in the template, so it is ignored.
+	Unknown
+	// Modified indicates that the value in the configuration file differs
+	// from the default value of the template.
+	Modified
+)
+
+// String returns the symbol used to print the change kind.
+func (k ChangeKind) String() string {
+	switch k {
+	case Missing:
+		return "+"
+	case Unknown:
+		return "-"
+	case Modified:
+		return "~"
+	default:
+		return "?"
+	}
+}
+
+// Change is a single difference between a configuration file and the current
+// configuration template.
+type Change struct {
+	// Kind is the kind of the change.
+	Kind ChangeKind
+	// Key is the fully qualified key, e.g. "beacon-kit.logger.log-level".
+	Key string
+	// Value is the value in the configuration file, empty if Missing.
+	Value string
+	// Default is the value in the template, empty if Unknown.
+	Default string
+}
+
+// String formats the change as a single line.
+func (c Change) String() string {
+	switch c.Kind {
+	case Missing:
+		return fmt.Sprintf("%s %s = %q", c.Kind, c.Key, c.Default)
+	case Unknown:
+		return fmt.Sprintf("%s %s = %q", c.Kind, c.Key, c.Value)
+	default:
+		return fmt.Sprintf(
+			"%s %s = %q (default %q)", c.Kind, c.Key, c.Value, c.Default,
+		)
+	}
+}
+
+// Diff returns the differences between the keys of a configuration file and
+// the keys of the rendered configuration template, sorted by key.
+func Diff(file, template *viper.Viper) []Change {
+	var changes []Change
+	for _, key := range template.AllKeys() {
+		def := fmt.Sprint(template.Get(key))
+		if !file.InConfig(key) {
+			changes = append(changes, Change{
+				Kind: Missing, Key: key, Default: def,
+			})
+			continue
+		}
+		if value := fmt.Sprint(file.Get(key)); value != def {
+			changes = append(changes, Change{
+				Kind: Modified, Key: key, Value: value, Default: def,
+			})
+		}
+	}
+	for _, key := range file.AllKeys() {
+		if !template.InConfig(key) {
+			changes = append(changes, Change{
+				Kind: Unknown, Key: key, Value: fmt.Sprint(file.Get(key)),
+			})
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Key < changes[j].Key
+	})
+	return changes
+}
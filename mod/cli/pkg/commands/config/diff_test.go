@@ -0,0 +1,91 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package config_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/config"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+const testTemplate = `[section]
+# Name is preserved.
+name = "{{ .Section.Name }}"
+# Count was added by a newer release.
+count = "{{ .Section.Count }}"
+`
+
+type testConfig struct {
+	Section struct {
+		Name  string `mapstructure:"name"`
+		Count int    `mapstructure:"count"`
+	} `mapstructure:"section"`
+}
+
+func defaults() testConfig {
+	var cfg testConfig
+	cfg.Section.Name = "default"
+	cfg.Section.Count = 3
+	return cfg
+}
+
+func readTOML(t *testing.T, data string) *viper.Viper {
+	t.Helper()
+	v := viper.New()
+	v.SetConfigType("toml")
+	require.NoError(t, v.ReadConfig(bytes.NewBufferString(data)))
+	return v
+}
+
+const oldFile = `[section]
+name = "custom"
+removed = "true"
+`
+
+func TestDiff(t *testing.T) {
+	rendered, err := config.Render(testTemplate, defaults())
+	require.NoError(t, err)
+
+	changes := config.Diff(readTOML(t, oldFile), readTOML(t, string(rendered)))
+	require.Equal(t, []config.Change{
+		{Kind: config.Missing, Key: "section.count", Default: "3"},
+		{
+			Kind: config.Modified, Key: "section.name",
+			Value: "custom", Default: "default",
+		},
+		{Kind: config.Unknown, Key: "section.removed", Value: "true"},
+	}, changes)
+}
+
+func TestMigrate(t *testing.T) {
+	migrated, err := config.Migrate(
+		readTOML(t, oldFile), testTemplate, defaults(),
+	)
+	require.NoError(t, err)
+	require.Equal(t, `[section]
+# Name is preserved.
+name = "custom"
+# Count was added by a newer release.
+count = "3"
+`, string(migrated))
+}
@@ -0,0 +1,86 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package config
+
+import (
+	"bytes"
+	"reflect"
+	"text/template"
+
+	beaconconfig "github.com/berachain/beacon-kit/mod/config"
+	"github.com/mitchellh/mapstructure"
+	"github.com/spf13/viper"
+)
+
+// Migrate renders the configuration template with the values of the given
+// configuration file. Keys missing from the file take their value from
+// defaults and keys unknown to the template are dropped.
+func Migrate(file *viper.Viper, tmpl string, defaults any) ([]byte, error) {
+	rendered, err := Render(tmpl, defaults)
+	if err != nil {
+		return nil, err
+	}
+	merged, err := parse(rendered)
+	if err != nil {
+		return nil, err
+	}
+	if err = merged.MergeConfigMap(file.AllSettings()); err != nil {
+		return nil, err
+	}
+
+	// Decode into a zero value so that pointer fields are allocated by the
+	// decode hooks rather than decoded in place.
+	cfg := reflect.New(reflect.TypeOf(defaults))
+	if err = merged.Unmarshal(
+		cfg.Interface(),
+		viper.DecodeHook(beaconconfig.DecodeHook()),
+		func(c *mapstructure.DecoderConfig) {
+			// The server configuration is embedded at the top level.
+			c.Squash = true
+		},
+	); err != nil {
+		return nil, err
+	}
+	return Render(tmpl, cfg.Interface())
+}
+
+// Render renders the configuration template with the given configuration.
+func Render(tmpl string, cfg any) ([]byte, error) {
+	t, err := template.New("appConfigFileTemplate").Parse(tmpl)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	if err = t.Execute(&buf, cfg); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// parse reads the given TOML encoded configuration into a new viper
+// instance.
+func parse(data []byte) (*viper.Viper, error) {
+	v := viper.New()
+	v.SetConfigType("toml")
+	if err := v.ReadConfig(bytes.NewReader(data)); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
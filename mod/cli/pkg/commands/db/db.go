@@ -0,0 +1,226 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package db
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	clicontext "github.com/berachain/beacon-kit/mod/cli/pkg/context"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/db"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// flagFrom is the flag for the backend the databases are stored in.
+	flagFrom = "from"
+	// flagTo is the flag for the backend the databases are converted to.
+	flagTo = "to"
+
+	// migratingSuffix is appended to the name of a database while it is
+	// being converted.
+	migratingSuffix = "-migrating"
+	// backupSuffix is appended to the directory of a converted database.
+	backupSuffix = ".bak"
+)
+
+// databases are the names of the databases of the data directory a
+// migration converts, the application state first.
+//
+//nolint:gochecknoglobals // the databases of the node.
+var databases = []string{"application", "blobs"}
+
+// Migration is the conversion of a database to another backend.
+type Migration struct {
+	// Name is the name of the database.
+	Name string
+	// Keys is the number of keys copied.
+	Keys int
+	// Backup is the directory the database is kept at in its previous
+	// backend.
+	Backup string
+}
+
+// Commands creates a new command for managing the databases of the node.
+func Commands() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "db",
+		Short:                      "Database subcommands",
+		DisableFlagParsing:         false,
+		SuggestionsMinimumDistance: 2, //nolint:mnd // from sdk.
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		NewMigrateCommand(),
+	)
+
+	return cmd
+}
+
+// NewMigrateCommand creates a new command converting the databases of the
+// node to another backend.
+func NewMigrateCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate",
+		Short: "Converts the databases of the node to another backend",
+		Long: `Converts the application database of the node, and the blob store if it
+is kept in a key-value database, from the backend configured in app.toml (or
+--from) to the backend given by --to. The previous databases are kept next to
+the converted ones with a .bak suffix. The node must be stopped, and the
+backend in the [beacon-kit.storage] section of app.toml updated before it is
+started again.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			cfg, err := db.ReadConfig(clicontext.GetViperFromCmd(cmd))
+			if err != nil {
+				return err
+			}
+			from, err := cmd.Flags().GetString(flagFrom)
+			if err != nil {
+				return err
+			}
+			if from != "" {
+				cfg.Backend = dbm.BackendType(from)
+			}
+			to, err := cmd.Flags().GetString(flagTo)
+			if err != nil {
+				return err
+			}
+
+			dataDir := filepath.Join(
+				clicontext.GetConfigFromCmd(cmd).RootDir, "data",
+			)
+			migrations, err := MigrateDataDir(
+				dataDir, cfg, dbm.BackendType(to),
+			)
+			for _, m := range migrations {
+				cmd.Printf(
+					"Migrated %d keys of %s from %s to %s, previous database "+
+						"kept at %s\n",
+					m.Keys, m.Name, cfg.Backend, to, m.Backup,
+				)
+			}
+			if err != nil {
+				return err
+			}
+			cmd.Printf(
+				"Set backend = %q in the [beacon-kit.storage] section of "+
+					"app.toml before starting the node\n", to,
+			)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(
+		flagFrom, "", "Backend the databases are stored in, "+
+			"defaults to the backend of app.toml",
+	)
+	cmd.Flags().String(flagTo, "", "Backend to convert the databases to")
+	if err := cmd.MarkFlagRequired(flagTo); err != nil {
+		panic(err)
+	}
+	return cmd
+}
+
+// MigrateDataDir converts the databases of the given data directory from
+// the backend of cfg to the given backend, keeping the tuning of cfg. The
+// blob store is only converted if it is kept in a key-value database.
+func MigrateDataDir(
+	dataDir string,
+	cfg db.Config,
+	to dbm.BackendType,
+) ([]Migration, error) {
+	if cfg.Backend == to {
+		return nil, ErrSameBackend
+	}
+	if cfg.Backend == dbm.MemDBBackend || to == dbm.MemDBBackend {
+		return nil, ErrMemDBMigration
+	}
+	target := cfg
+	target.Backend = to
+	if err := target.Validate(); err != nil {
+		return nil, err
+	}
+
+	migrations := make([]Migration, 0, len(databases))
+	for i, name := range databases {
+		path := filepath.Join(dataDir, name+dbm.DBFileSuffix)
+		if _, err := os.Stat(path); os.IsNotExist(err) {
+			if i == 0 {
+				return nil, errors.Wrapf(ErrDatabaseNotFound, "%q", path)
+			}
+			continue
+		}
+
+		m, err := migrateDB(dataDir, name, cfg, target)
+		if err != nil {
+			return migrations, errors.Wrapf(
+				err, "failed to migrate %s", name,
+			)
+		}
+		migrations = append(migrations, m)
+	}
+	return migrations, nil
+}
+
+// migrateDB converts the database of the given name to the backend of
+// target and moves the previous database to its backup directory.
+func migrateDB(
+	dataDir string,
+	name string,
+	source db.Config,
+	target db.Config,
+) (Migration, error) {
+	path := filepath.Join(dataDir, name+dbm.DBFileSuffix)
+	m := Migration{
+		Name:   name,
+		Backup: fmt.Sprintf("%s.%s%s", path, source.Backend, backupSuffix),
+	}
+	if _, err := os.Stat(m.Backup); err == nil {
+		return m, errors.Wrapf(ErrBackupExists, "%q", m.Backup)
+	}
+
+	src, err := db.NewDB(name, dataDir, source)
+	if err != nil {
+		return m, err
+	}
+	// Convert into a temporary database, left behind if the copy fails.
+	dst, err := db.NewDB(name+migratingSuffix, dataDir, target)
+	if err != nil {
+		return m, errors.Join(err, src.Close())
+	}
+	m.Keys, err = db.Migrate(src, dst)
+	if err = errors.Join(err, dst.Close(), src.Close()); err != nil {
+		return m, err
+	}
+
+	if err = os.Rename(path, m.Backup); err != nil {
+		return m, err
+	}
+	return m, os.Rename(
+		filepath.Join(dataDir, name+migratingSuffix+dbm.DBFileSuffix), path,
+	)
+}
@@ -0,0 +1,78 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package db_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	dbcmd "github.com/berachain/beacon-kit/mod/cli/pkg/commands/db"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/db"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrateDataDir(t *testing.T) {
+	dataDir := t.TempDir()
+	cfg := db.DefaultConfig()
+	appDB, err := db.NewDB("application", dataDir, cfg)
+	require.NoError(t, err)
+	require.NoError(t, appDB.Set([]byte("key"), []byte("value")))
+	require.NoError(t, appDB.Close())
+
+	migrations, err := dbcmd.MigrateDataDir(
+		dataDir, cfg, dbm.GoLevelDBBackend,
+	)
+	require.NoError(t, err)
+	require.Len(t, migrations, 1)
+	require.Equal(t, 1, migrations[0].Keys)
+	require.DirExists(t, migrations[0].Backup)
+
+	// The database now opens with the target backend.
+	cfg.Backend = dbm.GoLevelDBBackend
+	appDB, err = db.NewDB("application", dataDir, cfg)
+	require.NoError(t, err)
+	value, err := appDB.Get([]byte("key"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("value"), value)
+	require.NoError(t, appDB.Close())
+
+	_, err = dbcmd.MigrateDataDir(dataDir, cfg, dbm.GoLevelDBBackend)
+	require.ErrorIs(t, err, dbcmd.ErrSameBackend)
+
+	// Migrating back keeps the goleveldb copy as a second backup, and a
+	// further round trip would overwrite the pebbledb one.
+	_, err = dbcmd.MigrateDataDir(dataDir, cfg, dbm.PebbleDBBackend)
+	require.NoError(t, err)
+	cfg.Backend = dbm.PebbleDBBackend
+	_, err = dbcmd.MigrateDataDir(dataDir, cfg, dbm.GoLevelDBBackend)
+	require.ErrorIs(t, err, dbcmd.ErrBackupExists)
+	require.NoError(t, os.RemoveAll(migrations[0].Backup))
+	_, err = dbcmd.MigrateDataDir(dataDir, cfg, dbm.GoLevelDBBackend)
+	require.NoError(t, err)
+	_, err = dbcmd.MigrateDataDir(dataDir, cfg, dbm.MemDBBackend)
+	require.ErrorIs(t, err, dbcmd.ErrMemDBMigration)
+	_, err = dbcmd.MigrateDataDir(
+		filepath.Join(dataDir, "missing"), cfg, dbm.GoLevelDBBackend,
+	)
+	require.ErrorIs(t, err, dbcmd.ErrDatabaseNotFound)
+}
@@ -0,0 +1,41 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package db
+
+import "github.com/berachain/beacon-kit/mod/errors"
+
+var (
+	// ErrSameBackend is returned when a database is migrated to the backend
+	// it is already stored in.
+	ErrSameBackend = errors.New("database already uses the target backend")
+
+	// ErrMemDBMigration is returned when a migration from or to the memdb
+	// backend is requested, which does not persist data.
+	ErrMemDBMigration = errors.New("memdb databases cannot be migrated")
+
+	// ErrDatabaseNotFound is returned when the application database to
+	// migrate does not exist.
+	ErrDatabaseNotFound = errors.New("application database not found")
+
+	// ErrBackupExists is returned when the backup of a database being
+	// migrated would overwrite the backup of a previous migration.
+	ErrBackupExists = errors.New("database backup already exists")
+)
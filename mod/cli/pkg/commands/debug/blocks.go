@@ -0,0 +1,182 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package debug
+
+import (
+	"io"
+
+	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/server/types"
+	clicontext "github.com/berachain/beacon-kit/mod/cli/pkg/context"
+	"github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service/middleware"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/log"
+	nodetypes "github.com/berachain/beacon-kit/mod/node-core/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/db"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/store"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/spf13/cobra"
+)
+
+// blockLoader loads finalized blocks, along with the consensus data they
+// were finalized with, from the CometBFT stores.
+type blockLoader struct {
+	// blockStore holds the finalized blocks.
+	blockStore *store.BlockStore
+	// stateStore holds the validator sets the blocks were voted by.
+	stateStore sm.Store
+	// initialHeight is the initial height of the chain.
+	initialHeight int64
+	// dbs are the databases of the stores.
+	dbs []io.Closer
+}
+
+// newBlockLoader opens the CometBFT stores of the node.
+func newBlockLoader(cfg *cmtcfg.Config) (*blockLoader, error) {
+	l := &blockLoader{}
+	blockStoreDB, err := cmtcfg.DefaultDBProvider(
+		&cmtcfg.DBContext{ID: "blockstore", Config: cfg},
+	)
+	if err != nil {
+		return nil, err
+	}
+	l.dbs = append(l.dbs, blockStoreDB)
+	stateDB, err := cmtcfg.DefaultDBProvider(
+		&cmtcfg.DBContext{ID: "state", Config: cfg},
+	)
+	if err != nil {
+		return nil, errors.Join(err, l.Close())
+	}
+	l.dbs = append(l.dbs, stateDB)
+
+	l.blockStore = store.NewBlockStore(
+		blockStoreDB, store.WithDBKeyLayout(cfg.Storage.ExperimentalKeyLayout),
+	)
+	l.stateStore = sm.NewStore(stateDB, sm.StoreOptions{
+		DiscardABCIResponses: cfg.Storage.DiscardABCIResponses,
+	})
+	state, err := l.stateStore.Load()
+	if err != nil {
+		return nil, errors.Join(err, l.Close())
+	}
+	l.initialHeight = state.InitialHeight
+	return l, nil
+}
+
+// Load loads the block finalized at height.
+func (l *blockLoader) Load(height int64) (*nodetypes.ReplayRequest, error) {
+	block, _ := l.blockStore.LoadBlock(height)
+	if block == nil {
+		return nil, errors.Wrapf(ErrBlockNotFound, "height %d", height)
+	}
+	if uint(len(block.Txs)) <= middleware.BeaconBlockTxIndex {
+		return nil, errors.Wrapf(ErrNoBeaconBlock, "height %d", height)
+	}
+
+	votes, err := l.lastCommitVotes(block)
+	if err != nil {
+		return nil, err
+	}
+
+	return &nodetypes.ReplayRequest{
+		Height:          height,
+		Block:           block.Txs[middleware.BeaconBlockTxIndex],
+		ProposerAddress: block.ProposerAddress,
+		ConsensusTime:   block.Time,
+		LastCommitVotes: votes,
+	}, nil
+}
+
+// Height returns the height of the last finalized block.
+func (l *blockLoader) Height() int64 {
+	return l.blockStore.Height()
+}
+
+// Close closes the CometBFT stores.
+func (l *blockLoader) Close() error {
+	var errs []error
+	for _, db := range l.dbs {
+		errs = append(errs, db.Close())
+	}
+	return errors.Join(errs...)
+}
+
+// lastCommitVotes returns the votes in the last commit of the block, as
+// they were decided when the block was finalized.
+func (l *blockLoader) lastCommitVotes(
+	block *cmttypes.Block,
+) ([]transition.CommitVote, error) {
+	if block.Height == l.initialHeight {
+		return nil, nil
+	}
+	lastValSet, err := l.stateStore.LoadValidators(block.Height - 1)
+	if err != nil {
+		return nil, err
+	}
+
+	commit := sm.BuildLastCommitInfo(block, lastValSet, l.initialHeight)
+	votes := make([]transition.CommitVote, len(commit.Votes))
+	for i, vote := range commit.Votes {
+		votes[i] = transition.CommitVote{
+			Address: vote.Validator.Address,
+			Signed:  vote.BlockIdFlag == cmtproto.BlockIDFlagCommit,
+		}
+	}
+	return votes, nil
+}
+
+// newReplayer opens the application database of the node read-only and
+// builds the node replaying the blocks. The returned function closes the
+// database.
+func newReplayer[
+	T nodetypes.Node,
+	LoggerT log.AdvancedLogger[LoggerT],
+](
+	cmd *cobra.Command,
+	appCreator types.AppCreator[T, LoggerT],
+) (nodetypes.BlockReplayer, func() error, error) {
+	cfg := clicontext.GetConfigFromCmd(cmd)
+	v := clicontext.GetViperFromCmd(cmd)
+	dbCfg, err := db.ReadConfig(v)
+	if err != nil {
+		return nil, nil, err
+	}
+	appDB, err := db.OpenDBReadOnly(cfg.RootDir, dbCfg)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	app := appCreator(
+		clicontext.GetLoggerFromCmd[LoggerT](cmd),
+		appDB,
+		nil,
+		cfg,
+		v,
+	)
+	replayer, ok := any(app).(nodetypes.BlockReplayer)
+	if !ok {
+		return nil, nil, errors.Join(ErrReplayUnsupported, appDB.Close())
+	}
+	return replayer, appDB.Close, nil
+}
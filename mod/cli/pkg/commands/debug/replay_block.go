@@ -0,0 +1,99 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package debug
+
+import (
+	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/server/types"
+	clicontext "github.com/berachain/beacon-kit/mod/cli/pkg/context"
+	"github.com/berachain/beacon-kit/mod/cli/pkg/flags"
+	"github.com/berachain/beacon-kit/mod/log"
+	nodetypes "github.com/berachain/beacon-kit/mod/node-core/pkg/types"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// flagHeight is the height of the block to replay.
+	flagHeight = "height"
+	// flagPreStateHeight is the height of the state to replay the block on.
+	flagPreStateHeight = "pre-state-height"
+)
+
+// NewReplayBlockCmd creates a command replaying a finalized block on a
+// committed state.
+func NewReplayBlockCmd[
+	T nodetypes.Node,
+	LoggerT log.AdvancedLogger[LoggerT],
+](
+	appCreator types.AppCreator[T, LoggerT],
+) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "replay-block",
+		Short: "Replays a finalized block and traces its state transition",
+		Long: `Replays the block finalized at the given height on the state
+committed at the given pre-state height, by default the previous height.
+Prints the fields of the beacon state mutated by every step of the state
+transition and the first field diverging from the state committed at the
+height of the block. The node must be stopped.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			height, err := cmd.Flags().GetInt64(flagHeight)
+			if err != nil {
+				return err
+			}
+			preStateHeight, err := cmd.Flags().GetInt64(flagPreStateHeight)
+			if err != nil {
+				return err
+			}
+			if preStateHeight == 0 {
+				preStateHeight = height - 1
+			}
+
+			blocks, err := newBlockLoader(clicontext.GetConfigFromCmd(cmd))
+			if err != nil {
+				return err
+			}
+			defer blocks.Close()
+			req, err := blocks.Load(height)
+			if err != nil {
+				return err
+			}
+			req.PreStateHeight = preStateHeight
+
+			replayer, closeFn, err := newReplayer(cmd, appCreator)
+			if err != nil {
+				return err
+			}
+			defer closeFn()
+			return replayer.ReplayBlock(cmd.Context(), req, cmd.OutOrStdout())
+		},
+	}
+
+	cmd.Flags().Int64(flagHeight, 0, "height of the block to replay")
+	cmd.Flags().Int64(
+		flagPreStateHeight, 0,
+		"height of the state to replay the block on (default height - 1)",
+	)
+	flags.AddBeaconKitFlags(cmd)
+	if err := cmd.MarkFlagRequired(flagHeight); err != nil {
+		panic(err)
+	}
+	return cmd
+}
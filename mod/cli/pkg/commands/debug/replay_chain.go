@@ -0,0 +1,114 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package debug
+
+import (
+	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/server/types"
+	clicontext "github.com/berachain/beacon-kit/mod/cli/pkg/context"
+	"github.com/berachain/beacon-kit/mod/cli/pkg/flags"
+	"github.com/berachain/beacon-kit/mod/log"
+	nodetypes "github.com/berachain/beacon-kit/mod/node-core/pkg/types"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// flagFrom is the height of the state the chain is replayed on.
+	flagFrom = "from"
+	// flagTo is the height of the last replayed block.
+	flagTo = "to"
+	// flagVerifyPayloads enables the verification of the execution payloads
+	// by the execution client.
+	flagVerifyPayloads = "verify-payloads"
+)
+
+// NewReplayCmd creates a command replaying a segment of the finalized chain
+// to check the determinism of the state transition.
+func NewReplayCmd[
+	T nodetypes.Node,
+	LoggerT log.AdvancedLogger[LoggerT],
+](
+	appCreator types.AppCreator[T, LoggerT],
+) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "replay",
+		Short: "Re-executes a segment of the chain and checks its state roots",
+		Long: `Replays the blocks finalized after the --from height up to the --to
+height on the state committed at the --from height, and checks the state
+root of every block. Reports the throughput and the first mismatch. The
+committed state is only read. By default, execution payloads are not sent
+to the execution client, which must otherwise be running and synced past
+the replayed blocks. The node must be stopped.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			from, err := cmd.Flags().GetInt64(flagFrom)
+			if err != nil {
+				return err
+			}
+			to, err := cmd.Flags().GetInt64(flagTo)
+			if err != nil {
+				return err
+			}
+			verifyPayloads, err := cmd.Flags().GetBool(flagVerifyPayloads)
+			if err != nil {
+				return err
+			}
+
+			blocks, err := newBlockLoader(clicontext.GetConfigFromCmd(cmd))
+			if err != nil {
+				return err
+			}
+			defer blocks.Close()
+			if to == 0 {
+				to = blocks.Height()
+			}
+
+			replayer, closeFn, err := newReplayer(cmd, appCreator)
+			if err != nil {
+				return err
+			}
+			defer closeFn()
+			return replayer.ReplayChain(
+				cmd.Context(),
+				&nodetypes.ChainReplayRequest{
+					From:           from,
+					To:             to,
+					VerifyPayloads: verifyPayloads,
+					LoadBlock:      blocks.Load,
+				},
+				cmd.OutOrStdout(),
+			)
+		},
+	}
+
+	cmd.Flags().Int64(flagFrom, 0, "height of the state to replay on")
+	cmd.Flags().Int64(
+		flagTo, 0, "height of the last block to replay (default latest)",
+	)
+	cmd.Flags().Bool(
+		flagVerifyPayloads, false,
+		"verify the execution payloads with the execution client",
+	)
+	flags.AddBeaconKitFlags(cmd)
+	if err := cmd.MarkFlagRequired(flagFrom); err != nil {
+		panic(err)
+	}
+	return cmd
+}
@@ -0,0 +1,345 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"cosmossdk.io/log"
+	"github.com/berachain/beacon-kit/mod/cli/pkg/utils/keygen"
+	"github.com/berachain/beacon-kit/mod/cli/pkg/utils/parser"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/cometbft/cometbft/crypto/bls12381"
+	"github.com/spf13/cobra"
+)
+
+// NewBatch creates a new command to create the deposits of a batch of
+// validators.
+func NewBatch(chainSpec common.ChainSpec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "batch",
+		Short: "Creates the deposits of a batch of validators",
+		Long: `Creates a signed deposit for each validator key and writes them
+		to a deposit_data-*.json file in the format of the staking-deposit-cli.
+		The keys are either read from a directory of validator key files or
+		derived from a mnemonic as specified by EIP-2333 and EIP-2334.`,
+		Args: cobra.NoArgs,
+		RunE: batchCmd(chainSpec),
+	}
+
+	cmd.Flags().String(keysDir, defaultKeysDir, keysDirMsg)
+	cmd.Flags().String(mnemonicFile, defaultMnemonicFile, mnemonicFileMsg)
+	cmd.Flags().
+		String(mnemonicPassword, defaultMnemonicPassword, mnemonicPasswordMsg)
+	cmd.Flags().Uint32(startIndex, defaultStartIndex, startIndexMsg)
+	cmd.Flags().Uint32(numValidators, defaultNumValidators, numValidatorsMsg)
+	cmd.Flags().String(depositAmount, defaultDepositAmount, depositAmountMsg)
+	cmd.Flags().String(
+		withdrawalAddress, defaultWithdrawalAddress, withdrawalAddressMsg,
+	)
+	cmd.Flags().String(forkVersion, defaultForkVersion, forkVersionMsg)
+	cmd.Flags().String(
+		genesisValidatorsRoot, defaultGenesisValidatorsRoot,
+		genesisValidatorsRootMsg,
+	)
+	cmd.Flags().String(networkName, defaultNetworkName(), networkNameMsg)
+	cmd.Flags().String(outputDir, defaultOutputDir, outputDirMsg)
+	if err := cmd.MarkFlagRequired(withdrawalAddress); err != nil {
+		panic(err)
+	}
+
+	return cmd
+}
+
+// batchCmd returns a command that writes the deposits of a batch of
+// validators.
+func batchCmd(
+	chainSpec common.ChainSpec,
+) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, _ []string) error {
+		logger := log.NewLogger(os.Stdout)
+
+		signers, err := getBatchSigners(cmd)
+		if err != nil {
+			return err
+		}
+
+		var address common.ExecutionAddress
+		addressFlag, err := cmd.Flags().GetString(withdrawalAddress)
+		if err != nil {
+			return err
+		}
+		if err = address.UnmarshalText([]byte(addressFlag)); err != nil {
+			return err
+		}
+
+		amountFlag, err := cmd.Flags().GetString(depositAmount)
+		if err != nil {
+			return err
+		}
+		amount, err := parser.ConvertAmount(amountFlag)
+		if err != nil {
+			return err
+		}
+
+		forkData, err := getForkData(cmd, chainSpec)
+		if err != nil {
+			return err
+		}
+
+		network, err := cmd.Flags().GetString(networkName)
+		if err != nil {
+			return err
+		}
+
+		entries := make([]*DepositData, 0, len(signers))
+		for _, blsSigner := range signers {
+			var entry *DepositData
+			entry, err = NewDepositData(
+				forkData,
+				chainSpec.DomainTypeDeposit(),
+				blsSigner,
+				types.NewCredentialsFromExecutionAddress(address),
+				amount,
+				network,
+			)
+			if err != nil {
+				return err
+			}
+			entries = append(entries, entry)
+		}
+
+		dir, err := cmd.Flags().GetString(outputDir)
+		if err != nil {
+			return err
+		}
+		path := filepath.Join(
+			dir, fmt.Sprintf("deposit_data-%d.json", time.Now().Unix()),
+		)
+		if err = WriteDepositDataFile(path, entries); err != nil {
+			return err
+		}
+
+		logger.Info(
+			"Wrote deposit data", "path", path, "deposits", len(entries),
+		)
+		return nil
+	}
+}
+
+// getBatchSigners returns the signers of the validator keys of the batch,
+// read from the keys directory or derived from the mnemonic.
+func getBatchSigners(cmd *cobra.Command) ([]crypto.BLSSigner, error) {
+	dir, err := cmd.Flags().GetString(keysDir)
+	if err != nil {
+		return nil, err
+	}
+	mnemonicPath, err := cmd.Flags().GetString(mnemonicFile)
+	if err != nil {
+		return nil, err
+	}
+
+	var keys []signer.LegacyKey
+	switch {
+	case (dir == "") == (mnemonicPath == ""):
+		return nil, ErrKeySourceRequired
+	case dir != "":
+		keys, err = ReadValidatorKeys(dir)
+	default:
+		keys, err = deriveMnemonicKeys(cmd, mnemonicPath)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(keys) == 0 {
+		return nil, ErrNoValidatorKeys
+	}
+
+	signers := make([]crypto.BLSSigner, 0, len(keys))
+	for _, key := range keys {
+		var blsSigner *signer.LegacySigner
+		if blsSigner, err = signer.NewLegacySigner(key); err != nil {
+			return nil, err
+		}
+		signers = append(signers, blsSigner)
+	}
+	return signers, nil
+}
+
+// deriveMnemonicKeys derives the validator keys at the indices of the flags
+// from the mnemonic in the given file.
+func deriveMnemonicKeys(
+	cmd *cobra.Command, mnemonicPath string,
+) ([]signer.LegacyKey, error) {
+	//#nosec:G304 // the path is provided by the operator.
+	mnemonic, err := os.ReadFile(mnemonicPath)
+	if err != nil {
+		return nil, err
+	}
+	password, err := cmd.Flags().GetString(mnemonicPassword)
+	if err != nil {
+		return nil, err
+	}
+	start, err := cmd.Flags().GetUint32(startIndex)
+	if err != nil {
+		return nil, err
+	}
+	count, err := cmd.Flags().GetUint32(numValidators)
+	if err != nil {
+		return nil, err
+	}
+	return DeriveValidatorKeys(string(mnemonic), password, start, count)
+}
+
+// DeriveValidatorKeys derives the signing keys of count validators from the
+// given mnemonic, starting at the validator of the given index.
+func DeriveValidatorKeys(
+	mnemonic, password string, start, count uint32,
+) ([]signer.LegacyKey, error) {
+	seed, err := keygen.SeedFromMnemonic(mnemonic, password)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]signer.LegacyKey, 0, count)
+	for index := start; index < start+count; index++ {
+		var sk keygen.SecretKey
+		sk, err = keygen.DeriveSecretKey(
+			seed, keygen.ValidatorSigningKeyPath(index),
+		)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, signer.LegacyKey(sk))
+	}
+	return keys, nil
+}
+
+// validatorKeyFile is the part of a priv_validator_key.json file holding the
+// secret key.
+type validatorKeyFile struct {
+	PrivKey struct {
+		Type  string `json:"type"`
+		Value string `json:"value"`
+	} `json:"priv_key"`
+}
+
+// ReadValidatorKeys reads the BLS12-381 secret keys of the JSON validator key
+// files of the given directory, in the order of their names.
+func ReadValidatorKeys(dir string) ([]signer.LegacyKey, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(paths)
+
+	keys := make([]signer.LegacyKey, 0, len(paths))
+	for _, path := range paths {
+		//#nosec:G304 // the path is provided by the operator.
+		bz, readErr := os.ReadFile(path)
+		if readErr != nil {
+			return nil, readErr
+		}
+		var file validatorKeyFile
+		if err = json.Unmarshal(bz, &file); err != nil {
+			return nil, errors.Wrapf(err, "%s", path)
+		}
+		if file.PrivKey.Type != bls12381.PrivKeyName {
+			return nil, errors.Wrapf(
+				ErrInvalidValidatorKeyFile, "%s has key type %q",
+				path, file.PrivKey.Type,
+			)
+		}
+		var key []byte
+		key, err = base64.StdEncoding.DecodeString(file.PrivKey.Value)
+		if err != nil {
+			return nil, errors.Wrapf(err, "%s", path)
+		}
+		if len(key) != len(signer.LegacyKey{}) {
+			return nil, errors.Wrapf(ErrInvalidValidatorKeyFile, "%s", path)
+		}
+		keys = append(keys, signer.LegacyKey(key))
+	}
+	return keys, nil
+}
+
+// getForkData returns the fork data deposits are signed with, from the fork
+// version and genesis validators root flags. The fork version defaults to
+// the one the chain spec activates at genesis.
+func getForkData(
+	cmd *cobra.Command,
+	chainSpec common.ChainSpec,
+) (*types.ForkData, error) {
+	currentVersion := version.FromUint32[common.Version](
+		chainSpec.ActiveForkVersionForEpoch(0),
+	)
+	versionFlag, err := cmd.Flags().GetString(forkVersion)
+	if err != nil {
+		return nil, err
+	}
+	if versionFlag != "" {
+		if currentVersion, err = parser.ConvertVersion(versionFlag); err != nil {
+			return nil, err
+		}
+		if err = checkForkVersion(chainSpec, currentVersion); err != nil {
+			return nil, err
+		}
+	}
+
+	root, err := getGenesisValidatorsRoot(cmd)
+	if err != nil {
+		return nil, err
+	}
+	return types.NewForkData(currentVersion, root), nil
+}
+
+// getGenesisValidatorsRoot returns the genesis validators root of the flag,
+// which defaults to the zero root.
+func getGenesisValidatorsRoot(cmd *cobra.Command) (common.Root, error) {
+	rootFlag, err := cmd.Flags().GetString(genesisValidatorsRoot)
+	if err != nil || rootFlag == "" {
+		return common.Root{}, err
+	}
+	return parser.ConvertGenesisValidatorRoot(rootFlag)
+}
+
+// defaultNetworkName returns the name of the chain spec the node runs.
+func defaultNetworkName() string {
+	if name := strings.TrimSpace(
+		os.Getenv(components.ChainSpecTypeEnvVar),
+	); name != "" {
+		return name
+	}
+	return "testnet"
+}
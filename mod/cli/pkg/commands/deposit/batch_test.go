@@ -0,0 +1,75 @@
+//go:build bls12381
+
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/deposit"
+	"github.com/berachain/beacon-kit/mod/config/pkg/spec"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/json"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBatch(t *testing.T) {
+	cs := spec.TestnetChainSpec()
+	dir := t.TempDir()
+	mnemonicPath := filepath.Join(dir, "mnemonic")
+	require.NoError(t, os.WriteFile(mnemonicPath, []byte(
+		"abandon abandon abandon abandon abandon abandon abandon abandon "+
+			"abandon abandon abandon about\n",
+	), 0o600))
+
+	batch := deposit.NewBatch(cs)
+	batch.SetArgs([]string{
+		"--mnemonic-file", mnemonicPath,
+		"--num-validators", "3",
+		"--withdrawal-address", "0x0000000000000000000000000000000000000001",
+		"--output-dir", dir,
+	})
+	require.NoError(t, batch.Execute())
+
+	paths, err := filepath.Glob(filepath.Join(dir, "deposit_data-*.json"))
+	require.NoError(t, err)
+	require.Len(t, paths, 1)
+	entries, err := deposit.ReadDepositDataFile(paths[0])
+	require.NoError(t, err)
+	require.Len(t, entries, 3)
+	// The deposits are signed at the fork version of the spec at genesis.
+	require.Equal(t, "04000000", entries[0].ForkVersion)
+
+	validate := deposit.NewValidateDeposit(cs)
+	validate.SetArgs([]string{"--file", paths[0]})
+	require.NoError(t, validate.Execute())
+
+	// A tampered amount invalidates the file.
+	entries[1].Amount++
+	bz, err := json.Marshal(entries)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(paths[0], bz, 0o600))
+	validate = deposit.NewValidateDeposit(cs)
+	validate.SetArgs([]string{"--file", paths[0]})
+	require.ErrorIs(t, validate.Execute(), deposit.ErrInvalidDepositData)
+}
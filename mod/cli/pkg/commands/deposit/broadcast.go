@@ -0,0 +1,207 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+	"os"
+	"strings"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
+	gethprimitives "github.com/berachain/beacon-kit/mod/geth-primitives"
+	"github.com/berachain/beacon-kit/mod/geth-primitives/pkg/bind"
+	gethcrypto "github.com/berachain/beacon-kit/mod/geth-primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/geth-primitives/pkg/deposit"
+	gethkeystore "github.com/berachain/beacon-kit/mod/geth-primitives/pkg/keystore"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/spf13/cobra"
+)
+
+// baseFeeMultiplier is the multiple of the current base fee the fee cap of
+// a deposit transaction covers.
+const baseFeeMultiplier = 2
+
+// Client is the execution client a deposit transaction is sent through.
+type Client interface {
+	bind.ContractBackend
+	bind.DeployBackend
+	// ChainID returns the chain ID transactions are signed for.
+	ChainID(ctx context.Context) (*big.Int, error)
+}
+
+// BroadcastDeposit sends the given signed deposit message to the deposit
+// contract in an EIP-1559 transaction paid for by the given key, and waits
+// for it to be mined. The gas limit is estimated against the pending state.
+// It returns the deposit event the contract emitted, which holds the index
+// of the deposit.
+func BroadcastDeposit(
+	ctx context.Context,
+	client Client,
+	contractAddress common.ExecutionAddress,
+	key *ecdsa.PrivateKey,
+	depositMsg *types.DepositMessage,
+	signature crypto.BLSSignature,
+) (*deposit.BeaconDepositContractDeposit, error) {
+	contract, err := deposit.NewBeaconDepositContract(
+		gethprimitives.ExecutionAddress(contractAddress), client,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	opts, err := newTransactOpts(ctx, client, key)
+	if err != nil {
+		return nil, err
+	}
+	opts.Value = depositMsg.Amount.ToWei().ToBig()
+
+	tx, err := contract.Deposit(
+		opts,
+		depositMsg.Pubkey[:],
+		depositMsg.Credentials[:],
+		depositMsg.Amount.Unwrap(),
+		signature[:],
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	receipt, err := bind.WaitMined(ctx, client, tx)
+	if err != nil {
+		return nil, err
+	}
+	if receipt == nil {
+		return nil, ErrDepositReceiptEmpty
+	}
+	if receipt.Status != gethprimitives.ReceiptStatusSuccessful {
+		return nil, errors.Wrapf(
+			ErrDepositTransactionFailed, "tx %s", tx.Hash(),
+		)
+	}
+
+	for _, log := range receipt.Logs {
+		if log.Address != gethprimitives.ExecutionAddress(contractAddress) {
+			continue
+		}
+		if event, parseErr := contract.ParseDeposit(*log); parseErr == nil {
+			return event, nil
+		}
+	}
+	return nil, errors.Wrapf(ErrDepositEventNotFound, "tx %s", tx.Hash())
+}
+
+// newTransactOpts returns the options of an EIP-1559 transaction signed by
+// the given key. The fee cap leaves room for the base fee to double before
+// the transaction is mined.
+func newTransactOpts(
+	ctx context.Context,
+	client Client,
+	key *ecdsa.PrivateKey,
+) (*bind.TransactOpts, error) {
+	chainID, err := client.ChainID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := bind.NewKeyedTransactorWithChainID(key, chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	head, err := client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	if head.BaseFee == nil {
+		return nil, ErrEIP1559Unsupported
+	}
+	tip, err := client.SuggestGasTipCap(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	opts.Context = ctx
+	opts.GasTipCap = tip
+	opts.GasFeeCap = new(big.Int).Add(
+		tip, new(big.Int).Mul(head.BaseFee, big.NewInt(baseFeeMultiplier)),
+	)
+	return opts, nil
+}
+
+// getExecutionKey returns the key paying for the deposit transaction, read
+// either from the private key flag or from the keystore flags.
+func getExecutionKey(cmd *cobra.Command) (*ecdsa.PrivateKey, error) {
+	privKey, err := cmd.Flags().GetString(privateKey)
+	if err != nil {
+		return nil, err
+	}
+	keystorePath, err := cmd.Flags().GetString(keystore)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case privKey != "" && keystorePath != "":
+		return nil, ErrConflictingKeys
+	case privKey != "":
+		return gethcrypto.HexToECDSA(strings.TrimPrefix(privKey, "0x"))
+	case keystorePath != "":
+		var passwordFile string
+		passwordFile, err = cmd.Flags().GetString(keystorePasswordFile)
+		if err != nil {
+			return nil, err
+		}
+		return LoadKeystore(keystorePath, passwordFile)
+	default:
+		return nil, ErrPrivateKeyRequired
+	}
+}
+
+// LoadKeystore decrypts the key of the given keystore file with the password
+// held in the given password file. An empty password file path stands for
+// an empty password.
+func LoadKeystore(
+	keystorePath, passwordFile string,
+) (*ecdsa.PrivateKey, error) {
+	keyJSON, err := os.ReadFile(keystorePath)
+	if err != nil {
+		return nil, err
+	}
+
+	var password []byte
+	if passwordFile != "" {
+		if password, err = os.ReadFile(passwordFile); err != nil {
+			return nil, err
+		}
+	}
+
+	var key *gethkeystore.Key
+	key, err = gethkeystore.DecryptKey(
+		keyJSON, strings.TrimRight(string(password), "\r\n"),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return key.PrivateKey, nil
+}
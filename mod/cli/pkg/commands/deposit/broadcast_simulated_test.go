@@ -0,0 +1,145 @@
+//go:build simulated
+
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+	"time"
+
+	depositcli "github.com/berachain/beacon-kit/mod/cli/pkg/commands/deposit"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/geth-primitives/pkg/deposit"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	gethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBroadcastDeposit(t *testing.T) {
+	key, err := gethcrypto.GenerateKey()
+	require.NoError(t, err)
+	sender := gethcrypto.PubkeyToAddress(key.PublicKey)
+
+	backend := simulated.NewBackend(gethtypes.GenesisAlloc{
+		sender: {Balance: new(big.Int).Lsh(big.NewInt(1), 100)},
+	})
+	t.Cleanup(func() { require.NoError(t, backend.Close()) })
+	client := backend.Client()
+
+	// The simulated genesis block has a difficulty, so the merge, hence
+	// Shanghai, is only active past it.
+	backend.Commit()
+
+	// Deploy the deposit contract and allow the sender a single deposit.
+	chainID, err := client.ChainID(context.Background())
+	require.NoError(t, err)
+	opts, err := bind.NewKeyedTransactorWithChainID(key, chainID)
+	require.NoError(t, err)
+	address, _, contract, err := deposit.DeployBeaconDepositContract(
+		opts, client, sender,
+	)
+	require.NoError(t, err)
+	backend.Commit()
+	_, err = contract.AllowDeposit(opts, sender, 1)
+	require.NoError(t, err)
+	backend.Commit()
+
+	depositMsg := &types.DepositMessage{
+		Pubkey: crypto.BLSPubkey{0x01},
+		Credentials: types.NewCredentialsFromExecutionAddress(
+			common.ExecutionAddress(sender),
+		),
+		Amount: math.Gwei(32e9),
+	}
+	signature := crypto.BLSSignature{0x02}
+
+	event, err := broadcast(
+		t, backend, client, common.ExecutionAddress(address), key,
+		depositMsg, signature,
+	)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), event.Index)
+	require.Equal(t, depositMsg.Pubkey[:], event.Pubkey)
+	require.Equal(t, depositMsg.Credentials[:], event.Credentials)
+	require.Equal(t, depositMsg.Amount.Unwrap(), event.Amount)
+	require.Equal(t, signature[:], event.Signature)
+
+	tx, _, err := client.TransactionByHash(
+		context.Background(), event.Raw.TxHash,
+	)
+	require.NoError(t, err)
+	require.Equal(t, uint8(gethtypes.DynamicFeeTxType), tx.Type())
+
+	// The sender is not allowed a second deposit.
+	_, err = broadcast(
+		t, backend, client, common.ExecutionAddress(address), key,
+		depositMsg, signature,
+	)
+	require.ErrorContains(t, err, "execution reverted")
+}
+
+// broadcast broadcasts the deposit while mining blocks on the simulated
+// backend until it returns.
+func broadcast(
+	t *testing.T,
+	backend *simulated.Backend,
+	client simulated.Client,
+	address common.ExecutionAddress,
+	key *ecdsa.PrivateKey,
+	depositMsg *types.DepositMessage,
+	signature crypto.BLSSignature,
+) (*deposit.BeaconDepositContractDeposit, error) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	type result struct {
+		event *deposit.BeaconDepositContractDeposit
+		err   error
+	}
+	done := make(chan result, 1)
+	go func() {
+		event, err := depositcli.BroadcastDeposit(
+			ctx, client, address, key, depositMsg, signature,
+		)
+		done <- result{event, err}
+	}()
+
+	ticker := time.NewTicker(10 * time.Millisecond)
+	defer ticker.Stop()
+	for {
+		select {
+		case res := <-done:
+			return res.event, res.err
+		case <-ticker.C:
+			backend.Commit()
+		}
+	}
+}
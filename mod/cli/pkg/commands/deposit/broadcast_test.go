@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	depositcli "github.com/berachain/beacon-kit/mod/cli/pkg/commands/deposit"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	gethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadKeystore(t *testing.T) {
+	key, err := gethcrypto.GenerateKey()
+	require.NoError(t, err)
+	keyJSON, err := keystore.EncryptKey(
+		&keystore.Key{
+			Address:    gethcrypto.PubkeyToAddress(key.PublicKey),
+			PrivateKey: key,
+		},
+		"password",
+		keystore.LightScryptN,
+		keystore.LightScryptP,
+	)
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	keystorePath := filepath.Join(dir, "keystore.json")
+	passwordFile := filepath.Join(dir, "password")
+	require.NoError(t, os.WriteFile(keystorePath, keyJSON, 0o600))
+	require.NoError(t, os.WriteFile(passwordFile, []byte("password\n"), 0o600))
+
+	loaded, err := depositcli.LoadKeystore(keystorePath, passwordFile)
+	require.NoError(t, err)
+	require.True(t, key.Equal(loaded))
+
+	_, err = depositcli.LoadKeystore(keystorePath, "")
+	require.Error(t, err)
+}
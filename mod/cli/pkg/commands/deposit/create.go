@@ -21,12 +21,16 @@
 package deposit
 
 import (
+	"context"
 	"os"
+	"time"
 
 	"cosmossdk.io/log"
 	clicontext "github.com/berachain/beacon-kit/mod/cli/pkg/context"
 	"github.com/berachain/beacon-kit/mod/cli/pkg/utils/parser"
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/geth-primitives/pkg/deposit"
+	"github.com/berachain/beacon-kit/mod/geth-primitives/pkg/ethclient"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
@@ -35,6 +39,10 @@ import (
 	"github.com/spf13/cobra"
 )
 
+// broadcastTimeout is how long the deposit transaction is waited on to be
+// mined.
+const broadcastTimeout = 2 * time.Minute
+
 // NewCreateValidator creates a new command to create a validator deposit.
 func NewCreateValidator[
 	ExecutionPayloadT constraints.EngineType[ExecutionPayloadT],
@@ -47,7 +55,9 @@ func NewCreateValidator[
 		Long: `Creates a validator deposit with the necessary credentials. The 
 		arguments are expected in the order of withdrawal credentials, deposit
 		amount, current version, and genesis validator root. If the broadcast
-		flag is set to true, a private key must be provided to sign the transaction.`,
+		flag is set to true, a private key or keystore must be provided to sign
+		the transaction, which is sent to the deposit contract through the
+		execution client at the RPC URL.`,
 		Args: cobra.ExactArgs(4), //nolint:mnd // The number of arguments.
 		RunE: createValidatorCmd[ExecutionPayloadT](chainSpec),
 	}
@@ -59,6 +69,18 @@ func NewCreateValidator[
 	)
 	cmd.Flags().
 		String(valPrivateKey, defaultValidatorPrivateKey, valPrivateKeyMsg)
+	cmd.Flags().BoolP(
+		broadcastDeposit, broadcastDepositShorthand,
+		defaultBroadcastDeposit, broadcastDepositMsg,
+	)
+	cmd.Flags().String(keystore, defaultKeystore, keystoreMsg)
+	cmd.Flags().String(
+		keystorePasswordFile, defaultKeystorePasswordFile,
+		keystorePasswordFileMsg,
+	)
+	cmd.Flags().String(rpcURL, defaultRPCURL, rpcURLMsg)
+	cmd.Flags().
+		String(depositContract, defaultDepositContract, depositContractMsg)
 
 	return cmd
 }
@@ -124,18 +146,82 @@ func createValidatorCmd[
 
 		// If the broadcast flag is not set, output the deposit message and
 		// signature and return early.
+		broadcast, err := cmd.Flags().GetBool(broadcastDeposit)
+		if err != nil {
+			return err
+		}
+		if !broadcast {
+			logger.Info(
+				"Deposit Message CallData",
+				"pubkey", depositMsg.Pubkey.String(),
+				"withdrawal credentials", depositMsg.Credentials.String(),
+				"amount", depositMsg.Amount,
+				"signature", signature.String(),
+			)
+			return nil
+		}
+
+		event, err := broadcastDepositCmd(
+			cmd, chainSpec, depositMsg, signature,
+		)
+		if err != nil {
+			return err
+		}
 		logger.Info(
-			"Deposit Message CallData",
+			"Deposit broadcast",
 			"pubkey", depositMsg.Pubkey.String(),
-			"withdrawal credentials", depositMsg.Credentials.String(),
 			"amount", depositMsg.Amount,
-			"signature", signature.String(),
+			"tx", event.Raw.TxHash.Hex(),
+			"block", event.Raw.BlockNumber,
+			"index", event.Index,
 		)
-
 		return nil
 	}
 }
 
+// broadcastDepositCmd sends the deposit transaction as configured by the
+// flags of the command.
+func broadcastDepositCmd(
+	cmd *cobra.Command,
+	chainSpec common.ChainSpec,
+	depositMsg *types.DepositMessage,
+	signature crypto.BLSSignature,
+) (*deposit.BeaconDepositContractDeposit, error) {
+	key, err := getExecutionKey(cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	contractAddress := chainSpec.DepositContractAddress()
+	contractFlag, err := cmd.Flags().GetString(depositContract)
+	if err != nil {
+		return nil, err
+	}
+	if contractFlag != "" {
+		if err = contractAddress.UnmarshalText(
+			[]byte(contractFlag),
+		); err != nil {
+			return nil, err
+		}
+	}
+
+	url, err := cmd.Flags().GetString(rpcURL)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(cmd.Context(), broadcastTimeout)
+	defer cancel()
+	client, err := ethclient.DialContext(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+	defer client.Close()
+
+	return BroadcastDeposit(
+		ctx, client, contractAddress, key, depositMsg, signature,
+	)
+}
+
 // getBLSSigner returns a BLS signer based on the override commands key flag.
 func getBLSSigner(
 	cmd *cobra.Command,
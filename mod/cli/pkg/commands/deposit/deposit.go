@@ -23,6 +23,7 @@ package deposit
 import (
 	"github.com/berachain/beacon-kit/mod/cli/pkg/utils/parser"
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
@@ -45,6 +46,7 @@ func Commands[ExecutionPayloadT constraints.EngineType[ExecutionPayloadT]](
 	cmd.AddCommand(
 		NewValidateDeposit(chainSpec),
 		NewCreateValidator[ExecutionPayloadT](chainSpec),
+		NewBatch(chainSpec),
 	)
 
 	return cmd
@@ -61,11 +63,27 @@ func NewValidateDeposit(chainSpec common.ChainSpec) *cobra.Command {
 		deposit message includes the public key, withdrawal credentials,
 		and deposit amount. The args taken are in the order of the public key,
 		withdrawal credentials, deposit amount, signature, current version,
-		and genesis validator root.`,
-		Args: cobra.ExactArgs(6),
+		and genesis validator root. Alternatively, every entry of a
+		deposit_data-*.json file is validated against its roots, the fork
+		versions of the chain spec and the deposit domain.`,
+		Args: func(cmd *cobra.Command, args []string) error {
+			if file, err := cmd.Flags().GetString(depositDataFile); err != nil {
+				return err
+			} else if file != "" {
+				return cobra.NoArgs(cmd, args)
+			}
+			return cobra.ExactArgs(6)(cmd, args)
+		},
 		RunE: validateDepositMessage(chainSpec),
 	}
 
+	cmd.Flags().
+		String(depositDataFile, defaultDepositDataFile, depositDataFileMsg)
+	cmd.Flags().String(
+		genesisValidatorsRoot, defaultGenesisValidatorsRoot,
+		genesisValidatorsRootMsg,
+	)
+
 	return cmd
 }
 
@@ -75,7 +93,15 @@ func validateDepositMessage(chainSpec common.ChainSpec) func(
 	_ *cobra.Command,
 	args []string,
 ) error {
-	return func(_ *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		file, err := cmd.Flags().GetString(depositDataFile)
+		if err != nil {
+			return err
+		}
+		if file != "" {
+			return validateDepositDataFile(cmd, chainSpec, file)
+		}
+
 		pubkey, err := parser.ConvertPubkey(args[0])
 		if err != nil {
 			return err
@@ -100,6 +126,9 @@ func validateDepositMessage(chainSpec common.ChainSpec) func(
 		if err != nil {
 			return err
 		}
+		if err = checkForkVersion(chainSpec, currentVersion); err != nil {
+			return err
+		}
 
 		genesisValidatorRoot, err := parser.ConvertGenesisValidatorRoot(args[5])
 		if err != nil {
@@ -120,3 +149,39 @@ func validateDepositMessage(chainSpec common.ChainSpec) func(
 		)
 	}
 }
+
+// validateDepositDataFile validates every entry of the deposit data file,
+// reporting all the invalid ones.
+func validateDepositDataFile(
+	cmd *cobra.Command,
+	chainSpec common.ChainSpec,
+	file string,
+) error {
+	entries, err := ReadDepositDataFile(file)
+	if err != nil {
+		return err
+	}
+	root, err := getGenesisValidatorsRoot(cmd)
+	if err != nil {
+		return err
+	}
+
+	var invalid int
+	for i, entry := range entries {
+		if err = entry.Verify(
+			chainSpec,
+			root,
+			signer.BLSSigner{}.VerifySignature,
+		); err != nil {
+			invalid++
+			cmd.PrintErrf("deposit %d (%s): %v\n", i, entry.Pubkey, err)
+		}
+	}
+	if invalid > 0 {
+		return errors.Wrapf(
+			ErrInvalidDepositData, "%d of %d deposits", invalid, len(entries),
+		)
+	}
+	cmd.Printf("All %d deposits are valid\n", len(entries))
+	return nil
+}
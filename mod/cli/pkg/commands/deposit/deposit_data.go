@@ -0,0 +1,239 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit
+
+import (
+	"encoding/hex"
+	"os"
+
+	"github.com/berachain/beacon-kit/mod/cli/pkg/utils/parser"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/json"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/karalabe/ssz"
+)
+
+// depositCLIVersion is the version of the staking-deposit-cli whose
+// deposit data format is written.
+const depositCLIVersion = "2.7.0"
+
+// DepositData is an entry of a deposit_data-*.json file in the format of the
+// staking-deposit-cli. Byte fields are hex encoded without a 0x prefix.
+type DepositData struct {
+	Pubkey                string `json:"pubkey"`
+	WithdrawalCredentials string `json:"withdrawal_credentials"`
+	Amount                uint64 `json:"amount"`
+	Signature             string `json:"signature"`
+	DepositMessageRoot    string `json:"deposit_message_root"`
+	DepositDataRoot       string `json:"deposit_data_root"`
+	ForkVersion           string `json:"fork_version"`
+	NetworkName           string `json:"network_name"`
+	DepositCLIVersion     string `json:"deposit_cli_version"`
+}
+
+// NewDepositData signs a deposit of the given amount for the key of the
+// signer and returns it as a deposit data entry.
+func NewDepositData(
+	forkData *types.ForkData,
+	domainType common.DomainType,
+	signer crypto.BLSSigner,
+	credentials types.WithdrawalCredentials,
+	amount math.Gwei,
+	networkName string,
+) (*DepositData, error) {
+	depositMsg, signature, err := types.CreateAndSignDepositMessage(
+		forkData, domainType, signer, credentials, amount,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	messageRoot := depositMsg.HashTreeRoot()
+	dataRoot := depositDataRoot(depositMsg, signature)
+	return &DepositData{
+		Pubkey:                hex.EncodeToString(depositMsg.Pubkey[:]),
+		WithdrawalCredentials: hex.EncodeToString(depositMsg.Credentials[:]),
+		Amount:                depositMsg.Amount.Unwrap(),
+		Signature:             hex.EncodeToString(signature[:]),
+		DepositMessageRoot:    hex.EncodeToString(messageRoot[:]),
+		DepositDataRoot:       hex.EncodeToString(dataRoot[:]),
+		ForkVersion:           hex.EncodeToString(forkData.CurrentVersion[:]),
+		NetworkName:           networkName,
+		DepositCLIVersion:     depositCLIVersion,
+	}, nil
+}
+
+// Verify checks that the roots of the entry match its deposit, that its fork
+// version is scheduled by the chain spec and that its signature is valid for
+// the deposit domain of the chain spec at that fork version.
+func (d *DepositData) Verify(
+	chainSpec common.ChainSpec,
+	genesisValidatorsRoot common.Root,
+	signatureVerificationFn func(
+		pubkey crypto.BLSPubkey, message []byte, signature crypto.BLSSignature,
+	) error,
+) error {
+	depositMsg, signature, forkVersion, err := d.decode()
+	if err != nil {
+		return err
+	}
+	if err = checkForkVersion(chainSpec, forkVersion); err != nil {
+		return err
+	}
+
+	messageRoot := depositMsg.HashTreeRoot()
+	if hex.EncodeToString(messageRoot[:]) != d.DepositMessageRoot {
+		return errors.Wrapf(
+			ErrDepositDataRootMismatch, "deposit message root %s",
+			d.DepositMessageRoot,
+		)
+	}
+	dataRoot := depositDataRoot(depositMsg, signature)
+	if hex.EncodeToString(dataRoot[:]) != d.DepositDataRoot {
+		return errors.Wrapf(
+			ErrDepositDataRootMismatch, "deposit data root %s",
+			d.DepositDataRoot,
+		)
+	}
+
+	return depositMsg.VerifyCreateValidator(
+		types.NewForkData(forkVersion, genesisValidatorsRoot),
+		signature,
+		chainSpec.DomainTypeDeposit(),
+		signatureVerificationFn,
+	)
+}
+
+// checkForkVersion checks that the chain spec activates the given fork
+// version at some epoch, since deposits are verified at the fork version
+// active when they are processed.
+func checkForkVersion(
+	chainSpec common.ChainSpec,
+	forkVersion common.Version,
+) error {
+	for _, epoch := range []math.Epoch{
+		0, chainSpec.DenebPlusForkEpoch(), chainSpec.ElectraForkEpoch(),
+	} {
+		if version.FromUint32[common.Version](
+			chainSpec.ActiveForkVersionForEpoch(epoch),
+		) == forkVersion {
+			return nil
+		}
+	}
+	return errors.Wrapf(
+		ErrForkVersionMismatch,
+		"fork version %s is not scheduled by the chain spec", forkVersion,
+	)
+}
+
+// decode parses the deposit message, signature and fork version of the
+// entry.
+func (d *DepositData) decode() (
+	*types.DepositMessage, crypto.BLSSignature, common.Version, error,
+) {
+	pubkey, err := parser.ConvertPubkey(prefixed(d.Pubkey))
+	if err != nil {
+		return nil, crypto.BLSSignature{}, common.Version{}, err
+	}
+	credentials, err := parser.ConvertWithdrawalCredentials(
+		prefixed(d.WithdrawalCredentials),
+	)
+	if err != nil {
+		return nil, crypto.BLSSignature{}, common.Version{}, err
+	}
+	signature, err := parser.ConvertSignature(prefixed(d.Signature))
+	if err != nil {
+		return nil, crypto.BLSSignature{}, common.Version{}, err
+	}
+	forkVersion, err := parser.ConvertVersion(prefixed(d.ForkVersion))
+	if err != nil {
+		return nil, crypto.BLSSignature{}, common.Version{}, err
+	}
+	return &types.DepositMessage{
+		Pubkey:      pubkey,
+		Credentials: credentials,
+		Amount:      math.Gwei(d.Amount),
+	}, signature, forkVersion, nil
+}
+
+// ReadDepositDataFile reads the entries of a deposit_data-*.json file.
+func ReadDepositDataFile(path string) ([]*DepositData, error) {
+	//#nosec:G304 // the path is provided by the operator.
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var entries []*DepositData
+	if err = json.Unmarshal(bz, &entries); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// WriteDepositDataFile writes the entries to a deposit_data-*.json file.
+func WriteDepositDataFile(path string, entries []*DepositData) error {
+	bz, err := json.Marshal(entries)
+	if err != nil {
+		return err
+	}
+	//nolint:mnd // file permissions.
+	return os.WriteFile(path, bz, 0o644)
+}
+
+// prefixed returns the hex string with a 0x prefix.
+func prefixed(s string) string {
+	return "0x" + s
+}
+
+// depositData is the DepositData container of the consensus specs, which the
+// deposit contract of Ethereum checks the root of.
+type depositData struct {
+	types.DepositMessage
+	Signature crypto.BLSSignature
+}
+
+// SizeSSZ returns the size of the depositData object in SSZ encoding.
+func (*depositData) SizeSSZ(*ssz.Sizer) uint32 {
+	//nolint:mnd // 48 + 32 + 8 + 96 = 184.
+	return 184
+}
+
+// DefineSSZ defines the SSZ encoding for the depositData object.
+func (d *depositData) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineStaticBytes(codec, &d.Pubkey)
+	ssz.DefineStaticBytes(codec, &d.Credentials)
+	ssz.DefineUint64(codec, &d.Amount)
+	ssz.DefineStaticBytes(codec, &d.Signature)
+}
+
+// depositDataRoot returns the root of the DepositData of the deposit.
+func depositDataRoot(
+	depositMsg *types.DepositMessage, signature crypto.BLSSignature,
+) common.Root {
+	return ssz.HashSequential(&depositData{
+		DepositMessage: *depositMsg,
+		Signature:      signature,
+	})
+}
@@ -0,0 +1,119 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit_test
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"path/filepath"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/deposit"
+	"github.com/berachain/beacon-kit/mod/config/pkg/spec"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+// testSigner signs with the hash of the message, so that signatures can be
+// checked without BLS.
+type testSigner struct {
+	pubkey crypto.BLSPubkey
+}
+
+func (s testSigner) PublicKey() crypto.BLSPubkey { return s.pubkey }
+
+func (s testSigner) Sign(msg []byte) (crypto.BLSSignature, error) {
+	var sig crypto.BLSSignature
+	hash := sha256.Sum256(append(s.pubkey[:], msg...))
+	copy(sig[:], hash[:])
+	return sig, nil
+}
+
+func (s testSigner) VerifySignature(
+	pubkey crypto.BLSPubkey, msg []byte, sig crypto.BLSSignature,
+) error {
+	expected, _ := testSigner{pubkey: pubkey}.Sign(msg)
+	if !bytes.Equal(expected[:], sig[:]) {
+		return types.ErrDepositMessage
+	}
+	return nil
+}
+
+func TestDepositData(t *testing.T) {
+	cs := spec.TestnetChainSpec()
+	forkData := types.NewForkData(common.Version{0x04}, common.Root{})
+
+	entries := make([]*deposit.DepositData, 0, 2)
+	for _, pubkey := range []crypto.BLSPubkey{{0x01}, {0x02}} {
+		entry, err := deposit.NewDepositData(
+			forkData,
+			cs.DomainTypeDeposit(),
+			testSigner{pubkey: pubkey},
+			types.NewCredentialsFromExecutionAddress(
+				common.ExecutionAddress{0x03},
+			),
+			math.Gwei(32e9),
+			"testnet",
+		)
+		require.NoError(t, err)
+		entries = append(entries, entry)
+	}
+	require.Equal(t, "04000000", entries[0].ForkVersion)
+	require.Equal(t, uint64(32e9), entries[0].Amount)
+
+	path := filepath.Join(t.TempDir(), "deposit_data.json")
+	require.NoError(t, deposit.WriteDepositDataFile(path, entries))
+	read, err := deposit.ReadDepositDataFile(path)
+	require.NoError(t, err)
+	require.Equal(t, entries, read)
+
+	for _, entry := range read {
+		require.NoError(t, entry.Verify(
+			cs, common.Root{}, testSigner{}.VerifySignature,
+		))
+	}
+
+	// A signature for another genesis validators root does not verify.
+	require.ErrorIs(t, read[0].Verify(
+		cs, common.Root{0x01}, testSigner{}.VerifySignature,
+	), types.ErrDepositMessage)
+
+	// Nor do entries signed at a fork version the chain spec does not
+	// schedule.
+	forked := *read[0]
+	forked.ForkVersion = "03000000"
+	require.ErrorIs(t, forked.Verify(
+		cs, common.Root{}, testSigner{}.VerifySignature,
+	), deposit.ErrForkVersionMismatch)
+
+	// Neither do entries whose deposit does not match their roots.
+	read[0].Amount++
+	require.ErrorIs(t, read[0].Verify(
+		cs, common.Root{}, testSigner{}.VerifySignature,
+	), deposit.ErrDepositDataRootMismatch)
+	read[1].DepositDataRoot = read[0].DepositDataRoot
+	require.ErrorIs(t, read[1].Verify(
+		cs, common.Root{}, testSigner{}.VerifySignature,
+	), deposit.ErrDepositDataRootMismatch)
+}
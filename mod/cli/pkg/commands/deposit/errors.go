@@ -42,4 +42,53 @@ var (
 	// ErrPrivateKeyEmpty is returned when the private key is empty.
 	ErrPrivateKeyEmpty = errors.New(
 		"private key is empty")
+
+	// ErrConflictingKeys is returned when both a private key and a keystore
+	// are provided to sign the deposit transaction.
+	ErrConflictingKeys = errors.New(
+		"only one of private key and keystore may be provided")
+
+	// ErrEIP1559Unsupported is returned when the execution chain does not
+	// have a base fee, hence cannot take an EIP-1559 transaction.
+	ErrEIP1559Unsupported = errors.New(
+		"execution chain does not support EIP-1559 transactions")
+
+	// ErrDepositTransactionFailed is returned when the deposit transaction is
+	// mined but reverted.
+	ErrDepositTransactionFailed = errors.New(
+		"deposit transaction failed")
+
+	// ErrDepositEventNotFound is returned when the receipt of the deposit
+	// transaction holds no deposit event.
+	ErrDepositEventNotFound = errors.New(
+		"deposit event not found in receipt")
+
+	// ErrDepositDataRootMismatch is returned when a root of a deposit data
+	// entry does not match its deposit.
+	ErrDepositDataRootMismatch = errors.New(
+		"deposit data root mismatch")
+
+	// ErrKeySourceRequired is returned when neither or both of a keys
+	// directory and a mnemonic are provided for a batch of deposits.
+	ErrKeySourceRequired = errors.New(
+		"exactly one of keys directory and mnemonic file is required")
+
+	// ErrInvalidValidatorKeyFile is returned when a validator key file does
+	// not hold a BLS12-381 key.
+	ErrInvalidValidatorKeyFile = errors.New(
+		"invalid validator key file")
+
+	// ErrNoValidatorKeys is returned when a batch of deposits has no keys.
+	ErrNoValidatorKeys = errors.New(
+		"no validator keys found")
+
+	// ErrForkVersionMismatch is returned when a deposit is signed at a fork
+	// version the chain spec does not schedule.
+	ErrForkVersionMismatch = errors.New(
+		"fork version mismatch")
+
+	// ErrInvalidDepositData is returned when entries of a deposit data file
+	// fail verification.
+	ErrInvalidDepositData = errors.New(
+		"invalid deposit data")
 )
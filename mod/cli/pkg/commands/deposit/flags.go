@@ -29,12 +29,75 @@ const (
 
 	// validatorPrivateKey is the flag for the validator private key.
 	valPrivateKey = "validator-private-key"
+
+	// broadcastDeposit is the flag for broadcasting the deposit transaction.
+	broadcastDeposit = "broadcast"
+
+	// keystore is the flag for the keystore file holding the key to sign the
+	// deposit transaction.
+	keystore = "keystore"
+
+	// keystorePasswordFile is the flag for the file holding the password of
+	// the keystore.
+	keystorePasswordFile = "keystore-password-file"
+
+	// rpcURL is the flag for the execution client the deposit transaction is
+	// sent to.
+	rpcURL = "rpc-url"
+
+	// depositContract is the flag for the address of the deposit contract.
+	depositContract = "deposit-contract"
+
+	// keysDir is the flag for the directory of validator key files.
+	keysDir = "keys-dir"
+
+	// mnemonicFile is the flag for the file holding the mnemonic validator
+	// keys are derived from.
+	mnemonicFile = "mnemonic-file"
+
+	// mnemonicPassword is the flag for the password of the mnemonic.
+	mnemonicPassword = "mnemonic-password"
+
+	// startIndex is the flag for the index of the first validator key derived
+	// from the mnemonic.
+	startIndex = "start-index"
+
+	// numValidators is the flag for the number of validator keys derived from
+	// the mnemonic.
+	numValidators = "num-validators"
+
+	// depositAmount is the flag for the amount of each deposit.
+	depositAmount = "amount"
+
+	// withdrawalAddress is the flag for the execution address deposits
+	// withdraw to.
+	withdrawalAddress = "withdrawal-address"
+
+	// forkVersion is the flag for the fork version deposits are signed at.
+	forkVersion = "fork-version"
+
+	// genesisValidatorsRoot is the flag for the genesis validators root
+	// deposits are signed with.
+	genesisValidatorsRoot = "genesis-validators-root"
+
+	// networkName is the flag for the network name of the deposit data.
+	networkName = "network-name"
+
+	// outputDir is the flag for the directory the deposit data is written to.
+	outputDir = "output-dir"
+
+	// depositDataFile is the flag for the deposit data file to validate.
+	depositDataFile = "file"
 )
 
 const (
 	// overrideNodeKeyShorthand is the shorthand flag for the overrideNodeKey
 	// flag.
 	overrideNodeKeyShorthand = "o"
+
+	// broadcastDepositShorthand is the shorthand flag for the
+	// broadcastDeposit flag.
+	broadcastDepositShorthand = "b"
 )
 
 const (
@@ -47,12 +110,68 @@ const (
 	// defaultValidatorPrivateKey is the default value for the
 	// validatorPrivateKey flag.
 	defaultValidatorPrivateKey = ""
+
+	// defaultBroadcastDeposit is the default value for the broadcastDeposit
+	// flag.
+	defaultBroadcastDeposit = false
+
+	// defaultKeystore is the default value for the keystore flag.
+	defaultKeystore = ""
+
+	// defaultKeystorePasswordFile is the default value for the
+	// keystorePasswordFile flag.
+	defaultKeystorePasswordFile = ""
+
+	// defaultRPCURL is the default value for the rpcURL flag.
+	defaultRPCURL = "http://localhost:8545"
+
+	// defaultDepositContract is the default value for the depositContract
+	// flag, which falls back to the address in the chain spec.
+	defaultDepositContract = ""
+
+	// defaultKeysDir is the default value for the keysDir flag.
+	defaultKeysDir = ""
+
+	// defaultMnemonicFile is the default value for the mnemonicFile flag.
+	defaultMnemonicFile = ""
+
+	// defaultMnemonicPassword is the default value for the mnemonicPassword
+	// flag.
+	defaultMnemonicPassword = ""
+
+	// defaultStartIndex is the default value for the startIndex flag.
+	defaultStartIndex = 0
+
+	// defaultNumValidators is the default value for the numValidators flag.
+	defaultNumValidators = 1
+
+	// defaultDepositAmount is the default value for the depositAmount flag.
+	defaultDepositAmount = "32000000000"
+
+	// defaultWithdrawalAddress is the default value for the
+	// withdrawalAddress flag.
+	defaultWithdrawalAddress = ""
+
+	// defaultForkVersion is the default value for the forkVersion flag, which
+	// falls back to the fork version of the chain spec at genesis.
+	defaultForkVersion = ""
+
+	// defaultGenesisValidatorsRoot is the default value for the
+	// genesisValidatorsRoot flag, which falls back to the zero root.
+	defaultGenesisValidatorsRoot = ""
+
+	// defaultOutputDir is the default value for the outputDir flag.
+	defaultOutputDir = "."
+
+	// defaultDepositDataFile is the default value for the depositDataFile
+	// flag.
+	defaultDepositDataFile = ""
 )
 
 const (
 	// privateKeyFlagMsg is the usage description for the privateKey flag.
 	privateKeyMsg = `private key to sign and pay for the deposit message. 
-	This is required if the broadcast flag is set.`
+	This or a keystore is required if the broadcast flag is set.`
 
 	// overrideNodeKeyFlagMsg is the usage description for the overrideNodeKey
 	// flag.
@@ -62,4 +181,69 @@ const (
 	// valPrivateKey flag.
 	valPrivateKeyMsg = `validator private key. This is required if the 
 	override-node-key flag is set.`
+
+	// broadcastDepositMsg is the usage description for the broadcastDeposit
+	// flag.
+	broadcastDepositMsg = "broadcast the deposit transaction"
+
+	// keystoreMsg is the usage description for the keystore flag.
+	keystoreMsg = `keystore file holding the key to sign and pay for the 
+	deposit message, used instead of the private key.`
+
+	// keystorePasswordFileMsg is the usage description for the
+	// keystorePasswordFile flag.
+	keystorePasswordFileMsg = "file holding the password of the keystore"
+
+	// rpcURLMsg is the usage description for the rpcURL flag.
+	rpcURLMsg = "execution client RPC URL to send the deposit transaction to"
+
+	// depositContractMsg is the usage description for the depositContract
+	// flag.
+	depositContractMsg = `address of the deposit contract, defaults to the 
+	one of the chain spec.`
+
+	// keysDirMsg is the usage description for the keysDir flag.
+	keysDirMsg = `directory of validator key files in the format of 
+	priv_validator_key.json, one deposit is created per file.`
+
+	// mnemonicFileMsg is the usage description for the mnemonicFile flag.
+	mnemonicFileMsg = `file holding the mnemonic validator keys are derived 
+	from at the EIP-2334 paths m/12381/3600/i/0/0.`
+
+	// mnemonicPasswordMsg is the usage description for the mnemonicPassword
+	// flag.
+	mnemonicPasswordMsg = "password of the mnemonic"
+
+	// startIndexMsg is the usage description for the startIndex flag.
+	startIndexMsg = "index of the first validator key derived from the mnemonic"
+
+	// numValidatorsMsg is the usage description for the numValidators flag.
+	numValidatorsMsg = "number of validator keys derived from the mnemonic"
+
+	// depositAmountMsg is the usage description for the depositAmount flag.
+	depositAmountMsg = "amount of each deposit in gwei"
+
+	// withdrawalAddressMsg is the usage description for the
+	// withdrawalAddress flag.
+	withdrawalAddressMsg = "execution address the deposits withdraw to"
+
+	// forkVersionMsg is the usage description for the forkVersion flag.
+	forkVersionMsg = `fork version the deposits are signed at, defaults to
+	the fork version of the chain spec at genesis.`
+
+	// genesisValidatorsRootMsg is the usage description for the
+	// genesisValidatorsRoot flag.
+	genesisValidatorsRootMsg = `genesis validators root the deposits are 
+	signed with, defaults to the zero root.`
+
+	// networkNameMsg is the usage description for the networkName flag.
+	networkNameMsg = "network name recorded in the deposit data"
+
+	// outputDirMsg is the usage description for the outputDir flag.
+	outputDirMsg = "directory the deposit data file is written to"
+
+	// depositDataFileMsg is the usage description for the depositDataFile
+	// flag.
+	depositDataFileMsg = `deposit data file whose entries are all validated, 
+	instead of the deposit given as arguments.`
 )
@@ -57,14 +57,6 @@ func AddExecutionPayloadCmd(chainSpec common.ChainSpec) *cobra.Command {
 			if err = ethGenesis.UnmarshalJSON(genesisBz); err != nil {
 				return errors.Wrap(err, "failed to unmarshal eth1 genesis")
 			}
-			genesisBlock := ethGenesis.ToBlock()
-
-			// Create the execution payload.
-			payload := gethprimitives.BlockToExecutableData(
-				genesisBlock,
-				nil,
-				nil,
-			).ExecutionPayload
 
 			config := context.GetConfigFromCmd(cmd)
 
@@ -95,9 +87,9 @@ func AddExecutionPayloadCmd(chainSpec common.ChainSpec) *cobra.Command {
 
 			// Inject the execution payload.
 			genesisInfo.ExecutionPayloadHeader, err =
-				executableDataToExecutionPayloadHeader(
+				ExecutionPayloadHeaderFromEthGenesis(
+					ethGenesis,
 					version.ToUint32(genesisInfo.ForkVersion),
-					payload,
 					chainSpec.MaxWithdrawalsPerPayload(),
 				)
 			if err != nil {
@@ -122,6 +114,23 @@ func AddExecutionPayloadCmd(chainSpec common.ChainSpec) *cobra.Command {
 	return cmd
 }
 
+// ExecutionPayloadHeaderFromEthGenesis returns the header of the execution
+// payload of the genesis block of the given eth1 genesis.
+func ExecutionPayloadHeaderFromEthGenesis(
+	ethGenesis *gethprimitives.Genesis,
+	forkVersion uint32,
+	maxWithdrawals uint64,
+) (*types.ExecutionPayloadHeader, error) {
+	payload := gethprimitives.BlockToExecutableData(
+		ethGenesis.ToBlock(),
+		nil,
+		nil,
+	).ExecutionPayload
+	return executableDataToExecutionPayloadHeader(
+		forkVersion, payload, maxWithdrawals,
+	)
+}
+
 // Converts the eth executable data type to the beacon execution payload header
 // interface.
 func executableDataToExecutionPayloadHeader(
@@ -0,0 +1,176 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package server
+
+import (
+	"fmt"
+
+	types "github.com/berachain/beacon-kit/mod/cli/pkg/commands/server/types"
+	clicontext "github.com/berachain/beacon-kit/mod/cli/pkg/context"
+	"github.com/berachain/beacon-kit/mod/cli/pkg/flags"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/log"
+	nodetypes "github.com/berachain/beacon-kit/mod/node-core/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/json"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/db"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// flagHeight is the height of the exported state.
+	flagHeight = "height"
+	// flagOutputDocument is the file the exported genesis is written to.
+	flagOutputDocument = "output-document"
+)
+
+// NewExportCmd creates a command exporting the committed beacon state as a
+// genesis restarting the chain from it.
+func NewExportCmd[
+	T nodetypes.Node,
+	LoggerT log.AdvancedLogger[LoggerT],
+](
+	appCreator types.AppCreator[T, LoggerT],
+) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Exports the beacon state at a height as a new genesis",
+		Long: `Exports the beacon state committed at the --height height into the
+beacon section of the genesis file of the node, and sets the initial height
+of the genesis to the next height. The validators, balances, latest
+execution payload header, deposit and withdrawal indices, randao mixes and
+fork of the state are kept, so that a chain started from the genesis
+continues from the exported state. The genesis is written to stdout unless
+--output-document is set. The node must be stopped.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			height, err := cmd.Flags().GetInt64(flagHeight)
+			if err != nil {
+				return err
+			}
+			output, err := cmd.Flags().GetString(flagOutputDocument)
+			if err != nil {
+				return err
+			}
+
+			cfg := clicontext.GetConfigFromCmd(cmd)
+			appGenesis, err := genutiltypes.AppGenesisFromFile(
+				cfg.GenesisFile(),
+			)
+			if err != nil {
+				return fmt.Errorf("failed to read genesis file: %w", err)
+			}
+			beacon, err := exportGenesis(cmd, appCreator, height)
+			if err != nil {
+				return err
+			}
+			if err = setBeaconGenesis(appGenesis, beacon, height); err != nil {
+				return err
+			}
+
+			if output != "" {
+				return appGenesis.SaveAs(output)
+			}
+			bz, err := json.MarshalIndent(appGenesis, "", "  ")
+			if err != nil {
+				return err
+			}
+			_, err = fmt.Fprintln(cmd.OutOrStdout(), string(bz))
+			return err
+		},
+	}
+
+	cmd.Flags().Int64(flagHeight, 0, "height of the state to export")
+	cmd.Flags().String(
+		flagOutputDocument, "",
+		"file to write the genesis to (default stdout)",
+	)
+	flags.AddBeaconKitFlags(cmd)
+	if err := cmd.MarkFlagRequired(flagHeight); err != nil {
+		panic(err)
+	}
+	return cmd
+}
+
+// exportGenesis returns the beacon genesis holding the state committed at
+// height, read through the app. The application database is opened
+// read-only, so that an export neither mutates nor locks the store of a
+// running node.
+func exportGenesis[
+	T nodetypes.Node,
+	LoggerT log.AdvancedLogger[LoggerT],
+](
+	cmd *cobra.Command,
+	appCreator types.AppCreator[T, LoggerT],
+	height int64,
+) ([]byte, error) {
+	cfg := clicontext.GetConfigFromCmd(cmd)
+	v := clicontext.GetViperFromCmd(cmd)
+	dbCfg, err := db.ReadConfig(v)
+	if err != nil {
+		return nil, err
+	}
+	appDB, err := db.OpenDBReadOnly(cfg.RootDir, dbCfg)
+	if err != nil {
+		return nil, err
+	}
+	app := appCreator(
+		clicontext.GetLoggerFromCmd[LoggerT](cmd),
+		appDB,
+		nil,
+		cfg,
+		v,
+	)
+	exporter, ok := any(app).(nodetypes.StateExporter)
+	if !ok {
+		return nil, errors.Join(ErrExportUnsupported, appDB.Close())
+	}
+	beacon, err := exporter.ExportGenesis(height)
+	return beacon, errors.Join(err, appDB.Close())
+}
+
+// setBeaconGenesis replaces the beacon section of the app genesis and starts
+// the chain after height. The genesis validators are cleared, since the
+// validator set is derived from the exported state on InitChain.
+func setBeaconGenesis(
+	appGenesis *genutiltypes.AppGenesis,
+	beacon []byte,
+	height int64,
+) error {
+	appGenesisState, err := genutiltypes.GenesisStateFromAppGenesis(
+		appGenesis,
+	)
+	if err != nil {
+		return err
+	}
+	appGenesisState["beacon"] = beacon
+	if appGenesis.AppState, err = json.MarshalIndent(
+		appGenesisState, "", "  ",
+	); err != nil {
+		return err
+	}
+
+	appGenesis.InitialHeight = height + 1
+	if appGenesis.Consensus != nil {
+		appGenesis.Consensus.Validators = nil
+	}
+	return nil
+}
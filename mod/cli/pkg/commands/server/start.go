@@ -30,7 +30,6 @@ import (
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/db"
 	cmtcmd "github.com/cometbft/cometbft/cmd/cometbft/commands"
-	dbm "github.com/cosmos/cosmos-db"
 	"github.com/spf13/cobra"
 )
 
@@ -114,7 +113,11 @@ custom: allow pruning options to be manually specified through 'pruning-keep-rec
 			}
 
 			// Open the Database
-			db, err := db.OpenDB(cfg.RootDir, dbm.PebbleDBBackend)
+			dbCfg, err := db.ReadConfig(v)
+			if err != nil {
+				return err
+			}
+			db, err := db.OpenDB(cfg.RootDir, dbCfg)
 			if err != nil {
 				return err
 			}
@@ -21,11 +21,15 @@
 package commands
 
 import (
+	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/config"
+	dbcmd "github.com/berachain/beacon-kit/mod/cli/pkg/commands/db"
+	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/debug"
 	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/deposit"
 	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/genesis"
 	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/jwt"
 	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/server"
 	servertypes "github.com/berachain/beacon-kit/mod/cli/pkg/commands/server/types"
+	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/testnet"
 	"github.com/berachain/beacon-kit/mod/cli/pkg/flags"
 	cmtcli "github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/cli"
 	cometbft "github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service"
@@ -38,6 +42,7 @@ import (
 )
 
 // DefaultRootCommandSetup sets up the default commands for the root command.
+// The app template and config are those app.toml is rendered from.
 func DefaultRootCommandSetup[
 	T types.Node,
 	ExecutionPayloadT constraints.EngineType[ExecutionPayloadT],
@@ -47,19 +52,31 @@ func DefaultRootCommandSetup[
 	mm *cometbft.Service[LoggerT],
 	appCreator servertypes.AppCreator[T, LoggerT],
 	chainSpec common.ChainSpec,
+	appTemplate string,
+	appConfig any,
 ) {
 	// Add all the commands to the root command.
 	root.cmd.AddCommand(
 		// `comet`
 		cmtcli.Commands(appCreator),
+		// `config`
+		config.Commands(appTemplate, appConfig),
 		// `init`
 		genutilcli.InitCmd(mm),
+		// `export`
+		server.NewExportCmd(appCreator),
 		// `genesis`
 		genesis.Commands(chainSpec),
+		// `db`
+		dbcmd.Commands(),
+		// `debug`
+		debug.Commands(appCreator),
 		// `deposit`
 		deposit.Commands[ExecutionPayloadT](chainSpec),
 		// `jwt`
 		jwt.Commands(),
+		// `replay`
+		debug.NewReplayCmd(appCreator),
 		// `rollback`
 		server.NewRollbackCmd(appCreator),
 		// `start`
@@ -68,6 +85,8 @@ func DefaultRootCommandSetup[
 		}),
 		// `status`
 		cmtcli.StatusCommand(),
+		// `testnet`
+		testnet.Commands(chainSpec, appTemplate, appConfig),
 		// `version`
 		version.NewVersionCommand(),
 	)
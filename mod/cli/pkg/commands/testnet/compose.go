@@ -0,0 +1,97 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package testnet
+
+import (
+	"bytes"
+	"path/filepath"
+	"text/template"
+
+	"github.com/spf13/afero"
+)
+
+// composeFileName is the name of the docker-compose file of the testnet.
+const composeFileName = "docker-compose.yml"
+
+// composeTemplate runs every node of the testnet along with its execution
+// client. The testnet directory is mounted in all the containers, and host
+// ports are offset by the index of the node.
+const composeTemplate = `services:
+{{- range .Nodes }}
+  {{ .ExecutionName }}:
+    image: {{ $.ExecutionImage }}
+    entrypoint: ["/bin/sh", "-c"]
+    command:
+      - >-
+        geth init --datadir /data {{ $.Home }}/{{ $.EthGenesis }} &&
+        exec geth --datadir /data
+        --http --http.addr 0.0.0.0 --http.api eth,net
+        --authrpc.addr 0.0.0.0 --authrpc.vhosts '*'
+        --authrpc.jwtsecret {{ .JWTPath }}
+    volumes:
+      - ./:{{ $.Home }}
+      - ./{{ .ExecutionName }}:/data
+    ports:
+      - "{{ port $.EthRPCPort .Index }}:{{ $.EthRPCPort }}"
+  {{ .Name }}:
+    image: {{ $.BeacondImage }}
+    command: ["start", "--home", "{{ .Home }}"]
+{{- if $.ChainSpec }}
+    environment:
+      CHAIN_SPEC: {{ $.ChainSpec }}
+{{- end }}
+    volumes:
+      - ./:{{ $.Home }}
+    depends_on:
+      - {{ .ExecutionName }}
+    ports:
+      - "{{ port $.CometRPCPort .Index }}:{{ $.CometRPCPort }}"
+{{- end }}
+`
+
+// writeCompose writes the docker-compose file of the testnet.
+func writeCompose(cfg Config, nodes []node) error {
+	tmpl, err := template.New(composeFileName).Funcs(template.FuncMap{
+		"port": func(base, index int) int { return base + index },
+	}).Parse(composeTemplate)
+	if err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	if err = tmpl.Execute(&buf, map[string]any{
+		"Nodes":          nodes,
+		"Home":           containerHome,
+		"EthGenesis":     ethGenesisFileName,
+		"ChainSpec":      cfg.ChainSpec,
+		"BeacondImage":   cfg.BeacondImage,
+		"ExecutionImage": cfg.ExecutionImage,
+		"EthRPCPort":     ethRPCPort,
+		"CometRPCPort":   cometRPCPort,
+	}); err != nil {
+		return err
+	}
+	return afero.WriteFile(
+		afero.NewOsFs(),
+		filepath.Join(cfg.OutputDir, composeFileName),
+		buf.Bytes(), 0o644, //nolint:mnd // file permissions.
+	)
+}
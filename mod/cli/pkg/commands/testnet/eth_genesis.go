@@ -0,0 +1,103 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package testnet
+
+import (
+	"math/big"
+
+	gethprimitives "github.com/berachain/beacon-kit/mod/geth-primitives"
+	"github.com/berachain/beacon-kit/mod/geth-primitives/pkg/deposit"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+)
+
+const (
+	// ethGasLimit is the gas limit of the genesis block of the generated
+	// eth1 genesis.
+	ethGasLimit = 30_000_000
+	// devAccountBalance is the balance in wei the development account is
+	// funded with, 1e9 ether.
+	devAccountBalance = "1000000000000000000000000000"
+)
+
+// devAccount is the development account documented in the README, whose
+// private key is public. It is funded by the generated eth1 genesis and owns
+// the deposit contract.
+//
+//nolint:gochecknoglobals // constant address.
+var devAccount = common.NewExecutionAddressFromHex(
+	"0x20f33ce90a13a4b5e7697e3544c3083b8f8a51d4",
+)
+
+// NewEthGenesis returns an eth1 genesis for a testnet of the given chain
+// spec. Every fork up to Cancun is active from the genesis block, which
+// predeploys the deposit contract of the chain spec and the beacon roots
+// contract of EIP-4788 and funds the development account.
+func NewEthGenesis(cs common.ChainSpec) (*gethprimitives.Genesis, error) {
+	contract, err := deposit.GenesisAccount(
+		gethprimitives.ExecutionAddress(devAccount),
+	)
+	if err != nil {
+		return nil, err
+	}
+	balance, _ := new(big.Int).SetString(devAccountBalance, 10)
+
+	var genesisTime uint64
+	return &gethprimitives.Genesis{
+		Config: &gethprimitives.ChainConfig{
+			ChainID: new(big.Int).SetUint64(
+				cs.DepositEth1ChainID(),
+			),
+			HomesteadBlock:                big.NewInt(0),
+			EIP150Block:                   big.NewInt(0),
+			EIP155Block:                   big.NewInt(0),
+			EIP158Block:                   big.NewInt(0),
+			ByzantiumBlock:                big.NewInt(0),
+			ConstantinopleBlock:           big.NewInt(0),
+			PetersburgBlock:               big.NewInt(0),
+			IstanbulBlock:                 big.NewInt(0),
+			MuirGlacierBlock:              big.NewInt(0),
+			BerlinBlock:                   big.NewInt(0),
+			LondonBlock:                   big.NewInt(0),
+			ArrowGlacierBlock:             big.NewInt(0),
+			GrayGlacierBlock:              big.NewInt(0),
+			MergeNetsplitBlock:            big.NewInt(0),
+			ShanghaiTime:                  &genesisTime,
+			CancunTime:                    &genesisTime,
+			TerminalTotalDifficulty:       big.NewInt(0),
+			TerminalTotalDifficultyPassed: true,
+		},
+		GasLimit:   ethGasLimit,
+		Difficulty: big.NewInt(0),
+		Alloc: gethprimitives.GenesisAlloc{
+			gethprimitives.ExecutionAddress(
+				cs.DepositContractAddress(),
+			): contract,
+			gethprimitives.BeaconRootsAddress: {
+				Code:    gethprimitives.BeaconRootsCode,
+				Balance: new(big.Int),
+				Nonce:   1,
+			},
+			gethprimitives.ExecutionAddress(devAccount): {
+				Balance: balance,
+			},
+		},
+	}, nil
+}
@@ -0,0 +1,65 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package testnet
+
+const (
+	// validators is the flag for the number of validators of the testnet.
+	validators = "validators"
+
+	// output is the flag for the directory the testnet is generated in.
+	output = "output"
+
+	// chainID is the flag for the CometBFT chain ID of the testnet.
+	chainID = "chain-id"
+
+	// ethGenesis is the flag for the eth1 genesis file the execution clients
+	// are started from.
+	ethGenesis = "eth-genesis"
+
+	// trustedSetup is the flag for the KZG trusted setup file of the nodes.
+	trustedSetup = "kzg-trusted-setup"
+
+	// depositAmount is the flag for the amount of each premined deposit.
+	depositAmount = "deposit-amount"
+
+	// withdrawalAddress is the flag for the execution address premined
+	// deposits withdraw to.
+	withdrawalAddress = "withdrawal-address"
+
+	// beacondImage is the flag for the docker image of the beacon nodes.
+	beacondImage = "beacond-image"
+
+	// executionImage is the flag for the docker image of the execution
+	// clients.
+	executionImage = "execution-image"
+)
+
+const (
+	defaultValidators        = 4
+	defaultOutput            = "./testnet"
+	defaultChainID           = "beacond-2061"
+	defaultEthGenesis        = ""
+	defaultTrustedSetup      = "./testing/files/kzg-trusted-setup.json"
+	defaultDepositAmount     = "32000000000" // 32e9
+	defaultWithdrawalAddress = "0x0000000000000000000000000000000000000000"
+	defaultBeacondImage      = "ghcr.io/berachain/beacon-kit:latest"
+	defaultExecutionImage    = "ethereum/client-go:latest"
+)
@@ -0,0 +1,411 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package testnet
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	appconfig "github.com/berachain/beacon-kit/mod/cli/pkg/commands/config"
+	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/genesis"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
+	gethprimitives "github.com/berachain/beacon-kit/mod/geth-primitives"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/json"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/jwt"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	cmttypes "github.com/cometbft/cometbft/types"
+	sdkversion "github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/spf13/afero"
+	"github.com/spf13/viper"
+)
+
+const (
+	// containerHome is where the testnet directory is mounted in the
+	// containers of the docker-compose file.
+	containerHome = "/testnet"
+	// ethGenesisFileName is the name of the eth1 genesis file of the testnet.
+	ethGenesisFileName = "eth-genesis.json"
+	// trustedSetupFileName is the name of the KZG trusted setup file of the
+	// testnet.
+	trustedSetupFileName = "kzg-trusted-setup.json"
+	// jwtFileName is the name of the JWT secret shared by a node and its
+	// execution client.
+	jwtFileName = "jwt.hex"
+	// p2pPort is the port CometBFT nodes listen to their peers on.
+	p2pPort = 26656
+	// cometRPCPort is the port CometBFT nodes serve their RPC on.
+	cometRPCPort = 26657
+	// ethRPCPort is the port execution clients serve the JSON-RPC on.
+	ethRPCPort = 8545
+	// engineRPCPort is the port execution clients serve the engine API on.
+	engineRPCPort = 8551
+)
+
+// Config is the configuration of a generated testnet.
+type Config struct {
+	// Validators is the number of validator nodes.
+	Validators int
+	// OutputDir is the directory the testnet is generated in.
+	OutputDir string
+	// ChainID is the CometBFT chain ID.
+	ChainID string
+	// ChainSpec is the name of the chain spec the nodes run, if not the
+	// default one.
+	ChainSpec string
+	// EthGenesis is the eth1 genesis the execution clients are started from.
+	// If empty, one is generated for the chain spec with NewEthGenesis.
+	EthGenesis []byte
+	// TrustedSetup is the KZG trusted setup of the nodes.
+	TrustedSetup []byte
+	// DepositAmount is the amount of each premined deposit.
+	DepositAmount math.Gwei
+	// WithdrawalAddress is the execution address premined deposits withdraw
+	// to.
+	WithdrawalAddress common.ExecutionAddress
+	// BeacondImage is the docker image of the beacon nodes.
+	BeacondImage string
+	// ExecutionImage is the docker image of the execution clients.
+	ExecutionImage string
+}
+
+// node is a validator node of a generated testnet.
+type node struct {
+	// Index is the index of the node in the testnet.
+	Index int
+	// ID is the CometBFT node ID.
+	ID string
+}
+
+// Name returns the name of the node, used for its home directory and its
+// docker-compose service.
+func (n node) Name() string {
+	return fmt.Sprintf("node%d", n.Index)
+}
+
+// ExecutionName returns the name of the execution client of the node.
+func (n node) ExecutionName() string {
+	return fmt.Sprintf("el%d", n.Index)
+}
+
+// Home returns the home directory of the node in the containers.
+func (n node) Home() string {
+	return containerHome + "/" + n.Name()
+}
+
+// JWTPath returns the path of the JWT secret of the node in the containers.
+func (n node) JWTPath() string {
+	return n.Home() + "/config/" + jwtFileName
+}
+
+// Generate writes a testnet of cfg.Validators nodes to cfg.OutputDir. Each
+// node gets its own home directory holding its keys, a JWT secret shared
+// with its execution client and configuration files peering it with the
+// other nodes. The nodes share a genesis premining a deposit for each of
+// them on top of the genesis block of the eth1 genesis, and a
+// docker-compose file runs every node along with its execution client.
+// Unless given, the eth1 genesis is generated for the chain spec.
+func Generate(
+	cfg Config,
+	cometConfig *cmtcfg.Config,
+	cs common.ChainSpec,
+	appTemplate string,
+	appConfig any,
+) error {
+	if cfg.Validators <= 0 {
+		return ErrNoValidators
+	}
+
+	ethGenesis, ethGenesisBz, err := loadEthGenesis(cfg.EthGenesis, cs)
+	if err != nil {
+		return err
+	}
+
+	if err = makeOutputDir(cfg.OutputDir); err != nil {
+		return err
+	}
+	fs := afero.NewOsFs()
+	if err = afero.WriteFile(
+		fs, filepath.Join(cfg.OutputDir, ethGenesisFileName),
+		ethGenesisBz, 0o644, //nolint:mnd // file permissions.
+	); err != nil {
+		return err
+	}
+	if err = afero.WriteFile(
+		fs, filepath.Join(cfg.OutputDir, trustedSetupFileName),
+		cfg.TrustedSetup, 0o644, //nolint:mnd // file permissions.
+	); err != nil {
+		return err
+	}
+
+	// Generate the keys of the nodes along with their premined deposits.
+	beaconGenesis := types.DefaultGenesisDeneb()
+	nodes := make([]node, cfg.Validators)
+	for i := range nodes {
+		nodes[i].Index = i
+		deposit, err := initNode(
+			cfg, cometConfig, cs, &nodes[i], beaconGenesis.ForkVersion,
+		)
+		if err != nil {
+			return errors.Wrapf(err, "failed to initialize node %d", i)
+		}
+		//#nosec:G701 // won't realistically overflow.
+		deposit.Index = uint64(i)
+		beaconGenesis.Deposits = append(beaconGenesis.Deposits, deposit)
+	}
+
+	beaconGenesis.ExecutionPayloadHeader, err =
+		genesis.ExecutionPayloadHeaderFromEthGenesis(
+			ethGenesis,
+			version.ToUint32(beaconGenesis.ForkVersion),
+			cs.MaxWithdrawalsPerPayload(),
+		)
+	if err != nil {
+		return errors.Wrap(err, "failed to build execution payload header")
+	}
+	appGenesis, err := newAppGenesis(cfg.ChainID, beaconGenesis)
+	if err != nil {
+		return err
+	}
+
+	// Write the configuration of the nodes now that their IDs are known.
+	for _, n := range nodes {
+		if err = writeNodeConfig(
+			cometConfig, filepath.Join(cfg.OutputDir, n.Name()),
+			n, nodes, appGenesis, appTemplate, appConfig,
+		); err != nil {
+			return errors.Wrapf(err, "failed to configure node %d", n.Index)
+		}
+	}
+
+	return writeCompose(cfg, nodes)
+}
+
+// loadEthGenesis decodes the given eth1 genesis, which must predeploy the
+// deposit contract of the chain spec, or generates one if it is empty. It
+// returns the eth1 genesis along with its JSON encoding.
+func loadEthGenesis(
+	bz []byte,
+	cs common.ChainSpec,
+) (*gethprimitives.Genesis, []byte, error) {
+	if len(bz) == 0 {
+		ethGenesis, err := NewEthGenesis(cs)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "failed to build eth1 genesis")
+		}
+		bz, err = json.MarshalIndent(ethGenesis, "", "  ")
+		return ethGenesis, bz, err
+	}
+
+	ethGenesis := &gethprimitives.Genesis{}
+	if err := ethGenesis.UnmarshalJSON(bz); err != nil {
+		return nil, nil, errors.Wrap(err, "failed to unmarshal eth1 genesis")
+	}
+	contract, ok := ethGenesis.Alloc[gethprimitives.ExecutionAddress(
+		cs.DepositContractAddress(),
+	)]
+	if !ok || len(contract.Code) == 0 {
+		return nil, nil, ErrDepositContractMissing
+	}
+	return ethGenesis, bz, nil
+}
+
+// makeOutputDir creates the output directory of the testnet, refusing to
+// write over an existing testnet.
+func makeOutputDir(dir string) error {
+	entries, err := os.ReadDir(dir)
+	switch {
+	case os.IsNotExist(err):
+		return os.MkdirAll(dir, os.ModePerm)
+	case err != nil:
+		return err
+	case len(entries) > 0:
+		return errors.Wrapf(ErrOutputDirNotEmpty, "%q", dir)
+	default:
+		return nil
+	}
+}
+
+// initNode generates the CometBFT keys and the JWT secret of the given node
+// and returns its premined deposit.
+func initNode(
+	cfg Config,
+	cometConfig *cmtcfg.Config,
+	cs common.ChainSpec,
+	n *node,
+	forkVersion common.Version,
+) (*types.Deposit, error) {
+	cometConfig.SetRoot(filepath.Join(cfg.OutputDir, n.Name()))
+	cmtcfg.EnsureRoot(cometConfig.RootDir)
+
+	var err error
+	n.ID, _, err = genutil.InitializeNodeValidatorFiles(
+		cometConfig, crypto.CometBLSType,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	secret, err := jwt.NewRandom()
+	if err != nil {
+		return nil, err
+	}
+	if err = afero.WriteFile(
+		afero.NewOsFs(),
+		filepath.Join(cometConfig.RootDir, "config", jwtFileName),
+		[]byte(secret.Hex()), 0o600, //nolint:mnd // file permissions.
+	); err != nil {
+		return nil, err
+	}
+
+	blsSigner := signer.NewBLSSigner(
+		cometConfig.PrivValidatorKeyFile(),
+		cometConfig.PrivValidatorStateFile(),
+	)
+	forkData := types.NewForkData(forkVersion, common.Root{})
+	depositMsg, signature, err := types.CreateAndSignDepositMessage(
+		forkData,
+		cs.DomainTypeDeposit(),
+		blsSigner,
+		types.NewCredentialsFromExecutionAddress(cfg.WithdrawalAddress),
+		cfg.DepositAmount,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err = depositMsg.VerifyCreateValidator(
+		forkData,
+		signature,
+		cs.DomainTypeDeposit(),
+		signer.BLSSigner{}.VerifySignature,
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.Deposit{
+		Pubkey:      depositMsg.Pubkey,
+		Amount:      depositMsg.Amount,
+		Signature:   signature,
+		Credentials: depositMsg.Credentials,
+	}, nil
+}
+
+// newAppGenesis returns the genesis shared by the nodes of the testnet.
+func newAppGenesis(
+	chainID string,
+	beaconGenesis *types.Genesis[*types.Deposit, *types.ExecutionPayloadHeader],
+) (*genutiltypes.AppGenesis, error) {
+	beaconBz, err := json.Marshal(beaconGenesis)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal beacon genesis")
+	}
+	appState, err := json.MarshalIndent(
+		map[string]json.RawMessage{"beacon": beaconBz}, "", "  ",
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	appGenesis := genutiltypes.NewAppGenesisWithVersion(chainID, appState)
+	appGenesis.AppName = sdkversion.AppName
+	appGenesis.AppVersion = sdkversion.Version
+	appGenesis.InitialHeight = 1
+	appGenesis.Consensus = &genutiltypes.ConsensusGenesis{
+		Params: cmttypes.DefaultConsensusParams(),
+	}
+	appGenesis.Consensus.Params.Validator.PubKeyTypes = []string{
+		crypto.CometBLSType,
+	}
+	// Validate once so that the nodes share the same genesis time.
+	if err = appGenesis.ValidateAndComplete(); err != nil {
+		return nil, err
+	}
+	return appGenesis, nil
+}
+
+// writeNodeConfig writes the genesis, config.toml and app.toml of the given
+// node to its home directory.
+func writeNodeConfig(
+	cometConfig *cmtcfg.Config,
+	home string,
+	n node,
+	nodes []node,
+	appGenesis *genutiltypes.AppGenesis,
+	appTemplate string,
+	appConfig any,
+) error {
+	cometConfig.SetRoot(home)
+	if err := genutil.ExportGenesisFile(
+		appGenesis, cometConfig.GenesisFile(),
+	); err != nil {
+		return err
+	}
+
+	peers := make([]string, 0, len(nodes)-1)
+	for _, peer := range nodes {
+		if peer.Index != n.Index {
+			peers = append(
+				peers, fmt.Sprintf("%s@%s:%d", peer.ID, peer.Name(), p2pPort),
+			)
+		}
+	}
+	cometConfig.Moniker = n.Name()
+	cometConfig.P2P.ListenAddress = fmt.Sprintf("tcp://0.0.0.0:%d", p2pPort)
+	cometConfig.P2P.PersistentPeers = strings.Join(peers, ",")
+	cometConfig.P2P.AddrBookStrict = false
+	cometConfig.P2P.AllowDuplicateIP = true
+	cometConfig.RPC.ListenAddress = fmt.Sprintf(
+		"tcp://0.0.0.0:%d", cometRPCPort,
+	)
+	cmtcfg.WriteConfigFile(
+		filepath.Join(home, "config", "config.toml"), cometConfig,
+	)
+
+	// Render app.toml with the paths the node has in the containers.
+	overrides := viper.New()
+	overrides.Set(
+		"beacon-kit.engine.rpc-dial-url",
+		fmt.Sprintf("http://%s:%d", n.ExecutionName(), engineRPCPort),
+	)
+	overrides.Set("beacon-kit.engine.jwt-secret-path", n.JWTPath())
+	overrides.Set(
+		"beacon-kit.kzg.trusted-setup-path",
+		containerHome+"/"+trustedSetupFileName,
+	)
+	app, err := appconfig.Migrate(overrides, appTemplate, appConfig)
+	if err != nil {
+		return errors.Wrap(err, "failed to render app.toml")
+	}
+	return afero.WriteFile(
+		afero.NewOsFs(),
+		filepath.Join(home, "config", appconfig.AppConfigFileName),
+		app, 0o644, //nolint:mnd // file permissions.
+	)
+}
@@ -0,0 +1,137 @@
+//go:build bls12381
+
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package testnet_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/cli/pkg/builder"
+	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/testnet"
+	"github.com/berachain/beacon-kit/mod/config/pkg/spec"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	gethprimitives "github.com/berachain/beacon-kit/mod/geth-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/json"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerate(t *testing.T) {
+	cfg := testConfig(t)
+	require.NoError(t, testnet.Generate(
+		cfg,
+		builder.DefaultCometConfig(),
+		spec.DevnetChainSpec(),
+		builder.DefaultAppConfigTemplate(),
+		builder.DefaultAppConfig(),
+	))
+
+	// The eth1 genesis is generated with the deposit contract of the spec.
+	ethGenesisBz, err := os.ReadFile(
+		filepath.Join(cfg.OutputDir, "eth-genesis.json"),
+	)
+	require.NoError(t, err)
+	ethGenesis := &gethprimitives.Genesis{}
+	require.NoError(t, ethGenesis.UnmarshalJSON(ethGenesisBz))
+	contract := ethGenesis.Alloc[gethprimitives.ExecutionAddress(
+		spec.DevnetChainSpec().DepositContractAddress(),
+	)]
+	require.NotEmpty(t, contract.Code)
+	require.Len(t, contract.Storage, 1)
+
+	var genesisBz []byte
+	for i, name := range []string{"node0", "node1", "node2"} {
+		home := filepath.Join(cfg.OutputDir, name, "config")
+
+		// Every node starts from the same genesis.
+		bz, err := os.ReadFile(filepath.Join(home, "genesis.json"))
+		require.NoError(t, err)
+		if genesisBz == nil {
+			genesisBz = bz
+		}
+		require.Equal(t, genesisBz, bz)
+
+		for _, file := range []string{
+			"node_key.json", "priv_validator_key.json", "jwt.hex",
+		} {
+			require.FileExists(t, filepath.Join(home, file))
+		}
+
+		// Nodes peer with every other node.
+		comet, err := os.ReadFile(filepath.Join(home, "config.toml"))
+		require.NoError(t, err)
+		require.Contains(t, string(comet), `moniker = "`+name+`"`)
+		for j, peer := range []string{"node0", "node1", "node2"} {
+			require.Equal(
+				t, i != j, strings.Contains(string(comet), "@"+peer+":26656"),
+			)
+		}
+
+		// Nodes dial their own execution client.
+		app, err := os.ReadFile(filepath.Join(home, "app.toml"))
+		require.NoError(t, err)
+		require.Contains(t, string(app), "http://el"+name[4:]+":8551")
+		require.Contains(t, string(app), "/testnet/"+name+"/config/jwt.hex")
+	}
+
+	appGenesis, err := genutiltypes.AppGenesisFromFile(
+		filepath.Join(cfg.OutputDir, "node0", "config", "genesis.json"),
+	)
+	require.NoError(t, err)
+	require.Equal(t, cfg.ChainID, appGenesis.ChainID)
+	appState, err := genutiltypes.GenesisStateFromAppGenesis(appGenesis)
+	require.NoError(t, err)
+	beaconGenesis := &types.Genesis[
+		*types.Deposit, *types.ExecutionPayloadHeader,
+	]{}
+	require.NoError(t, json.Unmarshal(appState["beacon"], beaconGenesis))
+	require.Len(t, beaconGenesis.Deposits, 3)
+	for i, deposit := range beaconGenesis.Deposits {
+		require.Equal(t, uint64(i), deposit.Index)
+	}
+	require.Equal(
+		t,
+		common.ExecutionHash(ethGenesis.ToBlock().Hash()),
+		beaconGenesis.ExecutionPayloadHeader.BlockHash,
+	)
+
+	compose, err := os.ReadFile(
+		filepath.Join(cfg.OutputDir, "docker-compose.yml"),
+	)
+	require.NoError(t, err)
+	for _, service := range []string{"node0", "el0", "node2", "el2"} {
+		require.Contains(t, string(compose), "  "+service+":\n")
+	}
+
+	// An existing testnet is not written over.
+	require.ErrorIs(t, testnet.Generate(
+		cfg,
+		builder.DefaultCometConfig(),
+		spec.DevnetChainSpec(),
+		builder.DefaultAppConfigTemplate(),
+		builder.DefaultAppConfig(),
+	), testnet.ErrOutputDirNotEmpty)
+}
@@ -0,0 +1,184 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package testnet
+
+import (
+	"os"
+
+	"github.com/berachain/beacon-kit/mod/cli/pkg/context"
+	"github.com/berachain/beacon-kit/mod/cli/pkg/utils/parser"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+// Commands creates a new command for generating local testnets. The given
+// template and default configuration are the ones app.toml of the nodes is
+// rendered from.
+func Commands(
+	cs common.ChainSpec,
+	appTemplate string,
+	appConfig any,
+) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "testnet",
+		Short:                      "Local testnet subcommands",
+		DisableFlagParsing:         false,
+		SuggestionsMinimumDistance: 2, //nolint:mnd // from sdk.
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		NewInitCmd(cs, appTemplate, appConfig),
+	)
+
+	return cmd
+}
+
+// NewInitCmd creates a new command generating the files of a local testnet.
+func NewInitCmd(
+	cs common.ChainSpec,
+	appTemplate string,
+	appConfig any,
+) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "init",
+		Short: "Generates the files of a local testnet",
+		Long: `Generates the files of a local testnet of validator nodes. Each node
+gets a home directory holding its keys, a premined deposit, a JWT secret and
+configuration files peering it with the other nodes. The nodes share a genesis
+built on top of an eth1 genesis predeploying the deposit contract, which is
+generated for the chain spec unless given. A docker-compose file runs every
+node along with a geth execution client, with the output directory mounted at
+/testnet.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			cfg, err := configFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+			if err = Generate(
+				cfg, context.GetConfigFromCmd(cmd), cs, appTemplate, appConfig,
+			); err != nil {
+				return err
+			}
+			cmd.Printf(
+				"Generated a testnet of %d validators in %s\n",
+				cfg.Validators, cfg.OutputDir,
+			)
+			return nil
+		},
+	}
+
+	cmd.Flags().Int(
+		validators, defaultValidators, "Number of validators of the testnet",
+	)
+	cmd.Flags().String(
+		output, defaultOutput, "Directory the testnet is generated in",
+	)
+	cmd.Flags().String(chainID, defaultChainID, "Chain ID of the testnet")
+	cmd.Flags().String(
+		ethGenesis, defaultEthGenesis,
+		"Eth1 genesis file the execution clients are started from, "+
+			"generated for the chain spec if not set",
+	)
+	cmd.Flags().String(
+		trustedSetup, defaultTrustedSetup,
+		"KZG trusted setup file of the nodes",
+	)
+	cmd.Flags().String(
+		depositAmount, defaultDepositAmount,
+		"Amount of the premined deposit of each validator",
+	)
+	cmd.Flags().String(
+		withdrawalAddress, defaultWithdrawalAddress,
+		"Execution address the premined deposits withdraw to",
+	)
+	cmd.Flags().String(
+		beacondImage, defaultBeacondImage,
+		"Docker image of the beacon nodes",
+	)
+	cmd.Flags().String(
+		executionImage, defaultExecutionImage,
+		"Docker image of the execution clients",
+	)
+
+	return cmd
+}
+
+// configFromFlags returns the testnet configuration set by the flags of the
+// given command.
+func configFromFlags(cmd *cobra.Command) (Config, error) {
+	var (
+		cfg Config
+		err error
+	)
+	flags := cmd.Flags()
+	if cfg.Validators, err = flags.GetInt(validators); err != nil {
+		return cfg, err
+	}
+	if cfg.OutputDir, err = flags.GetString(output); err != nil {
+		return cfg, err
+	}
+	if cfg.ChainID, err = flags.GetString(chainID); err != nil {
+		return cfg, err
+	}
+	if cfg.BeacondImage, err = flags.GetString(beacondImage); err != nil {
+		return cfg, err
+	}
+	if cfg.ExecutionImage, err = flags.GetString(executionImage); err != nil {
+		return cfg, err
+	}
+	cfg.ChainSpec = os.Getenv(components.ChainSpecTypeEnvVar)
+
+	fs := afero.NewOsFs()
+	path, err := flags.GetString(ethGenesis)
+	if err != nil {
+		return cfg, err
+	}
+	if path != "" {
+		if cfg.EthGenesis, err = afero.ReadFile(fs, path); err != nil {
+			return cfg, err
+		}
+	}
+	if path, err = flags.GetString(trustedSetup); err != nil {
+		return cfg, err
+	}
+	if cfg.TrustedSetup, err = afero.ReadFile(fs, path); err != nil {
+		return cfg, err
+	}
+
+	amount, err := flags.GetString(depositAmount)
+	if err != nil {
+		return cfg, err
+	}
+	if cfg.DepositAmount, err = parser.ConvertAmount(amount); err != nil {
+		return cfg, err
+	}
+	address, err := flags.GetString(withdrawalAddress)
+	if err != nil {
+		return cfg, err
+	}
+	err = cfg.WithdrawalAddress.UnmarshalText([]byte(address))
+	return cfg, err
+}
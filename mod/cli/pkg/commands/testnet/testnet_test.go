@@ -0,0 +1,78 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package testnet_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/cli/pkg/builder"
+	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/testnet"
+	"github.com/berachain/beacon-kit/mod/config/pkg/spec"
+	"github.com/stretchr/testify/require"
+)
+
+// testConfig returns the configuration of a testnet of three validators
+// generated in a temporary directory.
+func testConfig(t *testing.T) testnet.Config {
+	t.Helper()
+	return testnet.Config{
+		Validators:     3,
+		OutputDir:      filepath.Join(t.TempDir(), "net"),
+		ChainID:        "beacond-2061",
+		TrustedSetup:   []byte("{}"),
+		DepositAmount:  32e9,
+		BeacondImage:   "beacond",
+		ExecutionImage: "geth",
+	}
+}
+
+func TestGenerateInvalid(t *testing.T) {
+	generate := func(cfg testnet.Config) error {
+		return testnet.Generate(
+			cfg,
+			builder.DefaultCometConfig(),
+			spec.DevnetChainSpec(),
+			builder.DefaultAppConfigTemplate(),
+			builder.DefaultAppConfig(),
+		)
+	}
+
+	cfg := testConfig(t)
+	cfg.Validators = 0
+	require.ErrorIs(t, generate(cfg), testnet.ErrNoValidators)
+
+	cfg = testConfig(t)
+	cfg.EthGenesis = []byte(
+		`{"config":{"chainId":80087},"gasLimit":"0x1c9c380",` +
+			`"difficulty":"0x0","alloc":{}}`,
+	)
+	require.ErrorIs(t, generate(cfg), testnet.ErrDepositContractMissing)
+	require.NoDirExists(t, cfg.OutputDir)
+
+	cfg = testConfig(t)
+	require.NoError(t, os.MkdirAll(cfg.OutputDir, 0o755))
+	require.NoError(t, os.WriteFile(
+		filepath.Join(cfg.OutputDir, "genesis.json"), []byte("{}"), 0o600,
+	))
+	require.ErrorIs(t, generate(cfg), testnet.ErrOutputDirNotEmpty)
+}
@@ -0,0 +1,174 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+// Package keygen derives BLS12-381 secret keys from a mnemonic as specified
+// by EIP-2333 and EIP-2334, so that validators derived here match the ones of
+// the staking-deposit-cli.
+package keygen
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/cosmos/go-bip39"
+	"golang.org/x/crypto/hkdf"
+)
+
+const (
+	// keygenSalt is the initial salt of HKDF_mod_r.
+	keygenSalt = "BLS-SIG-KEYGEN-SALT-"
+	// okmLength is the length L of the output keying material of
+	// HKDF_mod_r, ceil((3 * ceil(log2(r))) / 16).
+	okmLength = 48
+	// lamportChunks is the number of 32 byte chunks of a Lamport secret key.
+	lamportChunks = 255
+	// minSeedLength is the minimum length of a seed.
+	minSeedLength = 32
+	// pathPrefix is the master node of a derivation path.
+	pathPrefix = "m"
+)
+
+// SecretKey is a BLS12-381 secret key, in big-endian.
+type SecretKey [constants.BLSSecretKeyLength]byte
+
+//nolint:gochecknoglobals // constant.
+var curveOrder, _ = new(big.Int).SetString(
+	"5243587517512619047944774050818596583769055250052763782260365869993"+
+		"8581184513",
+	10,
+)
+
+// SeedFromMnemonic returns the BIP-39 seed of the given mnemonic and
+// password.
+func SeedFromMnemonic(mnemonic, password string) ([]byte, error) {
+	mnemonic = strings.Join(strings.Fields(mnemonic), " ")
+	if !bip39.IsMnemonicValid(mnemonic) {
+		return nil, ErrInvalidMnemonic
+	}
+	return bip39.NewSeed(mnemonic, password), nil
+}
+
+// ValidatorSigningKeyPath returns the EIP-2334 path of the signing key of the
+// validator at the given index.
+func ValidatorSigningKeyPath(index uint32) string {
+	return fmt.Sprintf("m/12381/3600/%d/0/0", index)
+}
+
+// DeriveSecretKey derives the secret key at the given path from the seed.
+func DeriveSecretKey(seed []byte, path string) (SecretKey, error) {
+	nodes := strings.Split(path, "/")
+	if nodes[0] != pathPrefix {
+		return SecretKey{}, errors.Wrapf(ErrInvalidPath, "%q", path)
+	}
+
+	sk, err := DeriveMasterSecretKey(seed)
+	if err != nil {
+		return SecretKey{}, err
+	}
+	for _, node := range nodes[1:] {
+		index, parseErr := strconv.ParseUint(node, 10, 32)
+		if parseErr != nil {
+			return SecretKey{}, errors.Wrapf(ErrInvalidPath, "%q", path)
+		}
+		sk = DeriveChildSecretKey(sk, uint32(index))
+	}
+	return sk, nil
+}
+
+// DeriveMasterSecretKey derives the master secret key from the seed.
+func DeriveMasterSecretKey(seed []byte) (SecretKey, error) {
+	if len(seed) < minSeedLength {
+		return SecretKey{}, ErrSeedTooShort
+	}
+	return hkdfModR(seed), nil
+}
+
+// DeriveChildSecretKey derives the child secret key at the given index from
+// its parent.
+func DeriveChildSecretKey(parent SecretKey, index uint32) SecretKey {
+	return hkdfModR(parentToLamportPK(parent, index))
+}
+
+// hkdfModR derives a secret key from the input keying material.
+func hkdfModR(ikm []byte) SecretKey {
+	salt := []byte(keygenSalt)
+	secret := append(append([]byte{}, ikm...), 0)
+	info := []byte{0, okmLength}
+	sk := new(big.Int)
+	for sk.Sign() == 0 {
+		hash := sha256.Sum256(salt)
+		salt = hash[:]
+		okm := make([]byte, okmLength)
+		mustRead(hkdf.New(sha256.New, secret, salt, info), okm)
+		sk.SetBytes(okm).Mod(sk, curveOrder)
+	}
+
+	var out SecretKey
+	sk.FillBytes(out[:])
+	return out
+}
+
+// parentToLamportPK returns the compressed Lamport public key the child at
+// the given index is derived from.
+func parentToLamportPK(parent SecretKey, index uint32) []byte {
+	salt := []byte{
+		byte(index >> 24), byte(index >> 16), byte(index >> 8), byte(index),
+	}
+	ikm := parent[:]
+	notIKM := make([]byte, len(ikm))
+	for i, b := range ikm {
+		notIKM[i] = ^b
+	}
+
+	lamportPK := make([]byte, 0, 2*lamportChunks*sha256.Size)
+	for _, lamportSK := range [][]byte{
+		ikmToLamportSK(ikm, salt), ikmToLamportSK(notIKM, salt),
+	} {
+		for i := 0; i < lamportChunks; i++ {
+			chunk := sha256.Sum256(
+				lamportSK[i*sha256.Size : (i+1)*sha256.Size],
+			)
+			lamportPK = append(lamportPK, chunk[:]...)
+		}
+	}
+	compressed := sha256.Sum256(lamportPK)
+	return compressed[:]
+}
+
+// ikmToLamportSK returns the concatenated chunks of a Lamport secret key.
+func ikmToLamportSK(ikm, salt []byte) []byte {
+	okm := make([]byte, lamportChunks*sha256.Size)
+	mustRead(hkdf.New(sha256.New, ikm, salt, nil), okm)
+	return okm
+}
+
+// mustRead fills buf from the HKDF reader, which only fails when more than
+// 255 blocks are read.
+func mustRead(r io.Reader, buf []byte) {
+	if _, err := io.ReadFull(r, buf); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,117 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keygen_test
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/cli/pkg/utils/keygen"
+	"github.com/stretchr/testify/require"
+)
+
+// Test vectors of EIP-2333.
+func TestDeriveSecretKey(t *testing.T) {
+	tests := []struct {
+		seed     string
+		masterSK string
+		index    uint32
+		childSK  string
+	}{
+		{
+			seed: "c55257c360c07c72029aebc1b53c05ed0362ada38ead3e3e9efa3708e5349" +
+				"5531f09a6987599d18264c1e1c92f2cf141630c7a3c4ab7c81b2f001698e7463b04",
+			masterSK: "6083874454709270928345386274498605044986640685124978867" +
+				"557563392430687146096",
+			index: 0,
+			childSK: "2039778985973665094231741226247255810787539217244407679" +
+				"2671091975210932703118",
+		},
+		{
+			seed: "3141592653589793238462643383279502884197169399375105820974944592",
+			masterSK: "2975702064796130743148050453533656267828250541914101293" +
+				"3316116377660817309383",
+			index: 3141592653,
+			childSK: "2545720168885069194772762938519170451674479611492589796" +
+				"2676248250929345014287",
+		},
+		{
+			seed: "0099ff991111002299dd7744ee3355bbdd8844115566cc55663355668888cc00",
+			masterSK: "2758084229186979244294244877567472229980372064844544868" +
+				"6099262467207037398656",
+			index: 4294967295,
+			childSK: "2935861079445942886040223434187428124080378629406203587" +
+				"4021252734817515685787",
+		},
+	}
+
+	for _, tt := range tests {
+		seed, err := hex.DecodeString(tt.seed)
+		require.NoError(t, err)
+
+		master, err := keygen.DeriveMasterSecretKey(seed)
+		require.NoError(t, err)
+		require.Equal(t, tt.masterSK, new(big.Int).SetBytes(master[:]).String())
+
+		child := keygen.DeriveChildSecretKey(master, tt.index)
+		require.Equal(t, tt.childSK, new(big.Int).SetBytes(child[:]).String())
+	}
+}
+
+func TestSeedFromMnemonic(t *testing.T) {
+	// The seed of the first test vector of EIP-2333.
+	seed, err := keygen.SeedFromMnemonic(
+		"abandon abandon abandon abandon abandon abandon abandon abandon "+
+			"abandon abandon abandon about",
+		"TREZOR",
+	)
+	require.NoError(t, err)
+	require.Equal(t,
+		"c55257c360c07c72029aebc1b53c05ed0362ada38ead3e3e9efa3708e5349553"+
+			"1f09a6987599d18264c1e1c92f2cf141630c7a3c4ab7c81b2f001698e7463b04",
+		hex.EncodeToString(seed),
+	)
+
+	_, err = keygen.SeedFromMnemonic("abandon abandon", "")
+	require.ErrorIs(t, err, keygen.ErrInvalidMnemonic)
+}
+
+func TestDeriveSecretKeyPath(t *testing.T) {
+	seed := make([]byte, 32)
+	master, err := keygen.DeriveMasterSecretKey(seed)
+	require.NoError(t, err)
+
+	expected := master
+	for _, index := range []uint32{12381, 3600, 7, 0, 0} {
+		expected = keygen.DeriveChildSecretKey(expected, index)
+	}
+	sk, err := keygen.DeriveSecretKey(seed, keygen.ValidatorSigningKeyPath(7))
+	require.NoError(t, err)
+	require.Equal(t, expected, sk)
+
+	_, err = keygen.DeriveSecretKey(seed, "12381/3600/0/0/0")
+	require.ErrorIs(t, err, keygen.ErrInvalidPath)
+	_, err = keygen.DeriveSecretKey(seed, "m/12381/-1")
+	require.ErrorIs(t, err, keygen.ErrInvalidPath)
+	_, err = keygen.DeriveMasterSecretKey(seed[:31])
+	require.ErrorIs(t, err, keygen.ErrSeedTooShort)
+}
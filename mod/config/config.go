@@ -21,6 +21,8 @@
 package config
 
 import (
+	"fmt"
+
 	"github.com/berachain/beacon-kit/mod/beacon/validator"
 	"github.com/berachain/beacon-kit/mod/config/pkg/template"
 	viperlib "github.com/berachain/beacon-kit/mod/config/pkg/viper"
@@ -31,10 +33,15 @@ import (
 	blockstore "github.com/berachain/beacon-kit/mod/node-api/block_store"
 	"github.com/berachain/beacon-kit/mod/node-api/server"
 	"github.com/berachain/beacon-kit/mod/payload/pkg/builder"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/db"
 	"github.com/mitchellh/mapstructure"
 	"github.com/spf13/viper"
 )
 
+// ErrInvalidConfig is returned when the configuration contains values that
+// cannot be used.
+var ErrInvalidConfig = errors.New("invalid configuration")
+
 // AppOptions is from the SDK, we should look to remove its usage.
 type AppOptions interface {
 	Get(string) interface{}
@@ -50,6 +57,7 @@ func DefaultConfig() *Config {
 		Validator:         validator.DefaultConfig(),
 		BlockStoreService: blockstore.DefaultConfig(),
 		NodeAPI:           server.DefaultConfig(),
+		Storage:           db.DefaultConfig(),
 	}
 }
 
@@ -69,6 +77,8 @@ type Config struct {
 	BlockStoreService blockstore.Config `mapstructure:"block-store-service"`
 	// NodeAPI is the configuration for the node API.
 	NodeAPI server.Config `mapstructure:"node-api"`
+	// Storage is the configuration for the databases of the node.
+	Storage db.Config `mapstructure:"storage"`
 }
 
 // GetEngine returns the execution client configuration.
@@ -81,6 +91,48 @@ func (c Config) GetLogger() *log.Config {
 	return &c.Logger
 }
 
+// Validate returns an error describing every invalid field of the
+// configuration, each prefixed with its full key in the configuration file.
+func (c Config) Validate() error {
+	sections := []struct {
+		name     string
+		validate func() error
+	}{
+		{"engine", c.Engine.Validate},
+		{"logger", c.Logger.Validate},
+		{"kzg", c.KZG.Validate},
+		{"payload-builder", c.PayloadBuilder.Validate},
+		{"validator", c.Validator.Validate},
+		{"block-store-service", c.BlockStoreService.Validate},
+		{"node-api", c.NodeAPI.Validate},
+		{"storage", c.Storage.Validate},
+	}
+	var errs []error
+	for _, section := range sections {
+		for _, err := range unjoin(section.validate()) {
+			errs = append(errs, fmt.Errorf(
+				"beacon-kit.%s.%w", section.name, err,
+			))
+		}
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%w:\n%w", ErrInvalidConfig, errors.Join(errs...))
+}
+
+// unjoin returns the errors joined in err, or err itself if it does not wrap
+// multiple errors.
+func unjoin(err error) []error {
+	if err == nil {
+		return nil
+	}
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		return joined.Unwrap()
+	}
+	return []error{err}
+}
+
 // Template returns the configuration template.
 func (c Config) Template() string {
 	return template.TomlTemplate
@@ -96,6 +148,18 @@ func MustReadConfigFromAppOpts(opts AppOptions) *Config {
 	return cfg
 }
 
+// DecodeHook returns the hook used to decode the string values of the
+// configuration file into the fields of the configuration.
+func DecodeHook() mapstructure.DecodeHookFunc {
+	return mapstructure.ComposeDecodeHookFunc(
+		mapstructure.StringToTimeDurationHookFunc(),
+		mapstructure.StringToSliceHookFunc(","),
+		viperlib.StringToExecutionAddressFunc(),
+		viperlib.StringToDialURLFunc(),
+		viperlib.StringToConnectionURLFunc(),
+	)
+}
+
 // ReadConfigFromAppOpts reads the configuration options from the given
 // application options.
 func ReadConfigFromAppOpts(opts AppOptions) (*Config, error) {
@@ -108,16 +172,12 @@ func ReadConfigFromAppOpts(opts AppOptions) (*Config, error) {
 		BeaconKit Config `mapstructure:"beacon-kit"`
 	}
 	cfg := cfgUnmarshaller{}
-	if err := v.Unmarshal(&cfg,
-		viper.DecodeHook(mapstructure.ComposeDecodeHookFunc(
-			mapstructure.StringToTimeDurationHookFunc(),
-			mapstructure.StringToSliceHookFunc(","),
-			viperlib.StringToExecutionAddressFunc(),
-			viperlib.StringToDialURLFunc(),
-			viperlib.StringToConnectionURLFunc(),
-		))); err != nil {
+	if err := v.Unmarshal(&cfg, viper.DecodeHook(DecodeHook())); err != nil {
 		return nil, err
 	}
 
+	if err := cfg.BeaconKit.Validate(); err != nil {
+		return nil, err
+	}
 	return &cfg.BeaconKit, nil
 }
@@ -26,7 +26,10 @@ import (
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
-// DevnetChainSpec is the ChainSpec for the localnet.
+// DevnetChainSpec is the ChainSpec for the localnet, which enables rewards
+// and inactivity penalties.
+//
+//nolint:mnd // devnet values.
 func DevnetChainSpec() chain.Spec[
 	common.DomainType,
 	math.Epoch,
@@ -36,5 +39,7 @@ func DevnetChainSpec() chain.Spec[
 ] {
 	testnetSpec := BaseSpec()
 	testnetSpec.DepositEth1ChainID = DevnetEth1ChainID
+	testnetSpec.BaseRewardFactor = 64
+	testnetSpec.InactivityPenaltyQuotient = 1 << 26
 	return chain.NewChainSpec(testnetSpec)
 }
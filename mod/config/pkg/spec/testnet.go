@@ -64,6 +64,10 @@ func BaseSpec() chain.SpecData[
 		MaxEffectiveBalance:       uint64(32e9),
 		EjectionBalance:           uint64(16e9),
 		EffectiveBalanceIncrement: uint64(1e9),
+		// Effective balance hysteresis values.
+		HysteresisQuotient:           4,
+		HysteresisDownwardMultiplier: 1,
+		HysteresisUpwardMultiplier:   5,
 		// Time parameters constants.
 		SlotsPerEpoch:                32,
 		MinEpochsToInactivityPenalty: 4,
@@ -93,6 +97,9 @@ func BaseSpec() chain.SpecData[
 		DomainTypeApplicationMask: common.DomainType{
 			0x00, 0x00, 0x00, 0x01,
 		},
+		DomainTypeBLSToExecutionChange: common.DomainType{
+			0x0a, 0x00, 0x00, 0x00,
+		},
 		// Eth1-related values.
 		DepositContractAddress: common.NewExecutionAddressFromHex(
 			"0x4242424242424242424242424242424242424242",
@@ -108,10 +115,27 @@ func BaseSpec() chain.SpecData[
 		EpochsPerSlashingsVector:  8,
 		HistoricalRootsLimit:      8,
 		ValidatorRegistryLimit:    1099511627776,
+		ValidatorSetCap:           256,
+		// Validator cycle values.
+		MinPerEpochChurnLimit:            uint64(128e9),
+		MaxPerEpochActivationChurnLimit:  uint64(256e9),
+		ChurnLimitQuotient:               65536,
+		MinValidatorWithdrawabilityDelay: 256,
+		ShardCommitteePeriod:             256,
+		ActivationQueueEpoch:             0,
 		// Max operations per block constants.
-		MaxDepositsPerBlock: 16,
+		MaxDepositsPerBlock:              16,
+		MaxProposerSlashingsPerBlock:     16,
+		MaxVoluntaryExitsPerBlock:        16,
+		MaxBlsToExecutionChangesPerBlock: 16,
 		// Slashing
 		ProportionalSlashingMultiplier: 1,
+		MinSlashingPenaltyQuotient:     32,
+		// Rewards and penalties, which are disabled unless a network opts
+		// in, as enabling them changes the state transition.
+		BaseRewardFactor:          0,
+		ProposerRewardQuotient:    8,
+		InactivityPenaltyQuotient: 0,
 		// Capella values.
 		MaxWithdrawalsPerPayload:         16,
 		MaxValidatorsPerWithdrawalsSweep: 1 << 14,
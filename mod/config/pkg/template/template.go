@@ -25,6 +25,11 @@ const TomlTemplate = `
 ###############################################################################
 ###                                BeaconKit                                ###
 ###############################################################################
+# The log-level, module-levels, graffiti, suggested-fee-recipient,
+# availability-window and node-api rate-limit settings are reloaded without a
+# restart when beacond receives SIGHUP. Run "beacond config diff" to compare
+# this file against the current defaults and "beacond config migrate" to
+# update it.
 
 [beacon-kit.engine]
 # HTTP url of the execution client JSON-RPC endpoint.
@@ -53,9 +58,35 @@ time-format = "{{.BeaconKit.Logger.TimeFormat}}"
 # to LogLevel.
 log-level = "{{.BeaconKit.Logger.LogLevel}}"
 
-# Style is the style of the logger.
+# Style is the style of the logger. Options are "pretty" or "json".
 style = "{{.BeaconKit.Logger.Style}}"
 
+# ModuleLevels overrides log-level for individual services, as a comma
+# separated list of service=level pairs, e.g. "blockchain=debug,engine=warn".
+module-levels = "{{.BeaconKit.Logger.ModuleLevels}}"
+
+# SamplingInitial is the number of identical debug or info messages logged
+# per sampling interval before sampling kicks in. 0 disables sampling.
+sampling-initial = {{.BeaconKit.Logger.SamplingInitial}}
+
+# SamplingThereafter logs every Nth identical message once sampling-initial
+# has been reached within a sampling interval.
+sampling-thereafter = {{.BeaconKit.Logger.SamplingThereafter}}
+
+# SamplingInterval is the window over which identical messages are counted.
+sampling-interval = "{{.BeaconKit.Logger.SamplingInterval}}"
+
+# FilePath is the path of a file to additionally write JSON logs to. File
+# output is disabled if empty.
+file-path = "{{.BeaconKit.Logger.FilePath}}"
+
+# FileMaxSize is the maximum size in megabytes of the log file before it gets
+# rotated.
+file-max-size = {{.BeaconKit.Logger.FileMaxSize}}
+
+# FileMaxBackups is the maximum number of rotated log files to retain.
+file-max-backups = {{.BeaconKit.Logger.FileMaxBackups}}
+
 [beacon-kit.kzg]
 # Path to the trusted setup path.
 trusted-setup-path = "{{.BeaconKit.KZG.TrustedSetupPath}}"
@@ -101,4 +132,95 @@ address = "{{ .BeaconKit.NodeAPI.Address }}"
 
 # Logging determines if the node API logging is enabled.
 logging = "{{ .BeaconKit.NodeAPI.Logging }}"
+
+# AdminToken is the bearer token required by the admin API under
+# /bkit/v1/admin. The admin API is disabled if empty.
+admin-token = "{{ .BeaconKit.NodeAPI.AdminToken }}"
+
+# MaxBodySize is the maximum size of a request body, e.g. "2M".
+max-body-size = "{{ .BeaconKit.NodeAPI.MaxBodySize }}"
+
+# MaxHeaderBytes is the maximum size of the request headers in bytes.
+max-header-bytes = "{{ .BeaconKit.NodeAPI.MaxHeaderBytes }}"
+
+# ResponseCacheSize is the number of responses to state queries kept in the
+# response cache, which is cleared whenever a block is finalized. The cache is
+# disabled if set to 0.
+response-cache-size = "{{ .BeaconKit.NodeAPI.ResponseCacheSize }}"
+
+# TrustedProxies is a comma separated list of the CIDR ranges of the proxies
+# whose X-Forwarded-For header identifies the client for rate limiting. If
+# empty, the client is the peer of the connection and the header is ignored.
+trusted-proxies = "{{ range $i, $p := .BeaconKit.NodeAPI.TrustedProxies }}{{ if $i }},{{ end }}{{ $p }}{{ end }}"
+
+[beacon-kit.node-api.auth]
+# Token is a static bearer token accepted by the protected route groups.
+# Authentication is enabled if either token or jwt-secret-path is set.
+token = "{{ .BeaconKit.NodeAPI.Auth.Token }}"
+
+# JWTSecretPath is the path to a hex encoded secret used to verify HS256 signed
+# bearer tokens.
+jwt-secret-path = "{{ .BeaconKit.NodeAPI.Auth.JWTSecretPath }}"
+
+# Groups is a comma separated list of route path prefixes that require
+# authentication. The admin API is protected by admin-token instead.
+groups = "{{ range $i, $g := .BeaconKit.NodeAPI.Auth.Groups }}{{ if $i }},{{ end }}{{ $g }}{{ end }}"
+
+[beacon-kit.node-api.rate-limit]
+# Enabled determines if requests are rate limited per client IP.
+enabled = "{{ .BeaconKit.NodeAPI.RateLimit.Enabled }}"
+
+# RequestsPerSecond is the sustained request rate allowed per client.
+requests-per-second = "{{ .BeaconKit.NodeAPI.RateLimit.RequestsPerSecond }}"
+
+# Burst is the number of requests a client may issue at once.
+burst = "{{ .BeaconKit.NodeAPI.RateLimit.Burst }}"
+
+# ExpiresIn is the duration after which an idle client is forgotten.
+expires-in = "{{ .BeaconKit.NodeAPI.RateLimit.ExpiresIn }}"
+
+[beacon-kit.node-api.cors]
+# AllowedOrigins is a comma separated list of origins allowed to make
+# cross-origin requests. "*" allows all origins.
+allowed-origins = "{{ range $i, $o := .BeaconKit.NodeAPI.CORS.AllowedOrigins }}{{ if $i }},{{ end }}{{ $o }}{{ end }}"
+
+[beacon-kit.node-api.tls]
+# CertPath and KeyPath are the paths to the PEM encoded certificate and private
+# key. The node API is served over TLS if both are set.
+cert-path = "{{ .BeaconKit.NodeAPI.TLS.CertPath }}"
+key-path = "{{ .BeaconKit.NodeAPI.TLS.KeyPath }}"
+
+# ReloadInterval is the interval at which the certificate files are checked
+# for changes and reloaded.
+reload-interval = "{{ .BeaconKit.NodeAPI.TLS.ReloadInterval }}"
+
+[beacon-kit.storage]
+# Backend is the database backend of the application state, one of pebbledb,
+# goleveldb or memdb. An existing database is converted to another backend with
+# beacond db migrate.
+backend = "{{ .BeaconKit.Storage.Backend }}"
+
+# CacheSize is the size in MiB of the block cache.
+cache-size = "{{ .BeaconKit.Storage.CacheSize }}"
+
+# WriteBufferSize is the size in MiB of the memtable writes are buffered in
+# before being flushed to disk.
+write-buffer-size = "{{ .BeaconKit.Storage.WriteBufferSize }}"
+
+# MaxOpenFiles is the maximum number of files kept open, 0 for the default of
+# the backend.
+max-open-files = "{{ .BeaconKit.Storage.MaxOpenFiles }}"
+
+# MaxConcurrentCompactions is the maximum number of compactions run at once.
+# Only used by pebbledb.
+max-concurrent-compactions = "{{ .BeaconKit.Storage.MaxConcurrentCompactions }}"
+
+# DisableAutomaticCompactions disables background compactions. Only used by
+# pebbledb.
+disable-automatic-compactions = "{{ .BeaconKit.Storage.DisableAutomaticCompactions }}"
+
+# BlobStore is the layout of the blob availability store, either "file" for a
+# file per blob sidecar or "kv" for a single key-value database using the
+# backend above.
+blob-store = "{{ .BeaconKit.Storage.BlobStore }}"
 `
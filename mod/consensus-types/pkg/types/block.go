@@ -25,6 +25,7 @@ import (
 
 	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/json"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 	fastssz "github.com/ferranbt/fastssz"
@@ -59,7 +60,8 @@ func (b *BeaconBlock) NewWithVersion(
 	parentBlockRoot common.Root,
 	forkVersion uint32,
 ) (*BeaconBlock, error) {
-	if forkVersion == version.Deneb {
+	switch forkVersion {
+	case version.Deneb:
 		return &BeaconBlock{
 			Slot:          slot,
 			ProposerIndex: proposerIndex,
@@ -67,6 +69,17 @@ func (b *BeaconBlock) NewWithVersion(
 			StateRoot:     common.Root{},
 			Body:          &BeaconBlockBody{},
 		}, nil
+	case version.Electra:
+		return &BeaconBlock{
+			Slot:          slot,
+			ProposerIndex: proposerIndex,
+			ParentRoot:    parentBlockRoot,
+			StateRoot:     common.Root{},
+			Body: &BeaconBlockBody{
+				ExecutionRequests: new(ExecutionRequests),
+				forkVersion:       forkVersion,
+			},
+		}, nil
 	}
 
 	return nil, errors.Wrap(
@@ -80,9 +93,17 @@ func (b *BeaconBlock) NewFromSSZ(
 	bz []byte,
 	forkVersion uint32,
 ) (*BeaconBlock, error) {
-	if forkVersion == version.Deneb {
+	switch forkVersion {
+	case version.Deneb:
 		block := &BeaconBlock{}
 		return block, block.UnmarshalSSZ(bz)
+	case version.Electra:
+		// The body is allocated upfront so that it decodes the fields of
+		// its fork version.
+		block := &BeaconBlock{
+			Body: &BeaconBlockBody{forkVersion: forkVersion},
+		}
+		return block, block.UnmarshalSSZ(bz)
 	}
 
 	return nil, errors.Wrap(
@@ -91,6 +112,37 @@ func (b *BeaconBlock) NewFromSSZ(
 	)
 }
 
+// NewFromJSON creates a new beacon block from the given JSON bytes. The fork
+// version of the body is not part of the JSON encoding, so it is derived
+// from the slot of the block.
+func (b *BeaconBlock) NewFromJSON(
+	bz []byte,
+	cs common.ChainSpec,
+) (*BeaconBlock, error) {
+	block := &BeaconBlock{}
+	if err := json.Unmarshal(bz, block); err != nil {
+		return nil, err
+	}
+	if block.Body == nil {
+		block.Body = &BeaconBlockBody{}
+	}
+	switch forkVersion := cs.ActiveForkVersionForSlot(block.Slot); forkVersion {
+	case version.Deneb:
+		block.Body.ExecutionRequests = nil
+	case version.Electra:
+		if block.Body.ExecutionRequests == nil {
+			block.Body.ExecutionRequests = new(ExecutionRequests)
+		}
+		block.Body.forkVersion = forkVersion
+	default:
+		return nil, errors.Wrap(
+			ErrForkVersionNotSupported,
+			fmt.Sprintf("fork %d", forkVersion),
+		)
+	}
+	return block, nil
+}
+
 /* -------------------------------------------------------------------------- */
 /*                                     SSZ                                    */
 /* -------------------------------------------------------------------------- */
@@ -121,18 +173,27 @@ func (b *BeaconBlock) DefineSSZ(codec *ssz.Codec) {
 
 // MarshalSSZ marshals the BeaconBlock object to SSZ format.
 func (b *BeaconBlock) MarshalSSZ() ([]byte, error) {
-	buf := make([]byte, ssz.Size(b))
-	return buf, ssz.EncodeToBytes(buf, b)
+	buf := make([]byte, ssz.SizeOnFork(b, b.sszFork()))
+	return buf, ssz.EncodeToBytesOnFork(buf, b, b.sszFork())
 }
 
 // UnmarshalSSZ unmarshals the BeaconBlock object from SSZ format.
 func (b *BeaconBlock) UnmarshalSSZ(buf []byte) error {
-	return ssz.DecodeFromBytes(buf, b)
+	return ssz.DecodeFromBytesOnFork(buf, b, b.sszFork())
 }
 
 // HashTreeRoot computes the Merkleization of the BeaconBlock object.
 func (b *BeaconBlock) HashTreeRoot() common.Root {
-	return ssz.HashConcurrent(b)
+	return ssz.HashConcurrentOnFork(b, b.sszFork())
+}
+
+// sszFork returns the SSZ fork the block is encoded with, which is the one
+// of its body.
+func (b *BeaconBlock) sszFork() ssz.Fork {
+	if b.Body == nil {
+		return ssz.ForkDeneb
+	}
+	return b.Body.sszFork()
 }
 
 /* -------------------------------------------------------------------------- */
@@ -207,7 +268,10 @@ func (b *BeaconBlock) GetStateRoot() common.Root {
 
 // Version identifies the version of the BeaconBlock.
 func (b *BeaconBlock) Version() uint32 {
-	return version.Deneb
+	if b.Body == nil {
+		return version.Deneb
+	}
+	return b.Body.Version()
 }
 
 // SetStateRoot sets the state root of the BeaconBlock.
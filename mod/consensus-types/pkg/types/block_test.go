@@ -21,6 +21,7 @@
 package types_test
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
@@ -198,3 +199,122 @@ func TestBeaconBlock_GetTree(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, tree)
 }
+
+func TestBeaconBlockElectra_MarshalUnmarshalSSZ(t *testing.T) {
+	block, err := (&types.BeaconBlock{}).NewWithVersion(
+		10, 5, common.Root{1, 2, 3, 4, 5}, version.Electra,
+	)
+	require.NoError(t, err)
+	require.Equal(t, version.Electra, block.Version())
+
+	deneb := generateValidBeaconBlock()
+	block.Body.ExecutionPayload = deneb.Body.ExecutionPayload
+	block.Body.Eth1Data = deneb.Body.Eth1Data
+	block.Body.Deposits = deneb.Body.Deposits
+	block.Body.BlobKzgCommitments = deneb.Body.BlobKzgCommitments
+	block.Body.SetBlsToExecutionChanges(
+		[]*types.SignedBLSToExecutionChange{{
+			Message: &types.BLSToExecutionChange{
+				ValidatorIndex:     3,
+				ToExecutionAddress: common.ExecutionAddress{1},
+			},
+		}},
+	)
+	block.Body.SetVoluntaryExits([]*types.SignedVoluntaryExit{{
+		Message: &types.VoluntaryExit{Epoch: 1, ValidatorIndex: 4},
+	}})
+	block.Body.SetProposerSlashings([]*types.ProposerSlashing{{
+		SignedHeader1: &types.SignedBeaconBlockHeader{
+			Header: &types.BeaconBlockHeader{Slot: 7, ProposerIndex: 2},
+		},
+		SignedHeader2: &types.SignedBeaconBlockHeader{
+			Header: &types.BeaconBlockHeader{Slot: 7, ProposerIndex: 2},
+		},
+	}})
+	block.Body.SetExecutionRequests(&types.ExecutionRequests{
+		Withdrawals: []*types.WithdrawalRequest{
+			{SourceAddress: common.ExecutionAddress{2}, Amount: 1},
+		},
+		Consolidations: []*types.ConsolidationRequest{},
+	})
+
+	sszBlock, err := block.MarshalSSZ()
+	require.NoError(t, err)
+
+	// The Electra fields are not part of a Deneb encoding.
+	denebBlock, err := deneb.MarshalSSZ()
+	require.NoError(t, err)
+	require.Greater(t, len(sszBlock), len(denebBlock))
+
+	decoded, err := (&types.BeaconBlock{}).NewFromSSZ(
+		sszBlock, version.Electra,
+	)
+	require.NoError(t, err)
+	require.Equal(t, block.HashTreeRoot(), decoded.HashTreeRoot())
+	require.Equal(t,
+		block.Body.GetProposerSlashings(),
+		decoded.Body.GetProposerSlashings(),
+	)
+	require.Equal(t,
+		block.Body.GetVoluntaryExits(), decoded.Body.GetVoluntaryExits(),
+	)
+	require.Equal(t,
+		block.Body.GetBlsToExecutionChanges(),
+		decoded.Body.GetBlsToExecutionChanges(),
+	)
+	require.Equal(t,
+		block.Body.GetExecutionRequests().GetWithdrawals(),
+		decoded.Body.GetExecutionRequests().GetWithdrawals(),
+	)
+
+	// The proof tree must commit to the same fields as the hash tree root.
+	tree, err := block.Body.GetTree()
+	require.NoError(t, err)
+	require.Equal(t, block.Body.HashTreeRoot(), common.Root(tree.Hash()))
+	require.Len(t, block.Body.GetTopLevelRoots(), int(block.Body.Length()))
+}
+
+// forkSpec is a chain spec that activates Electra at electraSlot.
+type forkSpec struct {
+	common.ChainSpec
+	electraSlot math.Slot
+}
+
+func (s forkSpec) ActiveForkVersionForSlot(slot math.Slot) uint32 {
+	if slot >= s.electraSlot {
+		return version.Electra
+	}
+	return version.Deneb
+}
+
+func TestBeaconBlockElectra_NewFromJSON(t *testing.T) {
+	block, err := (&types.BeaconBlock{}).NewWithVersion(
+		10, 5, common.Root{1, 2, 3, 4, 5}, version.Electra,
+	)
+	require.NoError(t, err)
+	deneb := generateValidBeaconBlock()
+	block.Body.ExecutionPayload = deneb.Body.ExecutionPayload
+	block.Body.Eth1Data = deneb.Body.Eth1Data
+	block.Body.SetExecutionRequests(&types.ExecutionRequests{
+		Withdrawals: []*types.WithdrawalRequest{
+			{SourceAddress: common.ExecutionAddress{2}, Amount: 1},
+		},
+	})
+	bz, err := json.Marshal(block)
+	require.NoError(t, err)
+
+	// The fork version of the body is derived from the slot of the block.
+	decoded, err := (&types.BeaconBlock{}).NewFromJSON(
+		bz, forkSpec{electraSlot: 10},
+	)
+	require.NoError(t, err)
+	require.Equal(t, version.Electra, decoded.Version())
+	require.Equal(t, block.HashTreeRoot(), decoded.HashTreeRoot())
+
+	decoded, err = (&types.BeaconBlock{}).NewFromJSON(
+		bz, forkSpec{electraSlot: 11},
+	)
+	require.NoError(t, err)
+	require.Equal(t, version.Deneb, decoded.Version())
+	require.Nil(t, decoded.Body.ExecutionRequests)
+}
@@ -0,0 +1,179 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/karalabe/ssz"
+)
+
+// BLSToExecutionChange as defined in the Ethereum 2.0 specification:
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/capella/beacon-chain.md#blstoexecutionchange
+//
+//nolint:lll
+type BLSToExecutionChange struct {
+	// ValidatorIndex is the index of the validator changing credentials.
+	ValidatorIndex math.ValidatorIndex `json:"validator_index"`
+	// FromBLSPubkey is the BLS withdrawal public key committed to by the
+	// validator's current withdrawal credentials.
+	FromBLSPubkey crypto.BLSPubkey `json:"from_bls_pubkey"`
+	// ToExecutionAddress is the execution address to withdraw to.
+	ToExecutionAddress common.ExecutionAddress `json:"to_execution_address"`
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                     SSZ                                    */
+/* -------------------------------------------------------------------------- */
+
+// SizeSSZ returns the size of the BLSToExecutionChange object in SSZ
+// encoding.
+func (*BLSToExecutionChange) SizeSSZ(*ssz.Sizer) uint32 {
+	//nolint:mnd // 8 + 48 + 20 = 76.
+	return 76
+}
+
+// DefineSSZ defines the SSZ encoding for the BLSToExecutionChange object.
+func (c *BLSToExecutionChange) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineUint64(codec, &c.ValidatorIndex)
+	ssz.DefineStaticBytes(codec, &c.FromBLSPubkey)
+	ssz.DefineStaticBytes(codec, &c.ToExecutionAddress)
+}
+
+// HashTreeRoot computes the SSZ hash tree root of the BLSToExecutionChange
+// object.
+func (c *BLSToExecutionChange) HashTreeRoot() common.Root {
+	return ssz.HashSequential(c)
+}
+
+// MarshalSSZ marshals the BLSToExecutionChange object to SSZ format.
+func (c *BLSToExecutionChange) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, ssz.Size(c))
+	return buf, ssz.EncodeToBytes(buf, c)
+}
+
+// UnmarshalSSZ unmarshals the BLSToExecutionChange object from SSZ format.
+func (c *BLSToExecutionChange) UnmarshalSSZ(buf []byte) error {
+	return ssz.DecodeFromBytes(buf, c)
+}
+
+// SignedBLSToExecutionChange as defined in the Ethereum 2.0 specification:
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/capella/beacon-chain.md#signedblstoexecutionchange
+//
+//nolint:lll
+type SignedBLSToExecutionChange struct {
+	// Message is the signed BLSToExecutionChange.
+	Message *BLSToExecutionChange `json:"message"`
+	// Signature is the signature of the message by FromBLSPubkey.
+	Signature crypto.BLSSignature `json:"signature"`
+}
+
+// GetValidatorIndex returns the index of the validator changing credentials.
+func (c *SignedBLSToExecutionChange) GetValidatorIndex() math.ValidatorIndex {
+	return c.Message.ValidatorIndex
+}
+
+// VerifySignature verifies the signature of the BLSToExecutionChange against
+// the BLS public key it claims to come from.
+func (c *SignedBLSToExecutionChange) VerifySignature(
+	forkData *ForkData,
+	domainType common.DomainType,
+	signatureVerificationFn func(
+		pubkey crypto.BLSPubkey, message []byte, signature crypto.BLSSignature,
+	) error,
+) error {
+	signingRoot := ComputeSigningRoot(
+		c.Message, forkData.ComputeDomain(domainType))
+	if err := signatureVerificationFn(
+		c.Message.FromBLSPubkey, signingRoot[:], c.Signature,
+	); err != nil {
+		return errors.Join(err, ErrBLSToExecutionChange)
+	}
+	return nil
+}
+
+// SizeSSZ returns the size of the SignedBLSToExecutionChange object in SSZ
+// encoding.
+func (*SignedBLSToExecutionChange) SizeSSZ(*ssz.Sizer) uint32 {
+	//nolint:mnd // 76 + 96 = 172.
+	return 172
+}
+
+// DefineSSZ defines the SSZ encoding for the SignedBLSToExecutionChange
+// object.
+func (c *SignedBLSToExecutionChange) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineStaticObject(codec, &c.Message)
+	ssz.DefineStaticBytes(codec, &c.Signature)
+}
+
+// HashTreeRoot computes the SSZ hash tree root of the
+// SignedBLSToExecutionChange object.
+func (c *SignedBLSToExecutionChange) HashTreeRoot() common.Root {
+	return ssz.HashSequential(c)
+}
+
+// MarshalSSZ marshals the SignedBLSToExecutionChange object to SSZ format.
+func (c *SignedBLSToExecutionChange) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, ssz.Size(c))
+	return buf, ssz.EncodeToBytes(buf, c)
+}
+
+// UnmarshalSSZ unmarshals the SignedBLSToExecutionChange object from SSZ
+// format.
+func (c *SignedBLSToExecutionChange) UnmarshalSSZ(buf []byte) error {
+	return ssz.DecodeFromBytes(buf, c)
+}
+
+// BLSToExecutionChanges is a typealias for a list of signed BLS to execution
+// changes.
+type BLSToExecutionChanges []*SignedBLSToExecutionChange
+
+// SizeSSZ returns the SSZ encoded size in bytes for the BLSToExecutionChanges.
+func (cs BLSToExecutionChanges) SizeSSZ(siz *ssz.Sizer, _ bool) uint32 {
+	return ssz.SizeSliceOfStaticObjects(siz, ([]*SignedBLSToExecutionChange)(cs))
+}
+
+// DefineSSZ defines the SSZ encoding for the BLSToExecutionChanges object.
+func (cs BLSToExecutionChanges) DefineSSZ(c *ssz.Codec) {
+	c.DefineDecoder(func(*ssz.Decoder) {
+		ssz.DefineSliceOfStaticObjectsContent(
+			c, (*[]*SignedBLSToExecutionChange)(&cs), constants.MaxBlsToExecutionChanges,
+		)
+	})
+	c.DefineEncoder(func(*ssz.Encoder) {
+		ssz.DefineSliceOfStaticObjectsContent(
+			c, (*[]*SignedBLSToExecutionChange)(&cs), constants.MaxBlsToExecutionChanges,
+		)
+	})
+	c.DefineHasher(func(*ssz.Hasher) {
+		ssz.DefineSliceOfStaticObjectsOffset(
+			c, (*[]*SignedBLSToExecutionChange)(&cs), constants.MaxBlsToExecutionChanges,
+		)
+	})
+}
+
+// HashTreeRoot returns the hash tree root of the BLSToExecutionChanges.
+func (cs BLSToExecutionChanges) HashTreeRoot() common.Root {
+	return ssz.HashSequential(cs)
+}
@@ -26,7 +26,9 @@
 package types
 
 import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
@@ -40,6 +42,10 @@ const (
 	// struct.
 	BodyLengthDeneb uint64 = 6
 
+	// BodyLengthElectra is the number of fields in the BeaconBlockBody struct
+	// as of Electra.
+	BodyLengthElectra uint64 = 10
+
 	// KZGPositionDeneb is the position of BlobKzgCommitments in the block body.
 	KZGPositionDeneb = BodyLengthDeneb - 1
 
@@ -47,6 +53,11 @@ const (
 	// in the merkle tree built from the block body.
 	KZGMerkleIndexDeneb = 26
 
+	// KZGMerkleIndexElectra is the merkle index of BlobKzgCommitments' root
+	// in the merkle tree built from the Electra block body, which is one
+	// level deeper than the Deneb one.
+	KZGMerkleIndexElectra = 42
+
 	// ExtraDataSize is the size of ExtraData in bytes.
 	ExtraDataSize = 32
 )
@@ -62,6 +73,15 @@ func (b *BeaconBlockBody) Empty(forkVersion uint32) *BeaconBlockBody {
 				ExtraData: make([]byte, ExtraDataSize),
 			},
 		}
+	case version.Electra:
+		return &BeaconBlockBody{
+			Eth1Data: new(Eth1Data),
+			ExecutionPayload: &ExecutionPayload{
+				ExtraData: make([]byte, ExtraDataSize),
+			},
+			ExecutionRequests: new(ExecutionRequests),
+			forkVersion:       forkVersion,
+		}
 	default:
 		panic(ErrForkVersionNotSupported)
 	}
@@ -77,13 +97,16 @@ func BlockBodyKZGOffset(
 	switch cs.ActiveForkVersionForSlot(slot) {
 	case version.Deneb:
 		return KZGMerkleIndexDeneb * cs.MaxBlobCommitmentsPerBlock()
+	case version.Electra:
+		return KZGMerkleIndexElectra * cs.MaxBlobCommitmentsPerBlock()
 	default:
 		panic(ErrForkVersionNotSupported)
 	}
 }
 
 // BeaconBlockBody represents the body of a beacon block in the Deneb
-// chain.
+// chain. The Electra fields are only part of the body, and of its SSZ
+// encoding, when the body was built for the Electra fork version.
 type BeaconBlockBody struct {
 	// RandaoReveal is the reveal of the RANDAO.
 	RandaoReveal crypto.BLSSignature
@@ -97,6 +120,42 @@ type BeaconBlockBody struct {
 	ExecutionPayload *ExecutionPayload
 	// BlobKzgCommitments is the list of KZG commitments for the EIP-4844 blobs.
 	BlobKzgCommitments []eip4844.KZGCommitment
+	// ProposerSlashings is the list of proposer slashings included in the
+	// body, as of Electra.
+	ProposerSlashings []*ProposerSlashing
+	// VoluntaryExits is the list of voluntary exits included in the body, as
+	// of Electra.
+	VoluntaryExits []*SignedVoluntaryExit
+	// BlsToExecutionChanges is the list of BLS to execution changes included
+	// in the body, as of Electra.
+	BlsToExecutionChanges []*SignedBLSToExecutionChange
+	// ExecutionRequests is the set of requests triggered from the execution
+	// layer, as of Electra.
+	ExecutionRequests *ExecutionRequests
+
+	// forkVersion is the fork version the body was built for.
+	forkVersion uint32
+}
+
+// electraFilter gates the fields added to the body in Electra.
+//
+//nolint:gochecknoglobals // read-only.
+var electraFilter = ssz.ForkFilter{Added: ssz.ForkElectra}
+
+// sszFork returns the SSZ fork the body is encoded with.
+func (b *BeaconBlockBody) sszFork() ssz.Fork {
+	if b.forkVersion == version.Electra {
+		return ssz.ForkElectra
+	}
+	return ssz.ForkDeneb
+}
+
+// Version returns the fork version the body was built for.
+func (b *BeaconBlockBody) Version() uint32 {
+	if b.forkVersion == version.Electra {
+		return version.Electra
+	}
+	return version.Deneb
 }
 
 /* -------------------------------------------------------------------------- */
@@ -106,6 +165,9 @@ type BeaconBlockBody struct {
 // SizeSSZ returns the size of the BeaconBlockBody in SSZ.
 func (b *BeaconBlockBody) SizeSSZ(siz *ssz.Sizer, fixed bool) uint32 {
 	var size uint32 = 96 + 72 + 32 + 4 + 4 + 4
+	if siz.Fork() >= ssz.ForkElectra {
+		size += 4 + 4 + 4 + 4
+	}
 	if fixed {
 		return size
 	}
@@ -113,6 +175,12 @@ func (b *BeaconBlockBody) SizeSSZ(siz *ssz.Sizer, fixed bool) uint32 {
 	size += ssz.SizeSliceOfStaticObjects(siz, b.Deposits)
 	size += ssz.SizeDynamicObject(siz, b.ExecutionPayload)
 	size += ssz.SizeSliceOfStaticBytes(siz, b.BlobKzgCommitments)
+	if siz.Fork() >= ssz.ForkElectra {
+		size += ssz.SizeSliceOfStaticObjects(siz, b.ProposerSlashings)
+		size += ssz.SizeSliceOfStaticObjects(siz, b.VoluntaryExits)
+		size += ssz.SizeSliceOfStaticObjects(siz, b.BlsToExecutionChanges)
+		size += ssz.SizeDynamicObject(siz, b.ExecutionRequests)
+	}
 	return size
 }
 
@@ -127,27 +195,57 @@ func (b *BeaconBlockBody) DefineSSZ(codec *ssz.Codec) {
 	ssz.DefineSliceOfStaticObjectsOffset(codec, &b.Deposits, 16)
 	ssz.DefineDynamicObjectOffset(codec, &b.ExecutionPayload)
 	ssz.DefineSliceOfStaticBytesOffset(codec, &b.BlobKzgCommitments, 16)
+	ssz.DefineSliceOfStaticObjectsOffsetOnFork(
+		codec, &b.ProposerSlashings,
+		constants.MaxProposerSlashings, electraFilter,
+	)
+	ssz.DefineSliceOfStaticObjectsOffsetOnFork(
+		codec, &b.VoluntaryExits,
+		constants.MaxVoluntaryExits, electraFilter,
+	)
+	ssz.DefineSliceOfStaticObjectsOffsetOnFork(
+		codec, &b.BlsToExecutionChanges,
+		constants.MaxBlsToExecutionChanges, electraFilter,
+	)
+	ssz.DefineDynamicObjectOffsetOnFork(
+		codec, &b.ExecutionRequests, electraFilter,
+	)
 
 	// Define the dynamic data (fields)
 	ssz.DefineSliceOfStaticObjectsContent(codec, &b.Deposits, 16)
 	ssz.DefineDynamicObjectContent(codec, &b.ExecutionPayload)
 	ssz.DefineSliceOfStaticBytesContent(codec, &b.BlobKzgCommitments, 16)
+	ssz.DefineSliceOfStaticObjectsContentOnFork(
+		codec, &b.ProposerSlashings,
+		constants.MaxProposerSlashings, electraFilter,
+	)
+	ssz.DefineSliceOfStaticObjectsContentOnFork(
+		codec, &b.VoluntaryExits,
+		constants.MaxVoluntaryExits, electraFilter,
+	)
+	ssz.DefineSliceOfStaticObjectsContentOnFork(
+		codec, &b.BlsToExecutionChanges,
+		constants.MaxBlsToExecutionChanges, electraFilter,
+	)
+	ssz.DefineDynamicObjectContentOnFork(
+		codec, &b.ExecutionRequests, electraFilter,
+	)
 }
 
 // MarshalSSZ serializes the BeaconBlockBody to SSZ-encoded bytes.
 func (b *BeaconBlockBody) MarshalSSZ() ([]byte, error) {
-	buf := make([]byte, ssz.Size(b))
-	return buf, ssz.EncodeToBytes(buf, b)
+	buf := make([]byte, ssz.SizeOnFork(b, b.sszFork()))
+	return buf, ssz.EncodeToBytesOnFork(buf, b, b.sszFork())
 }
 
 // UnmarshalSSZ deserializes the BeaconBlockBody from SSZ-encoded bytes.
 func (b *BeaconBlockBody) UnmarshalSSZ(buf []byte) error {
-	return ssz.DecodeFromBytes(buf, b)
+	return ssz.DecodeFromBytesOnFork(buf, b, b.sszFork())
 }
 
 // HashTreeRoot returns the SSZ hash tree root of the BeaconBlockBody.
 func (b *BeaconBlockBody) HashTreeRoot() common.Root {
-	return ssz.HashConcurrent(b)
+	return ssz.HashConcurrentOnFork(b, b.sszFork())
 }
 
 /* -------------------------------------------------------------------------- */
@@ -221,6 +319,31 @@ func (b *BeaconBlockBody) HashTreeRootWith(hh fastssz.HashWalker) error {
 		hh.MerkleizeWithMixin(subIndx, numItems, 16)
 	}
 
+	if b.forkVersion == version.Electra {
+		// Field (6) 'ProposerSlashings'
+		slashingsRoot := ProposerSlashings(
+			b.ProposerSlashings,
+		).HashTreeRoot()
+		hh.PutBytes(slashingsRoot[:])
+
+		// Field (7) 'VoluntaryExits'
+		exitsRoot := VoluntaryExits(b.VoluntaryExits).HashTreeRoot()
+		hh.PutBytes(exitsRoot[:])
+
+		// Field (8) 'BlsToExecutionChanges'
+		changesRoot := BLSToExecutionChanges(
+			b.BlsToExecutionChanges,
+		).HashTreeRoot()
+		hh.PutBytes(changesRoot[:])
+
+		// Field (9) 'ExecutionRequests'
+		if b.ExecutionRequests == nil {
+			b.ExecutionRequests = new(ExecutionRequests)
+		}
+		requestsRoot := b.ExecutionRequests.HashTreeRoot()
+		hh.PutBytes(requestsRoot[:])
+	}
+
 	hh.Merkleize(indx)
 	return nil
 }
@@ -291,7 +414,7 @@ func (b *BeaconBlockBody) SetSlashingInfo(_ []*SlashingInfo) {
 
 // GetTopLevelRoots returns the top-level roots of the BeaconBlockBody.
 func (b *BeaconBlockBody) GetTopLevelRoots() []common.Root {
-	return []common.Root{
+	roots := []common.Root{
 		common.Root(b.GetRandaoReveal().HashTreeRoot()),
 		b.Eth1Data.HashTreeRoot(),
 		common.Root(b.GetGraffiti().HashTreeRoot()),
@@ -300,10 +423,23 @@ func (b *BeaconBlockBody) GetTopLevelRoots() []common.Root {
 		// I think this is a bug.
 		common.Root{},
 	}
+	if b.forkVersion == version.Electra {
+		roots = append(
+			roots,
+			ProposerSlashings(b.ProposerSlashings).HashTreeRoot(),
+			VoluntaryExits(b.VoluntaryExits).HashTreeRoot(),
+			BLSToExecutionChanges(b.BlsToExecutionChanges).HashTreeRoot(),
+			b.GetExecutionRequests().HashTreeRoot(),
+		)
+	}
+	return roots
 }
 
 // Length returns the number of fields in the BeaconBlockBody struct.
 func (b *BeaconBlockBody) Length() uint64 {
+	if b.forkVersion == version.Electra {
+		return BodyLengthElectra
+	}
 	return BodyLengthDeneb
 }
 
@@ -341,3 +477,68 @@ func (b *BeaconBlockBody) GetDeposits() []*Deposit {
 func (b *BeaconBlockBody) SetDeposits(deposits []*Deposit) {
 	b.Deposits = deposits
 }
+
+// GetProposerSlashings returns the ProposerSlashings of the BeaconBlockBody.
+func (b *BeaconBlockBody) GetProposerSlashings() []*ProposerSlashing {
+	return b.ProposerSlashings
+}
+
+// SetProposerSlashings sets the ProposerSlashings of the BeaconBlockBody.
+func (b *BeaconBlockBody) SetProposerSlashings(
+	slashings []*ProposerSlashing,
+) {
+	b.ProposerSlashings = slashings
+}
+
+// GetVoluntaryExits returns the VoluntaryExits of the BeaconBlockBody.
+func (b *BeaconBlockBody) GetVoluntaryExits() []*SignedVoluntaryExit {
+	return b.VoluntaryExits
+}
+
+// SetVoluntaryExits sets the VoluntaryExits of the BeaconBlockBody.
+func (b *BeaconBlockBody) SetVoluntaryExits(exits []*SignedVoluntaryExit) {
+	b.VoluntaryExits = exits
+}
+
+// GetBlsToExecutionChanges returns the BlsToExecutionChanges of the
+// BeaconBlockBody.
+func (
+	b *BeaconBlockBody,
+) GetBlsToExecutionChanges() []*SignedBLSToExecutionChange {
+	return b.BlsToExecutionChanges
+}
+
+// SetBlsToExecutionChanges sets the BlsToExecutionChanges of the
+// BeaconBlockBody.
+func (b *BeaconBlockBody) SetBlsToExecutionChanges(
+	changes []*SignedBLSToExecutionChange,
+) {
+	b.BlsToExecutionChanges = changes
+}
+
+// GetExecutionRequests returns the ExecutionRequests of the BeaconBlockBody,
+// which are empty before Electra.
+func (b *BeaconBlockBody) GetExecutionRequests() *ExecutionRequests {
+	if b.ExecutionRequests == nil {
+		return new(ExecutionRequests)
+	}
+	return b.ExecutionRequests
+}
+
+// SetExecutionRequests sets the ExecutionRequests of the BeaconBlockBody.
+func (b *BeaconBlockBody) SetExecutionRequests(requests *ExecutionRequests) {
+	b.ExecutionRequests = requests
+}
+
+// SetEncodedExecutionRequests sets the ExecutionRequests of the
+// BeaconBlockBody from their encoding in the engine API.
+func (b *BeaconBlockBody) SetEncodedExecutionRequests(
+	encoded []bytes.Bytes,
+) error {
+	requests, err := DecodeExecutionRequests(encoded)
+	if err != nil {
+		return err
+	}
+	b.ExecutionRequests = requests
+	return nil
+}
@@ -27,6 +27,20 @@ var (
 	// match.
 	ErrDepositMessage = errors.New("invalid deposit message")
 
+	// ErrBLSToExecutionChange is an error for when the BLS to execution
+	// change signature doesn't match.
+	ErrBLSToExecutionChange = errors.New(
+		"invalid bls to execution change",
+	)
+
+	// ErrVoluntaryExit is an error for when the voluntary exit signature
+	// doesn't match.
+	ErrVoluntaryExit = errors.New("invalid voluntary exit")
+
+	// ErrBlockHeaderSignature is an error for when the signature of a block
+	// header doesn't match.
+	ErrBlockHeaderSignature = errors.New("invalid block header signature")
+
 	// ErrInvalidWithdrawalCredentials is an error for when the.
 	ErrInvalidWithdrawalCredentials = errors.New(
 		"invalid withdrawal credentials",
@@ -38,4 +52,8 @@ var (
 
 	// ErrNilPayloadHeader is an error for when the payload header is nil.
 	ErrNilPayloadHeader = errors.New("nil payload header")
+
+	// ErrInvalidExecutionRequests is an error for when the execution
+	// requests returned by the execution client cannot be decoded.
+	ErrInvalidExecutionRequests = errors.New("invalid execution requests")
 )
@@ -0,0 +1,302 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/karalabe/ssz"
+)
+
+// FullExitRequestAmount is the amount of a WithdrawalRequest asking for the
+// full exit of the validator.
+const FullExitRequestAmount math.Gwei = 0
+
+// WithdrawalRequest as defined in the Ethereum 2.0 specification:
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/electra/beacon-chain.md#withdrawalrequest
+//
+//nolint:lll
+type WithdrawalRequest struct {
+	// SourceAddress is the execution address that sent the request.
+	SourceAddress common.ExecutionAddress `json:"source_address"`
+	// ValidatorPubkey is the public key of the validator to withdraw from.
+	ValidatorPubkey crypto.BLSPubkey `json:"validator_pubkey"`
+	// Amount is the amount to withdraw, or FullExitRequestAmount to exit.
+	Amount math.Gwei `json:"amount"`
+}
+
+// SizeSSZ returns the size of the WithdrawalRequest object in SSZ encoding.
+func (*WithdrawalRequest) SizeSSZ(*ssz.Sizer) uint32 {
+	//nolint:mnd // 20 + 48 + 8 = 76.
+	return 76
+}
+
+// DefineSSZ defines the SSZ encoding for the WithdrawalRequest object.
+func (r *WithdrawalRequest) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineStaticBytes(codec, &r.SourceAddress)
+	ssz.DefineStaticBytes(codec, &r.ValidatorPubkey)
+	ssz.DefineUint64(codec, &r.Amount)
+}
+
+// HashTreeRoot computes the SSZ hash tree root of the WithdrawalRequest
+// object.
+func (r *WithdrawalRequest) HashTreeRoot() common.Root {
+	return ssz.HashSequential(r)
+}
+
+// ConsolidationRequest as defined in the Ethereum 2.0 specification:
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/electra/beacon-chain.md#consolidationrequest
+//
+//nolint:lll
+type ConsolidationRequest struct {
+	// SourceAddress is the execution address that sent the request.
+	SourceAddress common.ExecutionAddress `json:"source_address"`
+	// SourcePubkey is the public key of the validator being consolidated.
+	SourcePubkey crypto.BLSPubkey `json:"source_pubkey"`
+	// TargetPubkey is the public key of the validator consolidated into.
+	TargetPubkey crypto.BLSPubkey `json:"target_pubkey"`
+}
+
+// SizeSSZ returns the size of the ConsolidationRequest object in SSZ
+// encoding.
+func (*ConsolidationRequest) SizeSSZ(*ssz.Sizer) uint32 {
+	//nolint:mnd // 20 + 48 + 48 = 116.
+	return 116
+}
+
+// DefineSSZ defines the SSZ encoding for the ConsolidationRequest object.
+func (r *ConsolidationRequest) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineStaticBytes(codec, &r.SourceAddress)
+	ssz.DefineStaticBytes(codec, &r.SourcePubkey)
+	ssz.DefineStaticBytes(codec, &r.TargetPubkey)
+}
+
+// HashTreeRoot computes the SSZ hash tree root of the ConsolidationRequest
+// object.
+func (r *ConsolidationRequest) HashTreeRoot() common.Root {
+	return ssz.HashSequential(r)
+}
+
+// ExecutionRequests holds the requests triggered from the execution layer
+// and included in an Electra block body. Unlike the Ethereum 2.0
+// specification it carries no deposit requests, since deposits keep being
+// read from the deposit contract logs.
+type ExecutionRequests struct {
+	// Withdrawals is the list of withdrawal requests.
+	Withdrawals []*WithdrawalRequest `json:"withdrawals"`
+	// Consolidations is the list of consolidation requests.
+	Consolidations []*ConsolidationRequest `json:"consolidations"`
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                     SSZ                                    */
+/* -------------------------------------------------------------------------- */
+
+// SizeSSZ returns the size of the ExecutionRequests object in SSZ encoding.
+func (r *ExecutionRequests) SizeSSZ(siz *ssz.Sizer, fixed bool) uint32 {
+	var size uint32 = 4 + 4
+	if fixed {
+		return size
+	}
+	size += ssz.SizeSliceOfStaticObjects(siz, r.Withdrawals)
+	size += ssz.SizeSliceOfStaticObjects(siz, r.Consolidations)
+	return size
+}
+
+// DefineSSZ defines the SSZ encoding for the ExecutionRequests object.
+func (r *ExecutionRequests) DefineSSZ(codec *ssz.Codec) {
+	// Define the static data (fields and dynamic offsets)
+	ssz.DefineSliceOfStaticObjectsOffset(
+		codec, &r.Withdrawals, constants.MaxWithdrawalRequestsPerPayload,
+	)
+	ssz.DefineSliceOfStaticObjectsOffset(
+		codec, &r.Consolidations,
+		constants.MaxConsolidationRequestsPerPayload,
+	)
+
+	// Define the dynamic data (fields)
+	ssz.DefineSliceOfStaticObjectsContent(
+		codec, &r.Withdrawals, constants.MaxWithdrawalRequestsPerPayload,
+	)
+	ssz.DefineSliceOfStaticObjectsContent(
+		codec, &r.Consolidations,
+		constants.MaxConsolidationRequestsPerPayload,
+	)
+}
+
+// MarshalSSZ marshals the ExecutionRequests object to SSZ format.
+func (r *ExecutionRequests) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, ssz.Size(r))
+	return buf, ssz.EncodeToBytes(buf, r)
+}
+
+// UnmarshalSSZ unmarshals the ExecutionRequests object from SSZ format.
+func (r *ExecutionRequests) UnmarshalSSZ(buf []byte) error {
+	return ssz.DecodeFromBytes(buf, r)
+}
+
+// HashTreeRoot computes the SSZ hash tree root of the ExecutionRequests
+// object.
+func (r *ExecutionRequests) HashTreeRoot() common.Root {
+	return ssz.HashSequential(r)
+}
+
+// GetWithdrawals returns the withdrawal requests.
+func (r *ExecutionRequests) GetWithdrawals() []*WithdrawalRequest {
+	return r.Withdrawals
+}
+
+// GetConsolidations returns the consolidation requests.
+func (r *ExecutionRequests) GetConsolidations() []*ConsolidationRequest {
+	return r.Consolidations
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                 Engine API                                 */
+/* -------------------------------------------------------------------------- */
+
+const (
+	// WithdrawalRequestType is the EIP-7685 request type of withdrawal
+	// requests.
+	WithdrawalRequestType byte = 0x01
+	// ConsolidationRequestType is the EIP-7685 request type of consolidation
+	// requests.
+	ConsolidationRequestType byte = 0x02
+)
+
+// Encode returns the requests encoded as in the engine API, where each list
+// of requests is prefixed with its EIP-7685 request type and empty lists
+// are left out. The result is never nil.
+func (r *ExecutionRequests) Encode() ([]bytes.Bytes, error) {
+	encoded := make([]bytes.Bytes, 0)
+	if len(r.GetWithdrawals()) > 0 {
+		bz, err := encodeRequests(WithdrawalRequestType, r.Withdrawals)
+		if err != nil {
+			return nil, err
+		}
+		encoded = append(encoded, bz)
+	}
+	if len(r.GetConsolidations()) > 0 {
+		bz, err := encodeRequests(ConsolidationRequestType, r.Consolidations)
+		if err != nil {
+			return nil, err
+		}
+		encoded = append(encoded, bz)
+	}
+	return encoded, nil
+}
+
+// DecodeExecutionRequests decodes the requests from their encoding in the
+// engine API. The request types must be in strictly ascending order.
+// Deposit requests are not supported, since deposits are read from the
+// deposit contract logs.
+func DecodeExecutionRequests(
+	encoded []bytes.Bytes,
+) (*ExecutionRequests, error) {
+	var (
+		requests = new(ExecutionRequests)
+		prevType = -1
+		err      error
+	)
+	for _, bz := range encoded {
+		if len(bz) <= 1 {
+			return nil, errors.Wrap(ErrInvalidExecutionRequests, "empty list")
+		}
+		if int(bz[0]) <= prevType {
+			return nil, errors.Wrapf(ErrInvalidExecutionRequests,
+				"request type %d out of order", bz[0],
+			)
+		}
+		prevType = int(bz[0])
+
+		switch bz[0] {
+		case WithdrawalRequestType:
+			requests.Withdrawals, err = decodeRequests[WithdrawalRequest](
+				bz[1:], constants.MaxWithdrawalRequestsPerPayload,
+			)
+		case ConsolidationRequestType:
+			requests.Consolidations, err = decodeRequests[ConsolidationRequest](
+				bz[1:], constants.MaxConsolidationRequestsPerPayload,
+			)
+		default:
+			err = errors.Wrapf(ErrInvalidExecutionRequests,
+				"unsupported request type %d", bz[0],
+			)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return requests, nil
+}
+
+// encodeRequests returns the SSZ encoding of the requests prefixed with
+// their request type.
+func encodeRequests[RequestT ssz.StaticObject](
+	requestType byte,
+	requests []RequestT,
+) (bytes.Bytes, error) {
+	bz := []byte{requestType}
+	for _, request := range requests {
+		buf := make([]byte, ssz.Size(request))
+		if err := ssz.EncodeToBytes(buf, request); err != nil {
+			return nil, err
+		}
+		bz = append(bz, buf...)
+	}
+	return bz, nil
+}
+
+// decodeRequests decodes the concatenated SSZ encodings of at most limit
+// requests.
+func decodeRequests[
+	RequestT any,
+	RequestPtrT interface {
+		*RequestT
+		ssz.StaticObject
+	},
+](bz []byte, limit uint64) ([]RequestPtrT, error) {
+	size := int(ssz.Size(RequestPtrT(new(RequestT))))
+	switch count := len(bz) / size; {
+	case len(bz)%size != 0:
+		return nil, errors.Wrapf(ErrInvalidExecutionRequests,
+			"invalid requests length %d", len(bz),
+		)
+	case uint64(count) > limit:
+		return nil, errors.Wrapf(ErrInvalidExecutionRequests,
+			"%d requests exceed the limit of %d", count, limit,
+		)
+	}
+
+	requests := make([]RequestPtrT, 0, len(bz)/size)
+	for i := 0; i < len(bz); i += size {
+		request := RequestPtrT(new(RequestT))
+		if err := ssz.DecodeFromBytes(bz[i:i+size], request); err != nil {
+			return nil, err
+		}
+		requests = append(requests, request)
+	}
+	return requests, nil
+}
@@ -0,0 +1,80 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExecutionRequests_EncodeDecode(t *testing.T) {
+	requests := &types.ExecutionRequests{
+		Withdrawals: []*types.WithdrawalRequest{
+			{SourceAddress: common.ExecutionAddress{1}, Amount: 2},
+			{SourceAddress: common.ExecutionAddress{3}},
+		},
+		Consolidations: []*types.ConsolidationRequest{
+			{SourceAddress: common.ExecutionAddress{4}},
+		},
+	}
+	encoded, err := requests.Encode()
+	require.NoError(t, err)
+	require.Len(t, encoded, 2)
+	require.Equal(t, types.WithdrawalRequestType, encoded[0][0])
+	require.Len(t, encoded[0], 1+2*76)
+	require.Equal(t, types.ConsolidationRequestType, encoded[1][0])
+
+	decoded, err := types.DecodeExecutionRequests(encoded)
+	require.NoError(t, err)
+	require.Equal(t, requests, decoded)
+
+	// Empty lists are left out, but the encoding is never nil.
+	encoded, err = (&types.ExecutionRequests{}).Encode()
+	require.NoError(t, err)
+	require.NotNil(t, encoded)
+	require.Empty(t, encoded)
+}
+
+func TestDecodeExecutionRequests_Invalid(t *testing.T) {
+	withdrawal := append(
+		[]byte{types.WithdrawalRequestType}, make([]byte, 76)...,
+	)
+	consolidation := append(
+		[]byte{types.ConsolidationRequestType}, make([]byte, 116)...,
+	)
+	for name, encoded := range map[string][]bytes.Bytes{
+		"empty list":     {{types.WithdrawalRequestType}},
+		"out of order":   {consolidation, withdrawal},
+		"duplicate type": {withdrawal, withdrawal},
+		"deposits":       {{0x00, 0x01}},
+		"bad length":     {withdrawal[:50]},
+		"over the limit": {append(
+			[]byte{types.ConsolidationRequestType}, make([]byte, 3*116)...,
+		)},
+	} {
+		_, err := types.DecodeExecutionRequests(encoded)
+		require.ErrorIs(t, err, types.ErrInvalidExecutionRequests, name)
+	}
+}
@@ -59,6 +59,10 @@ type Genesis[
 	// ExecutionPayloadHeader is the header of the execution payload
 	// in the genesis.
 	ExecutionPayloadHeader ExecutionPayloadHeaderT `json:"execution_payload_header"`
+
+	// State is the JSON encoded full beacon state of a previous chain the
+	// chain is restarted from. If set, it is used instead of the deposits.
+	State json.RawMessage `json:"state,omitempty"`
 }
 
 // GetForkVersion returns the fork version in the genesis.
@@ -80,6 +84,11 @@ func (g *Genesis[
 	return g.ExecutionPayloadHeader
 }
 
+// GetState returns the JSON encoded full beacon state, if any.
+func (g *Genesis[DepositT, ExecutionPayloadHeaderT]) GetState() []byte {
+	return g.State
+}
+
 // UnmarshalJSON for Genesis.
 func (g *Genesis[DepositT, ExecutionPayloadHeaderT]) UnmarshalJSON(
 	data []byte,
@@ -88,6 +97,7 @@ func (g *Genesis[DepositT, ExecutionPayloadHeaderT]) UnmarshalJSON(
 		ForkVersion            common.Version  `json:"fork_version"`
 		Deposits               []DepositT      `json:"deposits"`
 		ExecutionPayloadHeader json.RawMessage `json:"execution_payload_header"`
+		State                  json.RawMessage `json:"state"`
 	}
 	var g2 genesisMarshalable[DepositT]
 	if err := json.Unmarshal(data, &g2); err != nil {
@@ -109,6 +119,7 @@ func (g *Genesis[DepositT, ExecutionPayloadHeaderT]) UnmarshalJSON(
 	g.Deposits = g2.Deposits
 	g.ForkVersion = g2.ForkVersion
 	g.ExecutionPayloadHeader = payloadHeader
+	g.State = g2.State
 	return nil
 }
 
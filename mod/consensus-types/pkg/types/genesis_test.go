@@ -22,6 +22,7 @@
 package types_test
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
@@ -221,3 +222,20 @@ func TestGenesisUnmarshalJSON(t *testing.T) {
 		})
 	}
 }
+
+func TestGenesisState(t *testing.T) {
+	g := types.DefaultGenesisDeneb()
+	require.Empty(t, g.GetState())
+
+	bz, err := json.Marshal(g)
+	require.NoError(t, err)
+	require.NotContains(t, string(bz), `"state"`)
+
+	g.State = []byte(`{"Slot":"0x2a"}`)
+	bz, err = json.Marshal(g)
+	require.NoError(t, err)
+
+	var got types.Genesis[*types.Deposit, *types.ExecutionPayloadHeader]
+	require.NoError(t, json.Unmarshal(bz, &got))
+	require.JSONEq(t, `{"Slot":"0x2a"}`, string(got.GetState()))
+}
@@ -0,0 +1,168 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/karalabe/ssz"
+)
+
+// SignedBeaconBlockHeader as defined in the Ethereum 2.0 specification:
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#signedbeaconblockheader
+//
+//nolint:lll
+type SignedBeaconBlockHeader struct {
+	// Header is the signed BeaconBlockHeader.
+	Header *BeaconBlockHeader `json:"message"`
+	// Signature is the signature of the header by its proposer.
+	Signature crypto.BLSSignature `json:"signature"`
+}
+
+// VerifySignature verifies the signature of the header against the public
+// key of its proposer.
+func (h *SignedBeaconBlockHeader) VerifySignature(
+	forkData *ForkData,
+	domainType common.DomainType,
+	pubkey crypto.BLSPubkey,
+	signatureVerificationFn func(
+		pubkey crypto.BLSPubkey, message []byte, signature crypto.BLSSignature,
+	) error,
+) error {
+	signingRoot := ComputeSigningRoot(
+		h.Header, forkData.ComputeDomain(domainType))
+	if err := signatureVerificationFn(
+		pubkey, signingRoot[:], h.Signature,
+	); err != nil {
+		return errors.Join(err, ErrBlockHeaderSignature)
+	}
+	return nil
+}
+
+// SizeSSZ returns the size of the SignedBeaconBlockHeader object in SSZ
+// encoding.
+func (*SignedBeaconBlockHeader) SizeSSZ(*ssz.Sizer) uint32 {
+	//nolint:mnd // 112 + 96 = 208.
+	return 208
+}
+
+// DefineSSZ defines the SSZ encoding for the SignedBeaconBlockHeader object.
+func (h *SignedBeaconBlockHeader) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineStaticObject(codec, &h.Header)
+	ssz.DefineStaticBytes(codec, &h.Signature)
+}
+
+// HashTreeRoot computes the SSZ hash tree root of the SignedBeaconBlockHeader
+// object.
+func (h *SignedBeaconBlockHeader) HashTreeRoot() common.Root {
+	return ssz.HashSequential(h)
+}
+
+// MarshalSSZ marshals the SignedBeaconBlockHeader object to SSZ format.
+func (h *SignedBeaconBlockHeader) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, ssz.Size(h))
+	return buf, ssz.EncodeToBytes(buf, h)
+}
+
+// UnmarshalSSZ unmarshals the SignedBeaconBlockHeader object from SSZ format.
+func (h *SignedBeaconBlockHeader) UnmarshalSSZ(buf []byte) error {
+	return ssz.DecodeFromBytes(buf, h)
+}
+
+// ProposerSlashing as defined in the Ethereum 2.0 specification:
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#proposerslashing
+//
+//nolint:lll
+type ProposerSlashing struct {
+	// SignedHeader1 is the first of the two conflicting headers.
+	SignedHeader1 *SignedBeaconBlockHeader `json:"signed_header_1"`
+	// SignedHeader2 is the second of the two conflicting headers.
+	SignedHeader2 *SignedBeaconBlockHeader `json:"signed_header_2"`
+}
+
+// GetValidatorIndex returns the index of the slashed proposer.
+func (s *ProposerSlashing) GetValidatorIndex() math.ValidatorIndex {
+	return s.SignedHeader1.Header.GetProposerIndex()
+}
+
+// SizeSSZ returns the size of the ProposerSlashing object in SSZ encoding.
+func (*ProposerSlashing) SizeSSZ(*ssz.Sizer) uint32 {
+	//nolint:mnd // 208 + 208 = 416.
+	return 416
+}
+
+// DefineSSZ defines the SSZ encoding for the ProposerSlashing object.
+func (s *ProposerSlashing) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineStaticObject(codec, &s.SignedHeader1)
+	ssz.DefineStaticObject(codec, &s.SignedHeader2)
+}
+
+// HashTreeRoot computes the SSZ hash tree root of the ProposerSlashing
+// object.
+func (s *ProposerSlashing) HashTreeRoot() common.Root {
+	return ssz.HashSequential(s)
+}
+
+// MarshalSSZ marshals the ProposerSlashing object to SSZ format.
+func (s *ProposerSlashing) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, ssz.Size(s))
+	return buf, ssz.EncodeToBytes(buf, s)
+}
+
+// UnmarshalSSZ unmarshals the ProposerSlashing object from SSZ format.
+func (s *ProposerSlashing) UnmarshalSSZ(buf []byte) error {
+	return ssz.DecodeFromBytes(buf, s)
+}
+
+// ProposerSlashings is a typealias for a list of proposer slashings.
+type ProposerSlashings []*ProposerSlashing
+
+// SizeSSZ returns the SSZ encoded size in bytes for the ProposerSlashings.
+func (ps ProposerSlashings) SizeSSZ(siz *ssz.Sizer, _ bool) uint32 {
+	return ssz.SizeSliceOfStaticObjects(siz, ([]*ProposerSlashing)(ps))
+}
+
+// DefineSSZ defines the SSZ encoding for the ProposerSlashings object.
+func (ps ProposerSlashings) DefineSSZ(c *ssz.Codec) {
+	c.DefineDecoder(func(*ssz.Decoder) {
+		ssz.DefineSliceOfStaticObjectsContent(
+			c, (*[]*ProposerSlashing)(&ps), constants.MaxProposerSlashings,
+		)
+	})
+	c.DefineEncoder(func(*ssz.Encoder) {
+		ssz.DefineSliceOfStaticObjectsContent(
+			c, (*[]*ProposerSlashing)(&ps), constants.MaxProposerSlashings,
+		)
+	})
+	c.DefineHasher(func(*ssz.Hasher) {
+		ssz.DefineSliceOfStaticObjectsOffset(
+			c, (*[]*ProposerSlashing)(&ps), constants.MaxProposerSlashings,
+		)
+	})
+}
+
+// HashTreeRoot returns the hash tree root of the ProposerSlashings.
+func (ps ProposerSlashings) HashTreeRoot() common.Root {
+	return ssz.HashSequential(ps)
+}
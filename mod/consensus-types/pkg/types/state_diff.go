@@ -0,0 +1,177 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"fmt"
+
+	"github.com/karalabe/ssz"
+)
+
+// noIndex is the index of a FieldDiff on a field that is not a list.
+const noIndex = -1
+
+// FieldDiff is a difference in a single field of two BeaconStates. Fields
+// that are lists report one FieldDiff per differing element.
+type FieldDiff struct {
+	// Field is the name of the BeaconState field.
+	Field string
+	// Index is the index of the differing element if Field is a list, and
+	// -1 otherwise.
+	Index int
+	// Old is the value in the receiver of Diff, nil if the element is
+	// only present in the other state.
+	Old any
+	// New is the value in the other state, nil if the element is only
+	// present in the receiver of Diff.
+	New any
+}
+
+// String returns a human readable representation of the FieldDiff.
+func (d FieldDiff) String() string {
+	field := d.Field
+	if d.Index != noIndex {
+		field = fmt.Sprintf("%s[%d]", d.Field, d.Index)
+	}
+	return fmt.Sprintf("%s: %v -> %v", field, d.Old, d.New)
+}
+
+// Diff returns the differences between the BeaconState and other, field by
+// field in SSZ order, such that the first FieldDiff is the first field to
+// diverge in the state root. Container fields are compared by their hash
+// tree root.
+func (st *BeaconState[
+	BeaconBlockHeaderT,
+	Eth1DataT,
+	ExecutionPayloadHeaderT,
+	ForkT,
+	ValidatorT,
+	B, E, P, F, V,
+]) Diff(
+	other *BeaconState[
+		BeaconBlockHeaderT,
+		Eth1DataT,
+		ExecutionPayloadHeaderT,
+		ForkT,
+		ValidatorT,
+		B, E, P, F, V,
+	],
+) []FieldDiff {
+	var diffs []FieldDiff
+
+	// Versioning
+	diffs = diffValue(diffs, "GenesisValidatorsRoot",
+		st.GenesisValidatorsRoot, other.GenesisValidatorsRoot)
+	diffs = diffValue(diffs, "Slot", st.Slot, other.Slot)
+	diffs = diffObject(diffs, "Fork", noIndex, st.Fork, other.Fork)
+
+	// History
+	diffs = diffObject(diffs, "LatestBlockHeader", noIndex,
+		st.LatestBlockHeader, other.LatestBlockHeader)
+	diffs = diffValues(diffs, "BlockRoots", st.BlockRoots, other.BlockRoots)
+	diffs = diffValues(diffs, "StateRoots", st.StateRoots, other.StateRoots)
+
+	// Eth1
+	diffs = diffObject(diffs, "Eth1Data", noIndex,
+		st.Eth1Data, other.Eth1Data)
+	diffs = diffValue(diffs, "Eth1DepositIndex",
+		st.Eth1DepositIndex, other.Eth1DepositIndex)
+	diffs = diffObject(diffs, "LatestExecutionPayloadHeader", noIndex,
+		st.LatestExecutionPayloadHeader, other.LatestExecutionPayloadHeader)
+
+	// Registry
+	diffs = diffObjects(diffs, "Validators", st.Validators, other.Validators)
+	diffs = diffValues(diffs, "Balances", st.Balances, other.Balances)
+
+	// Randomness
+	diffs = diffValues(diffs, "RandaoMixes", st.RandaoMixes, other.RandaoMixes)
+
+	// Withdrawals
+	diffs = diffValue(diffs, "NextWithdrawalIndex",
+		st.NextWithdrawalIndex, other.NextWithdrawalIndex)
+	diffs = diffValue(diffs, "NextWithdrawalValidatorIndex",
+		st.NextWithdrawalValidatorIndex, other.NextWithdrawalValidatorIndex)
+
+	// Slashing
+	diffs = diffValues(diffs, "Slashings", st.Slashings, other.Slashings)
+	return diffValue(diffs, "TotalSlashing",
+		st.TotalSlashing, other.TotalSlashing)
+}
+
+// diffValue appends a FieldDiff for field if a and b differ.
+func diffValue[T comparable](
+	diffs []FieldDiff, field string, a, b T,
+) []FieldDiff {
+	if a == b {
+		return diffs
+	}
+	return append(diffs, FieldDiff{Field: field, Index: noIndex, Old: a, New: b})
+}
+
+// diffValues appends a FieldDiff for every index at which a and b differ,
+// including the elements only present in one of them.
+func diffValues[T comparable](
+	diffs []FieldDiff, field string, a, b []T,
+) []FieldDiff {
+	for i := range max(len(a), len(b)) {
+		switch {
+		case i >= len(a):
+			diffs = append(diffs, FieldDiff{Field: field, Index: i, New: b[i]})
+		case i >= len(b):
+			diffs = append(diffs, FieldDiff{Field: field, Index: i, Old: a[i]})
+		case a[i] != b[i]:
+			diffs = append(diffs, FieldDiff{
+				Field: field, Index: i, Old: a[i], New: b[i],
+			})
+		}
+	}
+	return diffs
+}
+
+// diffObject appends a FieldDiff for field if the hash tree roots of a and
+// b differ.
+func diffObject[T ssz.Object](
+	diffs []FieldDiff, field string, index int, a, b T,
+) []FieldDiff {
+	if ssz.HashSequential(a) == ssz.HashSequential(b) {
+		return diffs
+	}
+	return append(diffs, FieldDiff{Field: field, Index: index, Old: a, New: b})
+}
+
+// diffObjects appends a FieldDiff for every index at which the hash tree
+// roots of a and b differ, including the elements only present in one of
+// them.
+func diffObjects[T ssz.Object](
+	diffs []FieldDiff, field string, a, b []T,
+) []FieldDiff {
+	for i := range max(len(a), len(b)) {
+		switch {
+		case i >= len(a):
+			diffs = append(diffs, FieldDiff{Field: field, Index: i, New: b[i]})
+		case i >= len(b):
+			diffs = append(diffs, FieldDiff{Field: field, Index: i, Old: a[i]})
+		default:
+			diffs = diffObject(diffs, field, i, a[i], b[i])
+		}
+	}
+	return diffs
+}
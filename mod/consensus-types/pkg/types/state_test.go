@@ -239,3 +239,32 @@ func TestBeaconState_HashTreeRoot(t *testing.T) {
 		"HashTreeRoot and HashSequential should produce the same result",
 	)
 }
+
+func TestBeaconState_Diff(t *testing.T) {
+	pre := generateValidBeaconState()
+	require.Empty(t, pre.Diff(generateValidBeaconState()))
+
+	post := generateValidBeaconState()
+	post.Slot++
+	post.Validators[0].Slashed = true
+	post.Balances = append(post.Balances, 42)
+	post.TotalSlashing++
+
+	diffs := post.Diff(pre)
+	require.Len(t, diffs, 4)
+
+	require.Equal(t, "Slot", diffs[0].Field)
+	require.Equal(t, -1, diffs[0].Index)
+	require.Equal(t, pre.Slot, diffs[0].New)
+
+	require.Equal(t, "Validators", diffs[1].Field)
+	require.Equal(t, 0, diffs[1].Index)
+	require.Equal(t, pre.Validators[0], diffs[1].New)
+
+	require.Equal(t, "Balances", diffs[2].Field)
+	require.Equal(t, len(pre.Balances), diffs[2].Index)
+	require.Equal(t, uint64(42), diffs[2].Old)
+	require.Nil(t, diffs[2].New)
+
+	require.Equal(t, "TotalSlashing", diffs[3].Field)
+}
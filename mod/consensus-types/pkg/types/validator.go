@@ -280,6 +280,11 @@ func (v Validator) IsSlashed() bool {
 	return v.Slashed
 }
 
+// SetSlashed sets whether the validator has been slashed.
+func (v *Validator) SetSlashed(slashed bool) {
+	v.Slashed = slashed
+}
+
 // IsFullyWithdrawable as defined in the Ethereum 2.0 specification:
 // https://github.com/ethereum/consensus-specs/blob/dev/specs/capella/beacon-chain.md#is_fully_withdrawable_validator
 //
@@ -288,8 +293,8 @@ func (v Validator) IsFullyWithdrawable(
 	balance math.Gwei,
 	epoch math.Epoch,
 ) bool {
-	return v.HasEth1WithdrawalCredentials() && v.WithdrawableEpoch <= epoch &&
-		balance > 0
+	return v.HasExecutionWithdrawalCredential() &&
+		v.WithdrawableEpoch <= epoch && balance > 0
 }
 
 // IsPartiallyWithdrawable as defined in the Ethereum 2.0 specification:
@@ -300,7 +305,7 @@ func (v Validator) IsPartiallyWithdrawable(
 	balance, maxEffectiveBalance math.Gwei,
 ) bool {
 	hasExcessBalance := balance > maxEffectiveBalance
-	return v.HasEth1WithdrawalCredentials() &&
+	return v.HasExecutionWithdrawalCredential() &&
 		v.HasMaxEffectiveBalance(maxEffectiveBalance) && hasExcessBalance
 }
 
@@ -312,6 +317,24 @@ func (v Validator) HasEth1WithdrawalCredentials() bool {
 	return v.WithdrawalCredentials[0] == EthSecp256k1CredentialPrefix
 }
 
+// HasCompoundingWithdrawalCredential as defined in the Ethereum 2.0
+// specification:
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/electra/beacon-chain.md#new-has_compounding_withdrawal_credential
+//
+//nolint:lll
+func (v Validator) HasCompoundingWithdrawalCredential() bool {
+	return v.WithdrawalCredentials[0] == CompoundingCredentialPrefix
+}
+
+// HasExecutionWithdrawalCredential as defined in the Ethereum 2.0
+// specification:
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/electra/beacon-chain.md#new-has_execution_withdrawal_credential
+//
+//nolint:lll
+func (v Validator) HasExecutionWithdrawalCredential() bool {
+	return v.WithdrawalCredentials.IsExecution()
+}
+
 // HasMaxEffectiveBalance determines if the validator has the maximum effective
 // balance.
 func (v Validator) HasMaxEffectiveBalance(
@@ -325,12 +348,56 @@ func (v *Validator) SetEffectiveBalance(balance math.Gwei) {
 	v.EffectiveBalance = balance
 }
 
+// GetActivationEligibilityEpoch returns the epoch in which the validator
+// became eligible for activation.
+func (v Validator) GetActivationEligibilityEpoch() math.Epoch {
+	return v.ActivationEligibilityEpoch
+}
+
+// SetActivationEligibilityEpoch sets the epoch in which the validator became
+// eligible for activation.
+func (v *Validator) SetActivationEligibilityEpoch(epoch math.Epoch) {
+	v.ActivationEligibilityEpoch = epoch
+}
+
+// GetActivationEpoch returns the epoch in which the validator activates.
+func (v Validator) GetActivationEpoch() math.Epoch {
+	return v.ActivationEpoch
+}
+
+// SetActivationEpoch sets the epoch in which the validator activates.
+func (v *Validator) SetActivationEpoch(epoch math.Epoch) {
+	v.ActivationEpoch = epoch
+}
+
+// GetExitEpoch returns the epoch in which the validator exits.
+func (v Validator) GetExitEpoch() math.Epoch {
+	return v.ExitEpoch
+}
+
+// SetExitEpoch sets the epoch in which the validator exits.
+func (v *Validator) SetExitEpoch(epoch math.Epoch) {
+	v.ExitEpoch = epoch
+}
+
 // GetWithdrawableEpoch returns the epoch when the validator can withdraw.
 func (v Validator) GetWithdrawableEpoch() math.Epoch {
 	return v.WithdrawableEpoch
 }
 
+// SetWithdrawableEpoch sets the epoch when the validator can withdraw.
+func (v *Validator) SetWithdrawableEpoch(epoch math.Epoch) {
+	v.WithdrawableEpoch = epoch
+}
+
 // GetWithdrawalCredentials returns the withdrawal credentials of the validator.
 func (v Validator) GetWithdrawalCredentials() WithdrawalCredentials {
 	return v.WithdrawalCredentials
 }
+
+// SetWithdrawalCredentials sets the withdrawal credentials of the validator.
+func (v *Validator) SetWithdrawalCredentials(
+	credentials WithdrawalCredentials,
+) {
+	v.WithdrawalCredentials = credentials
+}
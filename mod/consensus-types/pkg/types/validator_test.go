@@ -1008,3 +1008,24 @@ func TestValidator_GetEffectiveBalance(t *testing.T) {
 		})
 	}
 }
+
+func TestValidator_SetActivationEpochs(t *testing.T) {
+	validator := types.NewValidatorFromDeposit(
+		[48]byte{0x01},
+		types.WithdrawalCredentials{},
+		32e9,
+		1e9,
+		32e9,
+	)
+	farFuture := math.Epoch(constants.FarFutureEpoch)
+	require.Equal(t, farFuture, validator.GetActivationEligibilityEpoch())
+	require.Equal(t, farFuture, validator.GetActivationEpoch())
+	require.Equal(t, farFuture, validator.GetExitEpoch())
+
+	validator.SetActivationEligibilityEpoch(3)
+	validator.SetActivationEpoch(4)
+	require.Equal(t, math.Epoch(3), validator.GetActivationEligibilityEpoch())
+	require.Equal(t, math.Epoch(4), validator.GetActivationEpoch())
+	require.True(t, validator.IsActive(4))
+	require.False(t, validator.IsActive(3))
+}
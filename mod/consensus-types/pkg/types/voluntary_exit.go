@@ -0,0 +1,170 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/karalabe/ssz"
+)
+
+// VoluntaryExit as defined in the Ethereum 2.0 specification:
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#voluntaryexit
+//
+//nolint:lll
+type VoluntaryExit struct {
+	// Epoch is the earliest epoch the exit can be processed at.
+	Epoch math.Epoch `json:"epoch"`
+	// ValidatorIndex is the index of the exiting validator.
+	ValidatorIndex math.ValidatorIndex `json:"validator_index"`
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                     SSZ                                    */
+/* -------------------------------------------------------------------------- */
+
+// SizeSSZ returns the size of the VoluntaryExit object in SSZ encoding.
+func (*VoluntaryExit) SizeSSZ(*ssz.Sizer) uint32 {
+	//nolint:mnd // 8 + 8 = 16.
+	return 16
+}
+
+// DefineSSZ defines the SSZ encoding for the VoluntaryExit object.
+func (e *VoluntaryExit) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineUint64(codec, &e.Epoch)
+	ssz.DefineUint64(codec, &e.ValidatorIndex)
+}
+
+// HashTreeRoot computes the SSZ hash tree root of the VoluntaryExit object.
+func (e *VoluntaryExit) HashTreeRoot() common.Root {
+	return ssz.HashSequential(e)
+}
+
+// MarshalSSZ marshals the VoluntaryExit object to SSZ format.
+func (e *VoluntaryExit) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, ssz.Size(e))
+	return buf, ssz.EncodeToBytes(buf, e)
+}
+
+// UnmarshalSSZ unmarshals the VoluntaryExit object from SSZ format.
+func (e *VoluntaryExit) UnmarshalSSZ(buf []byte) error {
+	return ssz.DecodeFromBytes(buf, e)
+}
+
+// SignedVoluntaryExit as defined in the Ethereum 2.0 specification:
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#signedvoluntaryexit
+//
+//nolint:lll
+type SignedVoluntaryExit struct {
+	// Message is the signed VoluntaryExit.
+	Message *VoluntaryExit `json:"message"`
+	// Signature is the signature of the message by the exiting validator.
+	Signature crypto.BLSSignature `json:"signature"`
+}
+
+// GetValidatorIndex returns the index of the exiting validator.
+func (e *SignedVoluntaryExit) GetValidatorIndex() math.ValidatorIndex {
+	return e.Message.ValidatorIndex
+}
+
+// VerifySignature verifies the signature of the VoluntaryExit against the
+// public key of the exiting validator.
+func (e *SignedVoluntaryExit) VerifySignature(
+	forkData *ForkData,
+	domainType common.DomainType,
+	pubkey crypto.BLSPubkey,
+	signatureVerificationFn func(
+		pubkey crypto.BLSPubkey, message []byte, signature crypto.BLSSignature,
+	) error,
+) error {
+	signingRoot := ComputeSigningRoot(
+		e.Message, forkData.ComputeDomain(domainType))
+	if err := signatureVerificationFn(
+		pubkey, signingRoot[:], e.Signature,
+	); err != nil {
+		return errors.Join(err, ErrVoluntaryExit)
+	}
+	return nil
+}
+
+// SizeSSZ returns the size of the SignedVoluntaryExit object in SSZ encoding.
+func (*SignedVoluntaryExit) SizeSSZ(*ssz.Sizer) uint32 {
+	//nolint:mnd // 16 + 96 = 112.
+	return 112
+}
+
+// DefineSSZ defines the SSZ encoding for the SignedVoluntaryExit object.
+func (e *SignedVoluntaryExit) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineStaticObject(codec, &e.Message)
+	ssz.DefineStaticBytes(codec, &e.Signature)
+}
+
+// HashTreeRoot computes the SSZ hash tree root of the SignedVoluntaryExit
+// object.
+func (e *SignedVoluntaryExit) HashTreeRoot() common.Root {
+	return ssz.HashSequential(e)
+}
+
+// MarshalSSZ marshals the SignedVoluntaryExit object to SSZ format.
+func (e *SignedVoluntaryExit) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, ssz.Size(e))
+	return buf, ssz.EncodeToBytes(buf, e)
+}
+
+// UnmarshalSSZ unmarshals the SignedVoluntaryExit object from SSZ format.
+func (e *SignedVoluntaryExit) UnmarshalSSZ(buf []byte) error {
+	return ssz.DecodeFromBytes(buf, e)
+}
+
+// VoluntaryExits is a typealias for a list of signed voluntary exits.
+type VoluntaryExits []*SignedVoluntaryExit
+
+// SizeSSZ returns the SSZ encoded size in bytes for the VoluntaryExits.
+func (es VoluntaryExits) SizeSSZ(siz *ssz.Sizer, _ bool) uint32 {
+	return ssz.SizeSliceOfStaticObjects(siz, ([]*SignedVoluntaryExit)(es))
+}
+
+// DefineSSZ defines the SSZ encoding for the VoluntaryExits object.
+func (es VoluntaryExits) DefineSSZ(c *ssz.Codec) {
+	c.DefineDecoder(func(*ssz.Decoder) {
+		ssz.DefineSliceOfStaticObjectsContent(
+			c, (*[]*SignedVoluntaryExit)(&es), constants.MaxVoluntaryExits,
+		)
+	})
+	c.DefineEncoder(func(*ssz.Encoder) {
+		ssz.DefineSliceOfStaticObjectsContent(
+			c, (*[]*SignedVoluntaryExit)(&es), constants.MaxVoluntaryExits,
+		)
+	})
+	c.DefineHasher(func(*ssz.Hasher) {
+		ssz.DefineSliceOfStaticObjectsOffset(
+			c, (*[]*SignedVoluntaryExit)(&es), constants.MaxVoluntaryExits,
+		)
+	})
+}
+
+// HashTreeRoot returns the hash tree root of the VoluntaryExits.
+func (es VoluntaryExits) HashTreeRoot() common.Root {
+	return ssz.HashSequential(es)
+}
@@ -26,11 +26,22 @@
 package types
 
 import (
+	"crypto/sha256"
+
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 )
 
-// EthSecp256k1CredentialPrefix is the prefix for an Ethereum secp256k1.
-const EthSecp256k1CredentialPrefix = byte(iota + 1)
+const (
+	// BLSCredentialPrefix is the prefix for credentials committing to the
+	// hash of a BLS withdrawal public key.
+	BLSCredentialPrefix = byte(iota)
+	// EthSecp256k1CredentialPrefix is the prefix for an Ethereum secp256k1.
+	EthSecp256k1CredentialPrefix
+	// CompoundingCredentialPrefix is the prefix for an Ethereum secp256k1
+	// address whose validator opted into compounding, as of Electra.
+	CompoundingCredentialPrefix
+)
 
 // WithdrawalCredentials is a staking credential that is used to identify a
 // validator.
@@ -47,12 +58,29 @@ func NewCredentialsFromExecutionAddress(
 	return credentials
 }
 
+// NewCredentialsFromBLSPubkey creates a new WithdrawalCredentials committing
+// to the hash of the given BLS withdrawal public key.
+func NewCredentialsFromBLSPubkey(
+	pubkey crypto.BLSPubkey,
+) WithdrawalCredentials {
+	credentials := WithdrawalCredentials(sha256.Sum256(pubkey[:]))
+	credentials[0] = BLSCredentialPrefix
+	return credentials
+}
+
+// IsExecution returns true if the WithdrawalCredentials withdraw to an
+// execution address.
+func (wc WithdrawalCredentials) IsExecution() bool {
+	return wc[0] == EthSecp256k1CredentialPrefix ||
+		wc[0] == CompoundingCredentialPrefix
+}
+
 // ToExecutionAddress converts the WithdrawalCredentials to an ExecutionAddress.
 func (wc WithdrawalCredentials) ToExecutionAddress() (
 	common.ExecutionAddress,
 	error,
 ) {
-	if wc[0] != EthSecp256k1CredentialPrefix {
+	if !wc.IsExecution() {
 		return common.ExecutionAddress{}, ErrInvalidWithdrawalCredentials
 	}
 	return common.ExecutionAddress(wc[12:]), nil
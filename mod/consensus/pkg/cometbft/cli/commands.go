@@ -242,8 +242,12 @@ func BootstrapStateCmd[T interface {
 			}
 			if height == 0 {
 				home := v.GetString(flags.FlagHome)
+				var dbCfg db.Config
+				if dbCfg, err = db.ReadConfig(v); err != nil {
+					return err
+				}
 				var dbi dbm.DB
-				dbi, err = db.OpenDB(home, dbm.PebbleDBBackend)
+				dbi, err = db.OpenDB(home, dbCfg)
 				if err != nil {
 					return err
 				}
@@ -20,17 +20,12 @@
 
 package encoding
 
-import (
-	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
-)
-
 // ExtractBlobsAndBlockFromRequest extracts the blobs and block from an ABCI
 // request.
 func ExtractBlobsAndBlockFromRequest[
 	BeaconBlockT BeaconBlock[BeaconBlockT],
 	BlobSidecarsT interface {
-		constraints.SSZUnmarshaler
-		Empty() BlobSidecarsT
+		NewFromSSZ([]byte, uint32) (BlobSidecarsT, error)
 	},
 ](
 	req ABCIRequest,
@@ -59,6 +54,7 @@ func ExtractBlobsAndBlockFromRequest[
 	blobs, err = UnmarshalBlobSidecarsFromABCIRequest[BlobSidecarsT](
 		req,
 		blobSidecarsIndex,
+		forkVersion,
 	)
 	if err != nil {
 		return blk, blobs, err
@@ -106,12 +102,12 @@ func UnmarshalBeaconBlockFromABCIRequest[
 // request.
 func UnmarshalBlobSidecarsFromABCIRequest[
 	BlobSidecarsT interface {
-		constraints.SSZUnmarshaler
-		Empty() BlobSidecarsT
+		NewFromSSZ([]byte, uint32) (BlobSidecarsT, error)
 	},
 ](
 	req ABCIRequest,
 	bzIndex uint,
+	forkVersion uint32,
 ) (BlobSidecarsT, error) {
 	var sidecars BlobSidecarsT
 	if req == nil {
@@ -128,8 +124,5 @@ func UnmarshalBlobSidecarsFromABCIRequest[
 		return sidecars, ErrNilBeaconBlockInRequest
 	}
 
-	// TODO: Do some research to figure out how to make this more
-	// elegant.
-	sidecars = sidecars.Empty()
-	return sidecars, sidecars.UnmarshalSSZ(sidecarBz)
+	return sidecars.NewFromSSZ(sidecarBz, forkVersion)
 }
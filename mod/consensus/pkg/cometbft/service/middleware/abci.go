@@ -32,6 +32,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
 	cmtabci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/api/cometbft/types/v1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -221,6 +222,7 @@ func (h *ABCIMiddleware[
 		blk,
 		req.GetProposerAddress(),
 		req.GetTime(),
+		nil,
 	)
 	blkEvent := async.NewEvent(ctx, async.BeaconBlockReceived, consensusBlk)
 	if err = h.dispatcher.Publish(blkEvent); err != nil {
@@ -232,6 +234,7 @@ func (h *ABCIMiddleware[
 		UnmarshalBlobSidecarsFromABCIRequest[BlobSidecarsT](
 		req,
 		BlobSidecarsTxIndex,
+		h.chainSpec.ActiveForkVersionForSlot(math.U64(req.Height)),
 	)
 	if err != nil {
 		return h.createProcessProposalResponse(errors.WrapNonFatal(err))
@@ -346,6 +349,7 @@ func (h *ABCIMiddleware[
 		blk,
 		req.GetProposerAddress(),
 		req.GetTime(),
+		lastCommitVotes(req.GetDecidedLastCommit()),
 	)
 	blkEvent := async.NewEvent(
 		ctx,
@@ -381,3 +385,18 @@ func (h *ABCIMiddleware[
 		return event.Data(), event.Error()
 	}
 }
+
+// lastCommitVotes converts the votes in the decided last commit into the
+// votes recorded by the state transition.
+func lastCommitVotes(
+	commit cmtabci.CommitInfo,
+) []transition.CommitVote {
+	votes := make([]transition.CommitVote, len(commit.Votes))
+	for i, vote := range commit.Votes {
+		votes[i] = transition.CommitVote{
+			Address: vote.Validator.Address,
+			Signed:  vote.BlockIdFlag == cmttypes.BlockIDFlagCommit,
+		}
+	}
+	return votes
+}
@@ -46,6 +46,7 @@ type TelemetrySink interface {
 type BlobSidecars[T any] interface {
 	constraints.SSZMarshallable
 	constraints.Empty[T]
+	NewFromSSZ([]byte, uint32) (T, error)
 }
 
 type validatorUpdates = transition.ValidatorUpdates
@@ -0,0 +1,78 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cometbft
+
+import (
+	"errors"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/p2p"
+	cmtp2p "github.com/cometbft/cometbft/p2p"
+	cmttypes "github.com/cometbft/cometbft/types"
+)
+
+// errNodeNotRunning is returned when the CometBFT node is queried before it
+// has been started.
+var errNodeNotRunning = errors.New("cometbft node is not running")
+
+// NetworkInfo returns the state of the node on the CometBFT p2p network.
+func (s *Service[_]) NetworkInfo() (*p2p.NetworkInfo, error) {
+	n := s.node.Load()
+	if n == nil || !n.IsRunning() {
+		return nil, errNodeNotRunning
+	}
+
+	sw := n.Switch()
+	info := &p2p.NetworkInfo{
+		NodeID:     string(n.NodeInfo().ID()),
+		Discovery:  s.cmtCfg.P2P.PexReactor,
+		CatchingUp: n.ConsensusReactor().WaitSync(),
+	}
+	if addr := sw.NetAddress(); addr != nil {
+		info.ListenAddress = addr.DialString()
+	}
+	_, _, info.Dialing = sw.NumPeers()
+
+	peers := sw.Peers().Copy()
+	info.Peers = make([]*p2p.Peer, 0, len(peers))
+	for _, peer := range peers {
+		info.Peers = append(info.Peers, peerInfo(peer))
+	}
+	return info, nil
+}
+
+// peerInfo maps a CometBFT peer to a p2p.Peer.
+func peerInfo(peer cmtp2p.Peer) *p2p.Peer {
+	p := &p2p.Peer{
+		ID:       string(peer.ID()),
+		Outbound: peer.IsOutbound(),
+	}
+	if addr := peer.SocketAddr(); addr != nil {
+		p.Address = addr.DialString()
+	}
+	// The consensus reactor keeps the latest known round state of every
+	// peer, which is where its height is read from.
+	if ps, ok := peer.Get(cmttypes.PeerStateKey).(interface {
+		GetHeight() int64
+	}); ok {
+		p.Height = ps.GetHeight()
+	}
+	return p
+}
@@ -23,6 +23,7 @@ package cometbft
 import (
 	"context"
 	"errors"
+	"sync/atomic"
 
 	storetypes "cosmossdk.io/store/types"
 	servercmtlog "github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service/log"
@@ -50,7 +51,9 @@ const (
 type Service[
 	LoggerT log.AdvancedLogger[LoggerT],
 ] struct {
-	node   *node.Node
+	// node is set once the CometBFT node has been created in Start, and may
+	// be read concurrently by other services.
+	node   atomic.Pointer[node.Node]
 	cmtCfg *cmtcfg.Config
 
 	logger     LoggerT
@@ -135,7 +138,7 @@ func (s *Service[_]) Start(
 		return err
 	}
 
-	s.node, err = node.NewNode(
+	n, err := node.NewNode(
 		ctx,
 		cfg,
 		pvm.LoadOrGenFilePV(
@@ -153,17 +156,18 @@ func (s *Service[_]) Start(
 		return err
 	}
 
-	return s.node.Start()
+	s.node.Store(n)
+	return n.Start()
 }
 
 // Close is called in start cmd to gracefully cleanup resources.
 func (s *Service[_]) Close() error {
 	var errs []error
 
-	if s.node != nil && s.node.IsRunning() {
+	if n := s.node.Load(); n != nil && n.IsRunning() {
 		s.logger.Info("Stopping CometBFT Node")
 		//#nosec:G703 // its a bet.
-		_ = s.node.Stop()
+		_ = n.Stop()
 	}
 
 	s.logger.Info("Closing application.db")
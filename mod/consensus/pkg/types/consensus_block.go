@@ -24,6 +24,7 @@ import (
 	"time"
 
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
 )
 
 type ConsensusBlock[BeaconBlockT any] struct {
@@ -31,6 +32,10 @@ type ConsensusBlock[BeaconBlockT any] struct {
 
 	// some consensus data useful to build and verify the block
 	*commonConsensusData
+
+	// lastCommitVotes are the votes in the commit of the previous block,
+	// only known once the block is finalized.
+	lastCommitVotes []transition.CommitVote
 }
 
 // New creates a new ConsensusBlock instance.
@@ -38,6 +43,7 @@ func (b *ConsensusBlock[BeaconBlockT]) New(
 	beaconBlock BeaconBlockT,
 	proposerAddress []byte,
 	consensusTime time.Time,
+	lastCommitVotes []transition.CommitVote,
 ) *ConsensusBlock[BeaconBlockT] {
 	b = &ConsensusBlock[BeaconBlockT]{
 		blk: beaconBlock,
@@ -45,6 +51,7 @@ func (b *ConsensusBlock[BeaconBlockT]) New(
 			proposerAddress: proposerAddress,
 			consensusTime:   math.U64(consensusTime.Unix()),
 		},
+		lastCommitVotes: lastCommitVotes,
 	}
 	return b
 }
@@ -52,3 +59,9 @@ func (b *ConsensusBlock[BeaconBlockT]) New(
 func (b *ConsensusBlock[BeaconBlockT]) GetBeaconBlock() BeaconBlockT {
 	return b.blk
 }
+
+// GetLastCommitVotes returns the votes of the validators in the commit of the
+// previous block.
+func (b *ConsensusBlock[_]) GetLastCommitVotes() []transition.CommitVote {
+	return b.lastCommitVotes
+}
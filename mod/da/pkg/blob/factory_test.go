@@ -20,6 +20,18 @@
 
 package blob_test
 
+import (
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/da/pkg/blob"
+	datypes "github.com/berachain/beacon-kit/mod/da/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/stretchr/testify/require"
+)
+
 // TODO: Create a mock such that core/types doesn't need
 // to be imported here.
 
@@ -34,6 +46,69 @@ func (m *MockSpec) MaxBlobCommitmentsPerBlock() uint64 {
 	return 16
 }
 
+// noopSink is a telemetry sink that discards all metrics.
+type noopSink struct{}
+
+func (noopSink) MeasureSince(string, time.Time, ...string) {}
+
+func TestBuildKZGInclusionProof_Forks(t *testing.T) {
+	factory := blob.NewSidecarFactory[
+		*types.BeaconBlock, *types.BeaconBlockBody, *types.BeaconBlockHeader,
+	](&MockSpec{}, types.KZGPositionDeneb, noopSink{})
+
+	tests := []struct {
+		name        string
+		forkVersion uint32
+		merkleIndex uint64
+		depth       int
+	}{
+		{
+			name:        "Deneb",
+			forkVersion: version.Deneb,
+			merkleIndex: types.KZGMerkleIndexDeneb,
+			depth:       datypes.InclusionProofDepthDeneb,
+		},
+		{
+			name:        "Electra",
+			forkVersion: version.Electra,
+			merkleIndex: types.KZGMerkleIndexElectra,
+			depth:       datypes.InclusionProofDepthElectra,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body := (&types.BeaconBlockBody{}).Empty(tt.forkVersion)
+			body.BlobKzgCommitments = []eip4844.KZGCommitment{{1}, {2}}
+
+			proof, err := factory.BuildKZGInclusionProof(body, 1)
+			require.NoError(t, err)
+			require.Len(t, proof, tt.depth)
+
+			sidecar := datypes.BuildBlobSidecar(
+				1,
+				&types.BeaconBlockHeader{BodyRoot: body.HashTreeRoot()},
+				&eip4844.Blob{},
+				body.BlobKzgCommitments[1],
+				eip4844.KZGProof{},
+				proof,
+			)
+			offset := tt.merkleIndex * (&MockSpec{}).MaxBlobCommitmentsPerBlock()
+			require.True(t, sidecar.HasValidInclusionProof(offset))
+
+			// The proof must survive the encoding of the fork.
+			sidecars := &datypes.BlobSidecars{
+				Sidecars: []*datypes.BlobSidecar{sidecar},
+			}
+			bz, err := sidecars.MarshalSSZ()
+			require.NoError(t, err)
+			decoded, err := sidecars.NewFromSSZ(bz, tt.forkVersion)
+			require.NoError(t, err)
+			require.Equal(t, sidecars, decoded)
+			require.True(t, decoded.Get(0).HasValidInclusionProof(offset))
+		})
+	}
+}
+
 // TODO: Re-enable once we can easily decouple from core/types.
 // func TestBuildKZGInclusionProof(t *testing.T) {
 // 	chainspec := &MockSpec{}
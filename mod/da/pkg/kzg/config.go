@@ -20,6 +20,12 @@
 
 package kzg
 
+import (
+	"github.com/berachain/beacon-kit/mod/da/pkg/kzg/ckzg"
+	"github.com/berachain/beacon-kit/mod/da/pkg/kzg/gokzg"
+	"github.com/berachain/beacon-kit/mod/errors"
+)
+
 const (
 	// defaultTrustedSetupPath is the default path to the trusted setup.
 	defaultTrustedSetupPath = "./testing/files/kzg-trusted-setup.json"
@@ -42,3 +48,23 @@ func DefaultConfig() Config {
 		Implementation:   defaultImplementation,
 	}
 }
+
+// Validate returns an error describing every invalid field of the
+// configuration.
+func (c Config) Validate() error {
+	var errs []error
+	if c.TrustedSetupPath == "" {
+		errs = append(errs, errors.New(
+			"trusted-setup-path must point to the KZG trusted setup file",
+		))
+	}
+	if c.Implementation != gokzg.Implementation &&
+		c.Implementation != ckzg.Implementation {
+		errs = append(errs, errors.Wrapf(
+			ErrUnsupportedKzgImplementation,
+			"implementation must be %q or %q, got %q",
+			gokzg.Implementation, ckzg.Implementation, c.Implementation,
+		))
+	}
+	return errors.Join(errs...)
+}
@@ -26,9 +26,34 @@ import (
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/merkle"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 	"github.com/karalabe/ssz"
 )
 
+const (
+	// InclusionProofDepthDeneb is the number of roots in the inclusion proof
+	// of a blob in a Deneb block body.
+	InclusionProofDepthDeneb = 8
+
+	// InclusionProofDepthElectra is the number of roots in the inclusion
+	// proof of a blob in an Electra block body, whose merkle tree is one
+	// level deeper.
+	InclusionProofDepthElectra = InclusionProofDepthDeneb + 1
+)
+
+// electraFilter gates the inclusion proof root added in Electra.
+//
+//nolint:gochecknoglobals // read-only.
+var electraFilter = ssz.ForkFilter{Added: ssz.ForkElectra}
+
+// sszFork returns the SSZ fork of the given fork version.
+func sszFork(forkVersion uint32) ssz.Fork {
+	if forkVersion == version.Electra {
+		return ssz.ForkElectra
+	}
+	return ssz.ForkDeneb
+}
+
 // BlobSidecar as per the Ethereum 2.0 specification:
 // https://github.com/ethereum/consensus-specs/blob/dev/specs/deneb/p2p-interface.md?ref=bankless.ghost.io#blobsidecar
 //
@@ -106,45 +131,80 @@ func (b *BlobSidecar) GetBeaconBlockHeader() *types.BeaconBlockHeader {
 	return b.BeaconBlockHeader
 }
 
-// DefineSSZ defines the SSZ encoding for the BlobSidecar object.
+// DefineSSZ defines the SSZ encoding for the BlobSidecar object. The last
+// root of an Electra inclusion proof is only part of the Electra encoding.
 func (b *BlobSidecar) DefineSSZ(codec *ssz.Codec) {
 	ssz.DefineUint64(codec, &b.Index)
 	ssz.DefineStaticBytes(codec, &b.Blob)
 	ssz.DefineStaticBytes(codec, &b.KzgCommitment)
 	ssz.DefineStaticBytes(codec, &b.KzgProof)
 	ssz.DefineStaticObject(codec, &b.BeaconBlockHeader)
-	//nolint:mnd // depth of 8
-	ssz.DefineCheckedArrayOfStaticBytes(codec, &b.InclusionProof, 8)
+	codec.DefineEncoder(func(enc *ssz.Encoder) {
+		ssz.EncodeCheckedArrayOfStaticBytes(
+			enc, b.InclusionProof, InclusionProofDepthDeneb,
+		)
+	})
+	codec.DefineDecoder(func(dec *ssz.Decoder) {
+		var last *common.Root
+		ssz.DecodeCheckedArrayOfStaticBytes(
+			dec, &b.InclusionProof, InclusionProofDepthDeneb,
+		)
+		ssz.DecodeStaticBytesPointerOnFork(dec, &last, electraFilter)
+		if last != nil {
+			b.InclusionProof = append(b.InclusionProof, *last)
+		}
+	})
+	codec.DefineHasher(func(has *ssz.Hasher) {
+		ssz.HashCheckedArrayOfStaticBytes(has, b.InclusionProof)
+	})
 }
 
 // SizeSSZ returns the size of the BlobSidecar object in SSZ encoding.
-func (b *BlobSidecar) SizeSSZ(*ssz.Sizer) uint32 {
+func (b *BlobSidecar) SizeSSZ(siz *ssz.Sizer) uint32 {
+	depth := uint32(InclusionProofDepthDeneb)
+	if siz.Fork() >= ssz.ForkElectra {
+		depth = InclusionProofDepthElectra
+	}
 	return 8 + // Index
 		131072 + // Blob
 		48 + // KzgCommitment
 		48 + // KzgProof
 		112 + // BeaconBlockHeader
-		8*32 // InclusionProof
+		depth*32 // InclusionProof
+}
+
+// sszFork returns the SSZ fork the sidecar is encoded with, which follows
+// from the depth of its inclusion proof.
+func (b *BlobSidecar) sszFork() ssz.Fork {
+	if len(b.InclusionProof) == InclusionProofDepthElectra {
+		return ssz.ForkElectra
+	}
+	return ssz.ForkDeneb
 }
 
 // MarshalSSZ marshals the BlobSidecar object to SSZ format.
 func (b *BlobSidecar) MarshalSSZ() ([]byte, error) {
-	buf := make([]byte, ssz.Size(b))
-	return buf, ssz.EncodeToBytes(buf, b)
+	buf := make([]byte, ssz.SizeOnFork(b, b.sszFork()))
+	return b.MarshalSSZTo(buf)
 }
 
-// UnmarshalSSZ unmarshals the BlobSidecar object from SSZ format.
+// UnmarshalSSZ unmarshals the BlobSidecar object from SSZ format. The fork is
+// inferred from the size of the static encoding.
 func (b *BlobSidecar) UnmarshalSSZ(buf []byte) error {
-	return ssz.DecodeFromBytes(buf, b)
+	fork := ssz.ForkDeneb
+	if len(buf) == int(ssz.SizeOnFork(b, ssz.ForkElectra)) {
+		fork = ssz.ForkElectra
+	}
+	return ssz.DecodeFromBytesOnFork(buf, b, fork)
 }
 
 // MarshalSSZTo marshals the BlobSidecar object to the provided buffer in SSZ
 // format.
 func (b *BlobSidecar) MarshalSSZTo(buf []byte) ([]byte, error) {
-	return buf, ssz.EncodeToBytes(buf, b)
+	return buf, ssz.EncodeToBytesOnFork(buf, b, b.sszFork())
 }
 
 // HashTreeRoot computes the SSZ hash tree root of the BlobSidecar object.
 func (b *BlobSidecar) HashTreeRoot() common.Root {
-	return ssz.HashSequential(b)
+	return ssz.HashSequentialOnFork(b, b.sszFork())
 }
@@ -106,19 +106,41 @@ func (bs *BlobSidecars) SizeSSZ(siz *ssz.Sizer, fixed bool) uint32 {
 	return 4 + ssz.SizeSliceOfStaticObjects(siz, bs.Sidecars)
 }
 
+// sszFork returns the SSZ fork the sidecars are encoded with. All sidecars
+// belong to the same block, so the first one determines it.
+func (bs *BlobSidecars) sszFork() ssz.Fork {
+	if len(bs.Sidecars) == 0 || bs.Sidecars[0] == nil {
+		return ssz.ForkDeneb
+	}
+	return bs.Sidecars[0].sszFork()
+}
+
 // MarshalSSZ marshals the BlobSidecars object to SSZ format.
 func (bs *BlobSidecars) MarshalSSZ() ([]byte, error) {
-	buf := make([]byte, ssz.Size(bs))
+	buf := make([]byte, ssz.SizeOnFork(bs, bs.sszFork()))
 	return bs.MarshalSSZTo(buf)
 }
 
 // MarshalSSZTo marshals the BlobSidecars object to the provided buffer in SSZ
 // format.
 func (bs *BlobSidecars) MarshalSSZTo(buf []byte) ([]byte, error) {
-	return buf, ssz.EncodeToBytes(buf, bs)
+	return buf, ssz.EncodeToBytesOnFork(buf, bs, bs.sszFork())
 }
 
-// UnmarshalSSZ unmarshals the BlobSidecars object from SSZ format.
+// UnmarshalSSZ unmarshals the BlobSidecars object from SSZ format, as of
+// Deneb.
 func (bs *BlobSidecars) UnmarshalSSZ(buf []byte) error {
 	return ssz.DecodeFromBytes(buf, bs)
 }
+
+// NewFromSSZ creates new BlobSidecars of the given fork version from
+// SSZ-encoded bytes.
+func (bs *BlobSidecars) NewFromSSZ(
+	buf []byte,
+	forkVersion uint32,
+) (*BlobSidecars, error) {
+	sidecars := bs.Empty()
+	return sidecars, ssz.DecodeFromBytesOnFork(
+		buf, sidecars, sszFork(forkVersion),
+	)
+}
@@ -69,6 +69,9 @@ type NewPayloadRequest[
 	VersionedHashes []common.ExecutionHash
 	// ParentBeaconBlockRoot is the root of the parent beacon block.
 	ParentBeaconBlockRoot *common.Root
+	// ExecutionRequests are the encoded requests triggered from the
+	// execution layer, which are nil before Electra.
+	ExecutionRequests []bytes.Bytes
 	// Optimistic is a flag that indicates if the payload should be
 	// optimistically deemed valid. This is useful during syncing.
 	Optimistic bool
@@ -111,12 +114,14 @@ func BuildNewPayloadRequest[
 	executionPayload ExecutionPayloadT,
 	versionedHashes []common.ExecutionHash,
 	parentBeaconBlockRoot *common.Root,
+	executionRequests []bytes.Bytes,
 	optimistic bool,
 ) *NewPayloadRequest[ExecutionPayloadT, WithdrawalsT] {
 	return &NewPayloadRequest[ExecutionPayloadT, WithdrawalsT]{
 		ExecutionPayload:      executionPayload,
 		VersionedHashes:       versionedHashes,
 		ParentBeaconBlockRoot: parentBeaconBlockRoot,
+		ExecutionRequests:     executionRequests,
 		Optimistic:            optimistic,
 	}
 }
@@ -181,7 +186,7 @@ func (n *NewPayloadRequest[ExecutionPayloadT, WithdrawalsT]) HasValidVersionedAn
 
 	// Verify that the payload is telling the truth about it's block hash.
 	//#nosec:G103 // its okay.
-	if block := gethprimitives.NewBlockWithHeader(
+	block := gethprimitives.NewBlockWithHeader(
 		&gethprimitives.Header{
 			ParentHash:       gethprimitives.ExecutionHash(payload.GetParentHash()),
 			UncleHash:        gethprimitives.EmptyUncleHash,
@@ -205,10 +210,24 @@ func (n *NewPayloadRequest[ExecutionPayloadT, WithdrawalsT]) HasValidVersionedAn
 		},
 	).WithBody(gethprimitives.Body{
 		Transactions: txs, Uncles: nil, Withdrawals: *(*gethprimitives.Withdrawals)(unsafe.Pointer(&wds)),
-	}); common.ExecutionHash(block.Hash()) != payload.GetBlockHash() {
+	})
+	blockHash := block.Hash()
+
+	// As of Electra, the block hash also commits to the execution requests
+	// through the requests hash of the header.
+	if n.ExecutionRequests != nil {
+		var err error
+		if blockHash, err = gethprimitives.HeaderHashWithRequests(
+			block.Header(),
+			gethprimitives.ExecutionHash(RequestsHash(n.ExecutionRequests)),
+		); err != nil {
+			return err
+		}
+	}
+	if common.ExecutionHash(blockHash) != payload.GetBlockHash() {
 		return errors.Wrapf(ErrPayloadBlockHashMismatch,
 			"%x, got %x",
-			payload.GetBlockHash(), block.Hash(),
+			payload.GetBlockHash(), blockHash,
 		)
 	}
 	return nil
@@ -101,12 +101,14 @@ func TestBuildNewPayloadRequest(t *testing.T) {
 	executionPayload := MockExecutionPayload{}
 	var versionedHashes []common.ExecutionHash
 	parentBeaconBlockRoot := common.Root{}
+	executionRequests := []bytes.Bytes{{0x01, 0x02}}
 	optimistic := false
 
 	request := engineprimitives.BuildNewPayloadRequest(
 		executionPayload,
 		versionedHashes,
 		&parentBeaconBlockRoot,
+		executionRequests,
 		optimistic,
 	)
 
@@ -114,6 +116,7 @@ func TestBuildNewPayloadRequest(t *testing.T) {
 	require.Equal(t, executionPayload, request.ExecutionPayload)
 	require.Equal(t, versionedHashes, request.VersionedHashes)
 	require.Equal(t, &parentBeaconBlockRoot, request.ParentBeaconBlockRoot)
+	require.Equal(t, executionRequests, request.ExecutionRequests)
 	require.Equal(t, optimistic, request.Optimistic)
 }
 
@@ -155,6 +158,7 @@ func TestHasValidVersionedAndBlockHashesPayloadError(t *testing.T) {
 		executionPayload,
 		versionedHashes,
 		&parentBeaconBlockRoot,
+		nil,
 		optimistic,
 	)
 
@@ -174,9 +178,49 @@ func TestHasValidVersionedAndBlockHashesMismatchedHashes(t *testing.T) {
 		executionPayload,
 		versionedHashes,
 		&parentBeaconBlockRoot,
+		nil,
 		optimistic,
 	)
 
 	err := request.HasValidVersionedAndBlockHashes()
 	require.ErrorIs(t, err, engineprimitives.ErrMismatchedNumVersionedHashes)
 }
+
+func TestHasValidVersionedAndBlockHashesRequests(t *testing.T) {
+	parentBeaconBlockRoot := common.Root{}
+	hashErr := func(requests []bytes.Bytes) error {
+		err := engineprimitives.BuildNewPayloadRequest(
+			MockExecutionPayload{},
+			nil,
+			&parentBeaconBlockRoot,
+			requests,
+			false,
+		).HasValidVersionedAndBlockHashes()
+		require.ErrorIs(t, err, engineprimitives.ErrPayloadBlockHashMismatch)
+		return err
+	}
+
+	// As of Electra, the block hash commits to the requests, even when
+	// there are none.
+	preElectra := hashErr(nil)
+	noRequests := hashErr([]bytes.Bytes{})
+	withRequests := hashErr([]bytes.Bytes{{0x01, 0x02}})
+	require.NotEqual(t, preElectra.Error(), noRequests.Error())
+	require.NotEqual(t, noRequests.Error(), withRequests.Error())
+}
+
+func TestRequestsHash(t *testing.T) {
+	// The hash of no requests is the hash of the empty string.
+	empty := common.NewExecutionHashFromHex(
+		"0xe3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+	)
+	require.Equal(t, empty, engineprimitives.RequestsHash(nil))
+
+	// Requests without data are not committed to.
+	require.Equal(t, empty, engineprimitives.RequestsHash(
+		[]bytes.Bytes{{0x01}, {0x02}},
+	))
+	require.NotEqual(t, empty, engineprimitives.RequestsHash(
+		[]bytes.Bytes{{0x01, 0x02}},
+	))
+}
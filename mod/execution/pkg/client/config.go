@@ -21,8 +21,10 @@
 package client
 
 import (
+	"fmt"
 	"time"
 
+	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/url"
 )
 
@@ -68,3 +70,39 @@ type Config struct {
 	// JWTSecretPath is the path to the JWT secret.
 	JWTSecretPath string `mapstructure:"jwt-secret-path"`
 }
+
+// Validate returns an error describing every invalid field of the
+// configuration.
+func (c Config) Validate() error {
+	var errs []error
+	if c.RPCDialURL == nil {
+		errs = append(errs, errors.New(
+			"rpc-dial-url must be set to the execution client's "+
+				"authenticated JSON-RPC endpoint, e.g. http://localhost:8551",
+		))
+	}
+	if c.RPCTimeout <= 0 {
+		errs = append(errs, fmt.Errorf(
+			"rpc-timeout must be greater than zero, got %s", c.RPCTimeout,
+		))
+	}
+	if c.RPCStartupCheckInterval <= 0 {
+		errs = append(errs, fmt.Errorf(
+			"rpc-startup-check-interval must be greater than zero, got %s",
+			c.RPCStartupCheckInterval,
+		))
+	}
+	if c.RPCJWTRefreshInterval <= 0 {
+		errs = append(errs, fmt.Errorf(
+			"rpc-jwt-refresh-interval must be greater than zero, got %s",
+			c.RPCJWTRefreshInterval,
+		))
+	}
+	if c.JWTSecretPath == "" {
+		errs = append(errs, errors.New(
+			"jwt-secret-path must point to the JWT secret shared with the "+
+				"execution client, generate one with `beacond jwt generate`",
+		))
+	}
+	return errors.Join(errs...)
+}
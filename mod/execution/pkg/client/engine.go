@@ -28,6 +28,7 @@ import (
 	engineerrors "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/errors"
 	"github.com/berachain/beacon-kit/mod/errors"
 	ethclient "github.com/berachain/beacon-kit/mod/execution/pkg/client/ethclient"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 )
 
@@ -43,6 +44,7 @@ func (s *EngineClient[
 	payload ExecutionPayloadT,
 	versionedHashes []common.ExecutionHash,
 	parentBeaconBlockRoot *common.Root,
+	executionRequests []bytes.Bytes,
 ) (*common.ExecutionHash, error) {
 	var (
 		startTime    = time.Now()
@@ -54,6 +56,7 @@ func (s *EngineClient[
 	// Call the appropriate RPC method based on the payload version.
 	result, err := s.Client.NewPayload(
 		cctx, payload, versionedHashes, parentBeaconBlockRoot,
+		executionRequests,
 	)
 	if err != nil {
 		if errors.Is(err, engineerrors.ErrEngineAPITimeout) {
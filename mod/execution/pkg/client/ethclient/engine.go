@@ -24,6 +24,7 @@ import (
 	"context"
 
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
@@ -33,17 +34,25 @@ import (
 /*                                 NewPayload                                 */
 /* -------------------------------------------------------------------------- */
 
-// NewPayload calls the engine_newPayloadV3 method via JSON-RPC.
+// NewPayload calls the engine_newPayloadV3 method via JSON-RPC, or the
+// engine_newPayloadV4 method when given the execution requests of an
+// Electra block.
 func (s *Client[ExecutionPayloadT]) NewPayload(
 	ctx context.Context,
 	payload ExecutionPayloadT,
 	versionedHashes []common.ExecutionHash,
 	parentBlockRoot *common.Root,
+	executionRequests []bytes.Bytes,
 ) (*engineprimitives.PayloadStatusV1, error) {
 	if payload.Version() < version.Deneb {
 		return nil, ErrInvalidVersion
 	}
 
+	if executionRequests != nil {
+		return s.NewPayloadV4(
+			ctx, payload, versionedHashes, parentBlockRoot, executionRequests,
+		)
+	}
 	return s.NewPayloadV3(
 		ctx, payload, versionedHashes, parentBlockRoot,
 	)
@@ -65,6 +74,25 @@ func (s *Client[ExecutionPayloadT]) NewPayloadV3(
 	return result, nil
 }
 
+// NewPayloadV4 is used to call the underlying JSON-RPC method for newPayload
+// as of Electra.
+func (s *Client[ExecutionPayloadT]) NewPayloadV4(
+	ctx context.Context,
+	payload ExecutionPayloadT,
+	versionedHashes []common.ExecutionHash,
+	parentBlockRoot *common.Root,
+	executionRequests []bytes.Bytes,
+) (*engineprimitives.PayloadStatusV1, error) {
+	result := &engineprimitives.PayloadStatusV1{}
+	if err := s.Call(
+		ctx, result, NewPayloadMethodV4, payload, versionedHashes,
+		parentBlockRoot, executionRequests,
+	); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 /* -------------------------------------------------------------------------- */
 /*                              ForkchoiceUpdated                             */
 /* -------------------------------------------------------------------------- */
@@ -127,16 +155,44 @@ func (s *Client[ExecutionPayloadT]) GetPayload(
 	payloadID engineprimitives.PayloadID,
 	forkVersion uint32,
 ) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error) {
-	if forkVersion < version.Deneb {
+	switch {
+	case forkVersion < version.Deneb:
 		return nil, ErrInvalidVersion
+	case forkVersion >= version.Electra:
+		return s.GetPayloadV4(ctx, payloadID)
+	default:
+		return s.GetPayloadV3(ctx, payloadID)
 	}
-
-	return s.GetPayloadV3(ctx, payloadID)
 }
 
 // GetPayloadV3 calls the engine_getPayloadV3 method via JSON-RPC.
 func (s *Client[ExecutionPayloadT]) GetPayloadV3(
 	ctx context.Context, payloadID engineprimitives.PayloadID,
+) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error) {
+	return s.getPayload(ctx, GetPayloadMethodV3, payloadID)
+}
+
+// GetPayloadV4 calls the engine_getPayloadV4 method via JSON-RPC, which also
+// returns the execution requests of the payload.
+func (s *Client[ExecutionPayloadT]) GetPayloadV4(
+	ctx context.Context, payloadID engineprimitives.PayloadID,
+) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error) {
+	result, err := s.getPayload(ctx, GetPayloadMethodV4, payloadID)
+	if err != nil {
+		return nil, err
+	}
+	if result.GetExecutionRequests() == nil {
+		return nil, ErrNilExecutionRequests
+	}
+	return result, nil
+}
+
+// getPayload is a helper function to call to any version of the getPayload
+// method.
+func (s *Client[ExecutionPayloadT]) getPayload(
+	ctx context.Context,
+	method string,
+	payloadID engineprimitives.PayloadID,
 ) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error) {
 	var t ExecutionPayloadT
 	result := &engineprimitives.ExecutionPayloadEnvelope[
@@ -148,9 +204,7 @@ func (s *Client[ExecutionPayloadT]) GetPayloadV3(
 		ExecutionPayload: t.Empty(version.Deneb),
 	}
 
-	if err := s.Call(
-		ctx, result, GetPayloadMethodV3, payloadID,
-	); err != nil {
+	if err := s.Call(ctx, result, method, payloadID); err != nil {
 		return nil, err
 	}
 	return result, nil
@@ -33,4 +33,8 @@ var (
 	// ErrInvalidVersion is an error that is returned when the version is
 	// invalid.
 	ErrInvalidVersion = errors.New("invalid version")
+
+	// ErrNilExecutionRequests is an error that is returned when a payload
+	// built as of Electra comes without execution requests.
+	ErrNilExecutionRequests = errors.New("nil execution requests")
 )
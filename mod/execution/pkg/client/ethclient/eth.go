@@ -21,6 +21,7 @@
 package ethclient
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"math/big"
@@ -44,6 +45,30 @@ func (ec *Client[ExecutionPayloadT]) ChainID(
 	return result, nil
 }
 
+// BlockNumber retrieves the number of the most recent block.
+func (ec *Client[ExecutionPayloadT]) BlockNumber(
+	ctx context.Context,
+) (math.U64, error) {
+	var result math.U64
+	if err := ec.Call(ctx, &result, "eth_blockNumber"); err != nil {
+		return 0, err
+	}
+	return result, nil
+}
+
+// Syncing reports whether the execution client is syncing. eth_syncing
+// returns false when the client is in sync, and an object describing the
+// sync progress otherwise.
+func (ec *Client[ExecutionPayloadT]) Syncing(
+	ctx context.Context,
+) (bool, error) {
+	result, err := ec.CallRaw(ctx, "eth_syncing")
+	if err != nil {
+		return false, err
+	}
+	return !bytes.Equal(bytes.TrimSpace(result), []byte("false")), nil
+}
+
 // TODO: Figure out how to unhood all this.
 
 // FilterLogs executes a filter query.
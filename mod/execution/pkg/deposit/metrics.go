@@ -47,3 +47,10 @@ func (m *metrics) markFailedToGetBlockLogs(blockNum math.U64) {
 		strconv.FormatUint(blockNum.Unwrap(), 10),
 	)
 }
+
+// markForcedCatchup increments the counter for forced deposit catchups.
+func (m *metrics) markForcedCatchup() {
+	m.sink.IncrementCounter(
+		"beacon_kit.execution.deposit.forced_catchup",
+	)
+}
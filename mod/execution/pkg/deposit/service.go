@@ -60,6 +60,9 @@ type Service[
 	// failedBlocks is a map of blocks that failed to be processed
 	// and should be retried.
 	failedBlocks map[math.U64]struct{}
+	// catchupRequested signals the catchup fetcher to retry failed blocks
+	// immediately instead of waiting for the next retry interval.
+	catchupRequested chan struct{}
 }
 
 // NewService creates a new instance of the Service struct.
@@ -89,6 +92,7 @@ func NewService[
 		ds:                      ds,
 		eth1FollowDistance:      eth1FollowDistance,
 		failedBlocks:            make(map[math.Slot]struct{}),
+		catchupRequested:        make(chan struct{}, 1),
 		subFinalizedBlockEvents: make(chan async.Event[BeaconBlockT]),
 		logger:                  logger,
 		metrics:                 newMetrics(telemetrySink),
@@ -138,6 +142,22 @@ func (s *Service[
 	return "deposit-handler"
 }
 
+// ForceCatchup schedules deposits for the given execution block numbers to be
+// (re)fetched and triggers an immediate catchup of all failed blocks.
+func (s *Service[
+	_, _, _, _, _,
+]) ForceCatchup(blockNums ...math.U64) {
+	for _, blockNum := range blockNums {
+		s.markFailedBlock(blockNum)
+	}
+	s.metrics.markForcedCatchup()
+	select {
+	case s.catchupRequested <- struct{}{}:
+	default:
+		// A catchup is already pending.
+	}
+}
+
 func (s *Service[
 	_, _, _, _, _,
 ]) markFailedBlock(blockNum math.U64) {
@@ -52,24 +52,33 @@ func (s *Service[
 		case <-ctx.Done():
 			return
 		case <-ticker.C:
-			failedBlks := s.getFailedBlocks()
-			if len(failedBlks) == 0 {
-				continue
-			}
-			s.logger.Warn(
-				"Failed to get deposits from block(s), retrying...",
-				"num_blocks",
-				failedBlks,
-			)
-
-			// Fetch deposits for blocks that failed to be processed.
-			for _, blockNum := range failedBlks {
-				s.fetchAndStoreDeposits(ctx, blockNum)
-			}
+			s.retryFailedBlocks(ctx)
+		case <-s.catchupRequested:
+			s.retryFailedBlocks(ctx)
 		}
 	}
 }
 
+// retryFailedBlocks fetches deposits for blocks that failed to be processed.
+func (s *Service[
+	_, _, _, _, _,
+]) retryFailedBlocks(ctx context.Context) {
+	failedBlks := s.getFailedBlocks()
+	if len(failedBlks) == 0 {
+		return
+	}
+	s.logger.Warn(
+		"Failed to get deposits from block(s), retrying...",
+		"num_blocks",
+		failedBlks,
+	)
+
+	// Fetch deposits for blocks that failed to be processed.
+	for _, blockNum := range failedBlks {
+		s.fetchAndStoreDeposits(ctx, blockNum)
+	}
+}
+
 func (s *Service[
 	_, _, _, _, _,
 ]) fetchAndStoreDeposits(ctx context.Context, blockNum math.U64) {
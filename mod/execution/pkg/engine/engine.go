@@ -210,6 +210,7 @@ func (ee *Engine[
 		req.ExecutionPayload,
 		req.VersionedHashes,
 		req.ParentBeaconBlockRoot,
+		req.ExecutionRequests,
 	)
 
 	// We abstract away some of the complexity and categorize status codes
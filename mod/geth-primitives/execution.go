@@ -25,6 +25,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
 	coretypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/trie"
 )
 
@@ -39,6 +40,9 @@ type (
 	ExecutionHash  = common.Hash
 	ExecutableData = engine.ExecutableData
 	Genesis        = core.Genesis
+	GenesisAlloc   = coretypes.GenesisAlloc
+	Account        = coretypes.Account
+	ChainConfig    = params.ChainConfig
 	Block          = coretypes.Block
 	Body           = coretypes.Body
 	Log            = coretypes.Log
@@ -50,6 +54,12 @@ type (
 	Withdrawals    = coretypes.Withdrawals
 )
 
+const (
+	// ReceiptStatusSuccessful is the status of a receipt of a transaction
+	// whose execution succeeded.
+	ReceiptStatusSuccessful = coretypes.ReceiptStatusSuccessful
+)
+
 //nolint:gochecknoglobals // alias.
 var (
 	BlockToExecutableData = engine.BlockToExecutableData
@@ -57,4 +67,6 @@ var (
 	DeriveSha             = coretypes.DeriveSha
 	EmptyUncleHash        = coretypes.EmptyUncleHash
 	NewStackTrie          = trie.NewStackTrie
+	BeaconRootsAddress    = params.BeaconRootsAddress
+	BeaconRootsCode       = params.BeaconRootsCode
 )
@@ -0,0 +1,51 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package gethprimitives
+
+import (
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// HeaderHashWithRequests returns the hash of the header extended with the
+// EIP-7685 requests hash, which follows the parent beacon root in the
+// encoding of the header as of Prague.
+func HeaderHashWithRequests(
+	header *Header,
+	requestsHash ExecutionHash,
+) (ExecutionHash, error) {
+	enc, err := rlp.EncodeToBytes(header)
+	if err != nil {
+		return ExecutionHash{}, err
+	}
+	var fields []rlp.RawValue
+	if err = rlp.DecodeBytes(enc, &fields); err != nil {
+		return ExecutionHash{}, err
+	}
+	field, err := rlp.EncodeToBytes(requestsHash)
+	if err != nil {
+		return ExecutionHash{}, err
+	}
+	if enc, err = rlp.EncodeToBytes(append(fields, field)); err != nil {
+		return ExecutionHash{}, err
+	}
+	return crypto.Keccak256Hash(enc), nil
+}
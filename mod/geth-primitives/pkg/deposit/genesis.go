@@ -0,0 +1,76 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm/runtime"
+)
+
+// GenesisAccount returns the account predeploying the deposit contract with
+// the given owner in the alloc of an eth1 genesis. It holds the code and the
+// storage the deployment of the contract results in.
+func GenesisAccount(owner common.Address) (types.Account, error) {
+	abi, err := BeaconDepositContractMetaData.GetAbi()
+	if err != nil {
+		return types.Account{}, err
+	}
+	args, err := abi.Pack("", owner)
+	if err != nil {
+		return types.Account{}, err
+	}
+
+	statedb, err := state.New(
+		types.EmptyRootHash,
+		state.NewDatabase(rawdb.NewMemoryDatabase()),
+		nil,
+	)
+	if err != nil {
+		return types.Account{}, err
+	}
+	// The constructor only writes to the storage of the contract.
+	storage := make(map[common.Hash]common.Hash)
+	statedb.SetLogger(&tracing.Hooks{
+		OnStorageChange: func(_ common.Address, slot, _, value common.Hash) {
+			storage[slot] = value
+		},
+	})
+
+	code, address, _, err := runtime.Create(
+		append(common.FromHex(BeaconDepositContractMetaData.Bin), args...),
+		&runtime.Config{State: statedb},
+	)
+	if err != nil {
+		return types.Account{}, err
+	}
+	return types.Account{
+		Code:    code,
+		Storage: storage,
+		Balance: new(big.Int),
+		Nonce:   statedb.GetNonce(address),
+	}, nil
+}
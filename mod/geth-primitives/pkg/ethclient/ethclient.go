@@ -28,5 +28,6 @@ type (
 
 //nolint:gochecknoglobals // its okay.
 var (
-	NewClient = ethclient.NewClient
+	DialContext = ethclient.DialContext
+	NewClient   = ethclient.NewClient
 )
@@ -20,6 +20,24 @@
 
 package phuslu
 
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+const (
+	// defaultSamplingInterval is the default window over which identical
+	// messages are counted for sampling.
+	defaultSamplingInterval = time.Second
+	// defaultFileMaxSize is the default maximum log file size in megabytes.
+	defaultFileMaxSize = 100
+	// defaultFileMaxBackups is the default number of rotated log files kept.
+	defaultFileMaxBackups = 10
+	// bytesPerMegabyte is used to convert FileMaxSize to bytes.
+	bytesPerMegabyte = 1 << 20
+)
+
 // Config is a structure that defines the configuration for the logger.
 type Config struct {
 	// TimeFormat is a string that defines the format of the time in
@@ -29,13 +47,80 @@ type Config struct {
 	LogLevel string `mapstructure:"log-level"`
 	// pretty or json.
 	Style string `mapstructure:"style"`
+	// ModuleLevels overrides LogLevel for individual services, formatted as a
+	// comma separated list of service=level pairs, e.g.
+	// "blockchain=debug,engine=warn".
+	ModuleLevels string `mapstructure:"module-levels"`
+	// SamplingInitial is the number of identical messages logged per
+	// SamplingInterval before sampling kicks in. Zero disables sampling.
+	SamplingInitial uint64 `mapstructure:"sampling-initial"`
+	// SamplingThereafter logs every Nth identical message once
+	// SamplingInitial has been reached within a SamplingInterval.
+	SamplingThereafter uint64 `mapstructure:"sampling-thereafter"`
+	// SamplingInterval is the window over which identical messages are
+	// counted.
+	SamplingInterval time.Duration `mapstructure:"sampling-interval"`
+	// FilePath is the path of a file to additionally write JSON logs to.
+	// File output is disabled if empty.
+	FilePath string `mapstructure:"file-path"`
+	// FileMaxSize is the maximum size in megabytes of the log file before it
+	// gets rotated.
+	FileMaxSize int64 `mapstructure:"file-max-size"`
+	// FileMaxBackups is the maximum number of rotated log files to retain.
+	FileMaxBackups int `mapstructure:"file-max-backups"`
 }
 
 // DefaultConfig is a function that returns a new Config with default values.
 func DefaultConfig() Config {
 	return Config{
-		TimeFormat: "RFC3339",
-		LogLevel:   "info",
-		Style:      StylePretty,
+		TimeFormat:       "RFC3339",
+		LogLevel:         "info",
+		Style:            StylePretty,
+		ModuleLevels:     "",
+		SamplingInterval: defaultSamplingInterval,
+		FileMaxSize:      defaultFileMaxSize,
+		FileMaxBackups:   defaultFileMaxBackups,
+	}
+}
+
+// Validate returns an error describing every invalid field of the
+// configuration.
+func (c Config) Validate() error {
+	var errs []error
+	if _, err := ParseLevel(c.LogLevel); err != nil {
+		errs = append(errs, fmt.Errorf(
+			"log-level: %w, expected one of trace, debug, info, warn or error",
+			err,
+		))
+	}
+	if _, err := ParseModuleLevels(c.ModuleLevels); err != nil {
+		errs = append(errs, fmt.Errorf(
+			"module-levels: %w, expected service=level pairs such as "+
+				"\"blockchain=debug,engine=warn\"",
+			err,
+		))
+	}
+	if c.Style != StylePretty && c.Style != StyleJSON {
+		errs = append(errs, fmt.Errorf(
+			"style must be %q or %q, got %q", StylePretty, StyleJSON, c.Style,
+		))
+	}
+	if c.SamplingInitial > 0 && c.SamplingInterval <= 0 {
+		errs = append(errs, fmt.Errorf(
+			"sampling-interval must be greater than zero when sampling is "+
+				"enabled, got %s", c.SamplingInterval,
+		))
+	}
+	if c.FilePath != "" && c.FileMaxSize <= 0 {
+		errs = append(errs, fmt.Errorf(
+			"file-max-size must be greater than zero when file-path is set, "+
+				"got %d", c.FileMaxSize,
+		))
+	}
+	if c.FileMaxBackups < 0 {
+		errs = append(errs, fmt.Errorf(
+			"file-max-backups must not be negative, got %d", c.FileMaxBackups,
+		))
 	}
+	return errors.Join(errs...)
 }
@@ -0,0 +1,141 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package phuslu
+
+import (
+	"fmt"
+	"maps"
+	"strings"
+	"sync"
+
+	"github.com/phuslu/log"
+)
+
+// moduleKey is the context key used to identify the module a child logger
+// belongs to.
+const moduleKey = "service"
+
+// levels holds the global log level along with per-module overrides. It is
+// shared between a logger and all of its children so that level changes are
+// observed by every logger derived from the same root.
+type levels struct {
+	mu sync.RWMutex
+	// global is the level used by modules without an override.
+	global log.Level
+	// modules maps a module name to its level override.
+	modules map[string]log.Level
+}
+
+// newLevels creates a new levels set defaulting to the info level.
+func newLevels() *levels {
+	return &levels{
+		global:  log.InfoLevel,
+		modules: make(map[string]log.Level),
+	}
+}
+
+// levelFor returns the effective level of the given module. Modules are
+// matched hierarchically on '.', so an override for "engine" also applies to
+// "engine.client" unless it has an override of its own.
+func (ls *levels) levelFor(module string) log.Level {
+	ls.mu.RLock()
+	defer ls.mu.RUnlock()
+	for module != "" {
+		if level, ok := ls.modules[module]; ok {
+			return level
+		}
+		idx := strings.LastIndexByte(module, '.')
+		if idx < 0 {
+			break
+		}
+		module = module[:idx]
+	}
+	return ls.global
+}
+
+// setGlobal sets the global level.
+func (ls *levels) setGlobal(level log.Level) {
+	ls.mu.Lock()
+	defer ls.mu.Unlock()
+	ls.global = level
+}
+
+// setModule sets the level override of a single module.
+func (ls *levels) setModule(module string, level log.Level) {
+	ls.mu.Lock()
+	defer ls.mu.Unlock()
+	ls.modules[module] = level
+}
+
+// removeModule removes the level override of a single module.
+func (ls *levels) removeModule(module string) {
+	ls.mu.Lock()
+	defer ls.mu.Unlock()
+	delete(ls.modules, module)
+}
+
+// snapshot returns the global level along with a copy of the module
+// overrides.
+func (ls *levels) snapshot() (log.Level, map[string]log.Level) {
+	ls.mu.RLock()
+	defer ls.mu.RUnlock()
+	return ls.global, maps.Clone(ls.modules)
+}
+
+// setModules replaces all module overrides.
+func (ls *levels) setModules(modules map[string]log.Level) {
+	ls.mu.Lock()
+	defer ls.mu.Unlock()
+	ls.modules = modules
+}
+
+// ParseModuleLevels parses a comma separated list of module=level pairs, e.g.
+// "blockchain=debug,engine=warn", into a map of module to level.
+func ParseModuleLevels(s string) (map[string]log.Level, error) {
+	modules := make(map[string]log.Level)
+	for _, pair := range strings.Split(s, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		module, levelStr, ok := strings.Cut(pair, "=")
+		module = strings.TrimSpace(module)
+		if !ok || module == "" {
+			return nil, fmt.Errorf("invalid module level %q", pair)
+		}
+		level, err := ParseLevel(strings.TrimSpace(levelStr))
+		if err != nil {
+			return nil, fmt.Errorf("module %s: %w", module, err)
+		}
+		modules[module] = level
+	}
+	return modules, nil
+}
+
+// ParseLevel parses a level string, returning an error if it is not a known
+// level.
+func ParseLevel(s string) (log.Level, error) {
+	level := log.ParseLevel(s)
+	if level > log.PanicLevel {
+		return level, fmt.Errorf("unknown log level %q", s)
+	}
+	return level, nil
+}
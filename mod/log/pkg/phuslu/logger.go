@@ -36,6 +36,17 @@ type Logger struct {
 	out io.Writer
 	// formatter is the formatter to use for the logger.
 	formatter *Formatter
+	// module is the name of the module the logger belongs to, as set by the
+	// "service" key passed to With.
+	module string
+	// levels holds the global and per-module log levels, shared with all
+	// children of the logger.
+	levels *levels
+	// sampler drops high-frequency messages, shared with all children of the
+	// logger.
+	sampler *sampler
+	// file is the rotating file writer, if file output is enabled.
+	file *log.FileWriter
 }
 
 // NewLogger initializes a new wrapped phuslogger with the provided config.
@@ -44,10 +55,17 @@ func NewLogger(
 	cfg *Config,
 ) *Logger {
 	logger := &Logger{
-		logger:    &log.Logger{},
+		logger: &log.Logger{
+			// Level filtering is performed by the wrapper to support
+			// per-module levels.
+			Level:     log.TraceLevel,
+			TimeField: timeField,
+		},
 		context:   make(log.Fields),
 		out:       out,
 		formatter: NewFormatter(),
+		levels:    newLevels(),
+		sampler:   newSampler(),
 	}
 	logger.WithConfig(cfg)
 	return logger
@@ -55,7 +73,7 @@ func NewLogger(
 
 // Info logs a message at level Info.
 func (l *Logger) Info(msg string, keyVals ...any) {
-	if l.logger.Level > log.InfoLevel {
+	if !l.enabled(log.InfoLevel, msg) {
 		return
 	}
 	l.msgWithContext(msg, l.logger.Info(), keyVals...)
@@ -63,7 +81,7 @@ func (l *Logger) Info(msg string, keyVals ...any) {
 
 // Warn logs a message at level Warn.
 func (l *Logger) Warn(msg string, keyVals ...any) {
-	if l.logger.Level > log.WarnLevel {
+	if !l.enabled(log.WarnLevel, msg) {
 		return
 	}
 	l.msgWithContext(msg, l.logger.Warn(), keyVals...)
@@ -71,7 +89,7 @@ func (l *Logger) Warn(msg string, keyVals ...any) {
 
 // Error logs a message at level Error.
 func (l *Logger) Error(msg string, keyVals ...any) {
-	if l.logger.Level > log.ErrorLevel {
+	if !l.enabled(log.ErrorLevel, msg) {
 		return
 	}
 	l.msgWithContext(msg, l.logger.Error(), keyVals...)
@@ -79,7 +97,7 @@ func (l *Logger) Error(msg string, keyVals ...any) {
 
 // Debug logs a message at level Debug.
 func (l *Logger) Debug(msg string, keyVals ...any) {
-	if l.logger.Level > log.DebugLevel {
+	if !l.enabled(log.DebugLevel, msg) {
 		return
 	}
 	l.msgWithContext(msg, l.logger.Debug(), keyVals...)
@@ -107,6 +125,10 @@ func (l Logger) With(keyVals ...any) *Logger {
 			continue
 		}
 		newLogger.context[key] = keyVals[i+1]
+		if module, isString := keyVals[i+1].(string); isString &&
+			key == moduleKey {
+			newLogger.module = module
+		}
 	}
 
 	return &newLogger
@@ -117,6 +139,15 @@ func (l *Logger) Writer() io.Writer {
 	return l.out
 }
 
+// enabled reports whether a message at the given level should be logged by
+// this logger, taking the module level and sampling into account.
+func (l *Logger) enabled(level log.Level, msg string) bool {
+	if level < l.levels.levelFor(l.module) {
+		return false
+	}
+	return l.sampler.allow(level, msg)
+}
+
 // msgWithContext logs a message with keyVals and current context.
 func (l *Logger) msgWithContext(
 	msg string, e *log.Entry, keyVals ...any,
@@ -137,11 +168,63 @@ func (l *Logger) WithConfig(cfg *Config) *Logger {
 		cfg = &c
 	}
 	l.withTimeFormat(cfg.TimeFormat)
+	l.withFile(cfg)
 	l.withStyle(cfg.Style)
 	l.withLogLevel(cfg.LogLevel)
+	l.withModuleLevels(cfg.ModuleLevels)
+	l.sampler.configure(
+		cfg.SamplingInitial, cfg.SamplingThereafter, cfg.SamplingInterval,
+	)
 	return l
 }
 
+// SetLevel sets the global log level of the logger and all of its children
+// at runtime.
+func (l *Logger) SetLevel(level string) error {
+	parsed, err := ParseLevel(level)
+	if err != nil {
+		return err
+	}
+	l.levels.setGlobal(parsed)
+	return nil
+}
+
+// SetModuleLevel overrides the log level of the given module at runtime. An
+// empty level removes the override, reverting the module to the global level.
+func (l *Logger) SetModuleLevel(module, level string) error {
+	if level == "" {
+		l.levels.removeModule(module)
+		return nil
+	}
+	parsed, err := ParseLevel(level)
+	if err != nil {
+		return err
+	}
+	l.levels.setModule(module, parsed)
+	return nil
+}
+
+// SetModuleLevels replaces all module level overrides at runtime with the
+// given comma separated list of module=level pairs.
+func (l *Logger) SetModuleLevels(moduleLevels string) error {
+	modules, err := ParseModuleLevels(moduleLevels)
+	if err != nil {
+		return err
+	}
+	l.levels.setModules(modules)
+	return nil
+}
+
+// Levels returns the global log level along with the per-module overrides.
+func (l *Logger) Levels() (string, map[string]string) {
+	global, modules := l.levels.snapshot()
+	overrides := make(map[string]string, len(modules))
+	for module, level := range modules {
+		overrides[module] = level.String()
+	}
+	return global.String(), overrides
+}
+
 // AddKeyColor applies a color to log entries based on their keys.
 func (l *Logger) AddKeyColor(key any, color Color) {
 	l.formatter.AddKeyColor(key.(string), color)
@@ -162,9 +245,38 @@ func (l *Logger) withStyle(style string) {
 	}
 }
 
-// SetLevel sets the log level of the logger.
+// withLogLevel sets the global log level of the logger. Unknown levels are
+// ignored.
 func (l *Logger) withLogLevel(level string) {
-	l.logger.Level = log.ParseLevel(level)
+	if parsed, err := ParseLevel(level); err == nil {
+		l.levels.setGlobal(parsed)
+	}
+}
+
+// withModuleLevels sets the per-module log level overrides of the logger.
+// Malformed overrides are ignored.
+func (l *Logger) withModuleLevels(moduleLevels string) {
+	if modules, err := ParseModuleLevels(moduleLevels); err == nil {
+		l.levels.setModules(modules)
+	}
+}
+
+// withFile enables writing JSON logs to a size-rotated file if a file path is
+// configured.
+func (l *Logger) withFile(cfg *Config) {
+	if l.file != nil {
+		_ = l.file.Close()
+		l.file = nil
+	}
+	if cfg.FilePath == "" {
+		return
+	}
+	l.file = &log.FileWriter{
+		Filename:     cfg.FilePath,
+		MaxSize:      cfg.FileMaxSize * bytesPerMegabyte,
+		MaxBackups:   cfg.FileMaxBackups,
+		EnsureFolder: true,
+	}
 }
 
 // useConsoleWriter sets the logger to use a console writer.
@@ -180,7 +292,11 @@ func (l *Logger) useJSONWriter() {
 	l.setWriter(log.IOWriter{Writer: l.out})
 }
 
-// setWriter sets the writer of the logger.
+// setWriter sets the writer of the logger, teeing output to the log file if
+// file output is enabled.
 func (l *Logger) setWriter(writer log.Writer) {
+	if l.file != nil {
+		writer = &log.MultiEntryWriter{writer, l.file}
+	}
 	l.logger.Writer = writer
 }
@@ -0,0 +1,161 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package phuslu_test
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/log/pkg/phuslu"
+)
+
+func newJSONLogger(buf *bytes.Buffer, cfg phuslu.Config) *phuslu.Logger {
+	cfg.Style = phuslu.StyleJSON
+	return phuslu.NewLogger(buf, &cfg)
+}
+
+func TestLogger_JSONFields(t *testing.T) {
+	buf := &bytes.Buffer{}
+	logger := newJSONLogger(buf, phuslu.DefaultConfig()).
+		With("service", "blockchain")
+	logger.Info("hello", "slot", 1)
+
+	out := buf.String()
+	for _, field := range []string{
+		`"time":`, `"level":"info"`, `"service":"blockchain"`,
+		`"slot":1`, `"message":"hello"`,
+	} {
+		if !strings.Contains(out, field) {
+			t.Errorf("expected %s in output %s", field, out)
+		}
+	}
+}
+
+func TestLogger_ModuleLevels(t *testing.T) {
+	buf := &bytes.Buffer{}
+	cfg := phuslu.DefaultConfig()
+	cfg.ModuleLevels = "blockchain=debug,engine=warn"
+	root := newJSONLogger(buf, cfg)
+
+	root.Debug("root debug")
+	root.With("service", "blockchain").Debug("blockchain debug")
+	root.With("service", "engine.client").Info("engine info")
+	root.With("service", "engine.client").Warn("engine warn")
+
+	out := buf.String()
+	if strings.Contains(out, "root debug") {
+		t.Error("expected root debug message to be filtered")
+	}
+	if !strings.Contains(out, "blockchain debug") {
+		t.Error("expected blockchain debug message to be logged")
+	}
+	if strings.Contains(out, "engine info") {
+		t.Error("expected engine info message to be filtered")
+	}
+	if !strings.Contains(out, "engine warn") {
+		t.Error("expected engine warn message to be logged")
+	}
+}
+
+func TestLogger_Sampling(t *testing.T) {
+	buf := &bytes.Buffer{}
+	cfg := phuslu.DefaultConfig()
+	cfg.SamplingInitial = 2
+	cfg.SamplingThereafter = 3
+	logger := newJSONLogger(buf, cfg)
+
+	for range 8 {
+		logger.Info("repeated")
+		logger.Error("failure")
+	}
+
+	// 2 initial messages, then the 3rd and 6th of the remaining 6.
+	if n := strings.Count(buf.String(), "repeated"); n != 4 {
+		t.Errorf("expected 4 sampled messages, got %d", n)
+	}
+	if n := strings.Count(buf.String(), "failure"); n != 8 {
+		t.Errorf("expected 8 error messages, got %d", n)
+	}
+}
+
+func TestLogger_SamplingPrunesExpiredCounters(t *testing.T) {
+	buf := &bytes.Buffer{}
+	cfg := phuslu.DefaultConfig()
+	cfg.SamplingInitial = 1
+	cfg.SamplingInterval = 100 * time.Millisecond
+	logger := newJSONLogger(buf, cfg)
+
+	for i := range 100 {
+		logger.Info("distinct", "i", i)
+		logger.Info(strconv.Itoa(i))
+	}
+	if n := phuslu.SampledMessages(logger); n != 101 {
+		t.Fatalf("expected 101 counters, got %d", n)
+	}
+
+	// The counters of the messages not logged since are dropped once their
+	// window expired.
+	time.Sleep(2 * cfg.SamplingInterval)
+	logger.Info("distinct")
+	if n := phuslu.SampledMessages(logger); n != 1 {
+		t.Errorf("expected 1 counter after expiry, got %d", n)
+	}
+}
+
+func TestParseModuleLevels(t *testing.T) {
+	levels, err := phuslu.ParseModuleLevels(" blockchain=debug, engine=warn ")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(levels) != 2 {
+		t.Errorf("expected 2 module levels, got %d", len(levels))
+	}
+
+	for _, invalid := range []string{"blockchain", "=debug", "engine=loud"} {
+		if _, err = phuslu.ParseModuleLevels(invalid); err == nil {
+			t.Errorf("expected error for %q", invalid)
+		}
+	}
+}
+
+func TestConfig_Validate(t *testing.T) {
+	if err := phuslu.DefaultConfig().Validate(); err != nil {
+		t.Fatalf("expected default config to be valid, got %v", err)
+	}
+
+	cfg := phuslu.DefaultConfig()
+	cfg.LogLevel = "loud"
+	cfg.Style = "fancy"
+	cfg.FilePath = "beacond.log"
+	cfg.FileMaxSize = 0
+	err := cfg.Validate()
+	if err == nil {
+		t.Fatal("expected invalid config to be rejected")
+	}
+	for _, key := range []string{"log-level", "style", "file-max-size"} {
+		if !strings.Contains(err.Error(), key) {
+			t.Errorf("expected error to mention %s, got %v", key, err)
+		}
+	}
+}
@@ -0,0 +1,161 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package phuslu
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/phuslu/log"
+)
+
+// sampler drops repeated messages at high frequency. Within each interval the
+// first `initial` occurrences of a message at a given level are logged, after
+// which only every `thereafter`-th occurrence is. Messages at warn level and
+// above are never sampled.
+type sampler struct {
+	// state holds the sampling parameters and counters, and is nil while
+	// sampling is disabled. It is replaced as a whole on reconfiguration,
+	// which resets the counters.
+	state atomic.Pointer[samplerState]
+}
+
+// samplerState is the configuration of an enabled sampler along with the
+// counters of the messages seen since it was configured.
+type samplerState struct {
+	initial    uint64
+	thereafter uint64
+	interval   time.Duration
+	// counters maps a samplerKey to its *samplerCounter.
+	counters sync.Map
+	// lastSweep is the time in Unix nanoseconds at which the expired
+	// counters were last dropped.
+	lastSweep atomic.Int64
+}
+
+// samplerKey identifies a message for sampling purposes.
+type samplerKey struct {
+	level log.Level
+	msg   string
+}
+
+// samplerCounter counts the occurrences of a message in the current window.
+type samplerCounter struct {
+	mu sync.Mutex
+	// windowStart is the start of the current counting window.
+	windowStart time.Time
+	n           uint64
+}
+
+// newSampler creates a new, disabled sampler.
+func newSampler() *sampler {
+	return &sampler{}
+}
+
+// configure updates the sampling parameters and resets the counters. A zero
+// initial value disables sampling.
+func (s *sampler) configure(
+	initial, thereafter uint64,
+	interval time.Duration,
+) {
+	if initial == 0 {
+		s.state.Store(nil)
+		return
+	}
+	st := &samplerState{
+		initial:    initial,
+		thereafter: thereafter,
+		interval:   interval,
+	}
+	st.lastSweep.Store(time.Now().UnixNano())
+	s.state.Store(st)
+}
+
+// allow reports whether a message at the given level should be logged.
+func (s *sampler) allow(level log.Level, msg string) bool {
+	if level >= log.WarnLevel {
+		return true
+	}
+	st := s.state.Load()
+	if st == nil {
+		return true
+	}
+
+	now := time.Now()
+	st.sweep(now)
+	n := st.counter(samplerKey{level: level, msg: msg}).inc(now, st.interval)
+	if n <= st.initial {
+		return true
+	}
+	return st.thereafter > 0 && (n-st.initial)%st.thereafter == 0
+}
+
+// counter returns the counter of the message with the given key.
+func (st *samplerState) counter(key samplerKey) *samplerCounter {
+	if c, ok := st.counters.Load(key); ok {
+		//nolint:errcheck // only *samplerCounter values are stored.
+		return c.(*samplerCounter)
+	}
+	c, _ := st.counters.LoadOrStore(key, &samplerCounter{})
+	//nolint:errcheck // only *samplerCounter values are stored.
+	return c.(*samplerCounter)
+}
+
+// sweep drops the counters whose window expired, at most once per interval,
+// so that the counters of messages that are no longer logged do not
+// accumulate. A dropped counter is recreated with an empty window on the
+// next occurrence of its message, just as an expired window is reset.
+func (st *samplerState) sweep(now time.Time) {
+	last := st.lastSweep.Load()
+	if now.UnixNano()-last < int64(st.interval) ||
+		!st.lastSweep.CompareAndSwap(last, now.UnixNano()) {
+		return
+	}
+	st.counters.Range(func(key, value any) bool {
+		//nolint:errcheck // only *samplerCounter values are stored.
+		if c := value.(*samplerCounter); c.expired(now, st.interval) {
+			st.counters.CompareAndDelete(key, c)
+		}
+		return true
+	})
+}
+
+// expired reports whether the window of the counter is older than interval.
+func (c *samplerCounter) expired(now time.Time, interval time.Duration) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return now.Sub(c.windowStart) >= interval
+}
+
+// inc counts an occurrence of the message at the given time, starting a new
+// window if the current one is older than interval, and returns the number
+// of occurrences in the window.
+func (c *samplerCounter) inc(now time.Time, interval time.Duration) uint64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if now.Sub(c.windowStart) >= interval {
+		c.windowStart = now
+		c.n = 0
+	}
+	c.n++
+	return c.n
+}
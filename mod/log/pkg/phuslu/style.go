@@ -61,4 +61,7 @@ const (
 	// output styles flags.
 	StylePretty = "pretty"
 	StyleJSON   = "json"
+
+	// timeField is the name of the time field in JSON output.
+	timeField = "time"
 )
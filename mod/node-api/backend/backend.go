@@ -44,19 +44,22 @@ type Backend[
 	BeaconStateMarshallableT any,
 	BlobSidecarsT any,
 	BlockStoreT BlockStore[BeaconBlockT],
+	BLSToExecutionChangeT any,
 	ContextT context.Context,
 	DepositT any,
 	DepositStoreT DepositStore[DepositT],
-	Eth1DataT,
-	ExecutionPayloadHeaderT,
+	Eth1DataT any,
+	ExecutionPayloadHeaderT ExecutionPayloadHeader,
 	ForkT any,
 	NodeT Node[ContextT],
+	ProposerSlashingT any,
 	StateStoreT any,
 	StorageBackendT StorageBackend[
 		AvailabilityStoreT, BeaconStateT, BlockStoreT, DepositStoreT,
 	],
 	ValidatorT Validator[WithdrawalCredentialsT],
 	ValidatorsT ~[]ValidatorT,
+	VoluntaryExitT any,
 	WithdrawalT Withdrawal[WithdrawalT],
 	WithdrawalCredentialsT WithdrawalCredentials,
 ] struct {
@@ -64,7 +67,17 @@ type Backend[
 	cs   common.ChainSpec
 	node NodeT
 
-	sp StateProcessor[BeaconStateT]
+	sp   StateProcessor[BeaconStateT]
+	pool OperationPool[
+		BeaconStateT, BLSToExecutionChangeT, ProposerSlashingT, VoluntaryExitT,
+	]
+	producer BlockProducer[BeaconBlockT, BeaconStateT, BlobSidecarsT]
+	ec       ExecutionClient
+
+	// dryRuns holds a token while a block is built by a dry run, so that
+	// the execution client builds at most one payload for the node API at
+	// a time.
+	dryRuns chan struct{}
 }
 
 // New creates and returns a new Backend instance.
@@ -82,70 +95,84 @@ func New[
 	BeaconStateMarshallableT any,
 	BlobSidecarsT any,
 	BlockStoreT BlockStore[BeaconBlockT],
+	BLSToExecutionChangeT any,
 	ContextT context.Context,
 	DepositT any,
 	DepositStoreT DepositStore[DepositT],
-	Eth1DataT,
-	ExecutionPayloadHeaderT,
+	Eth1DataT any,
+	ExecutionPayloadHeaderT ExecutionPayloadHeader,
 	ForkT any,
 	NodeT Node[ContextT],
+	ProposerSlashingT any,
 	StateStoreT any,
 	StorageBackendT StorageBackend[
 		AvailabilityStoreT, BeaconStateT, BlockStoreT, DepositStoreT,
 	],
 	ValidatorT Validator[WithdrawalCredentialsT],
 	ValidatorsT ~[]ValidatorT,
+	VoluntaryExitT any,
 	WithdrawalT Withdrawal[WithdrawalT],
 	WithdrawalCredentialsT WithdrawalCredentials,
 ](
 	storageBackend StorageBackendT,
 	cs common.ChainSpec,
 	sp StateProcessor[BeaconStateT],
+	pool OperationPool[
+		BeaconStateT, BLSToExecutionChangeT, ProposerSlashingT, VoluntaryExitT,
+	],
+	producer BlockProducer[BeaconBlockT, BeaconStateT, BlobSidecarsT],
+	ec ExecutionClient,
 ) *Backend[
 	AvailabilityStoreT, BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
 	BeaconStateT, BeaconStateMarshallableT, BlobSidecarsT, BlockStoreT,
-	ContextT, DepositT, DepositStoreT, Eth1DataT, ExecutionPayloadHeaderT, ForkT,
-	NodeT, StateStoreT, StorageBackendT, ValidatorT, ValidatorsT, WithdrawalT,
+	BLSToExecutionChangeT, ContextT, DepositT, DepositStoreT, Eth1DataT,
+	ExecutionPayloadHeaderT, ForkT, NodeT, ProposerSlashingT, StateStoreT,
+	StorageBackendT, ValidatorT, ValidatorsT, VoluntaryExitT, WithdrawalT,
 	WithdrawalCredentialsT,
 ] {
 	return &Backend[
 		AvailabilityStoreT, BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
 		BeaconStateT, BeaconStateMarshallableT, BlobSidecarsT, BlockStoreT,
-		ContextT, DepositT, DepositStoreT, Eth1DataT, ExecutionPayloadHeaderT, ForkT,
-		NodeT, StateStoreT, StorageBackendT, ValidatorT, ValidatorsT, WithdrawalT,
+		BLSToExecutionChangeT, ContextT, DepositT, DepositStoreT, Eth1DataT,
+		ExecutionPayloadHeaderT, ForkT, NodeT, ProposerSlashingT, StateStoreT,
+		StorageBackendT, ValidatorT, ValidatorsT, VoluntaryExitT, WithdrawalT,
 		WithdrawalCredentialsT,
 	]{
-		sb: storageBackend,
-		cs: cs,
-		sp: sp,
+		sb:       storageBackend,
+		cs:       cs,
+		sp:       sp,
+		pool:     pool,
+		producer: producer,
+		ec:       ec,
+		dryRuns:  make(chan struct{}, 1),
 	}
 }
 
 // AttachQueryBackend sets the node on the backend for
 // querying historical heights.
 func (b *Backend[
-	_, _, _, _, _, _, _, _, _, _, _, _, _, _, NodeT, _, _, _, _, _, _,
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, NodeT, _, _, _, _, _, _, _, _,
 ]) AttachQueryBackend(node NodeT) {
 	b.node = node
 }
 
 // ChainSpec returns the chain spec from the backend.
 func (b *Backend[
-	_, _, _, _, _, _, _, _, _, _, _, _, _, _, NodeT, _, _, _, _, _, _,
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, NodeT, _, _, _, _, _, _, _, _,
 ]) ChainSpec() common.ChainSpec {
 	return b.cs
 }
 
 // GetSlotByBlockRoot retrieves the slot by a block root from the block store.
 func (b *Backend[
-	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
 ]) GetSlotByBlockRoot(root common.Root) (math.Slot, error) {
 	return b.sb.BlockStore().GetSlotByBlockRoot(root)
 }
 
 // GetSlotByStateRoot retrieves the slot by a state root from the block store.
 func (b *Backend[
-	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
 ]) GetSlotByStateRoot(root common.Root) (math.Slot, error) {
 	return b.sb.BlockStore().GetSlotByStateRoot(root)
 }
@@ -153,7 +180,7 @@ func (b *Backend[
 // GetParentSlotByTimestamp retrieves the parent slot by a given timestamp from
 // the block store.
 func (b *Backend[
-	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
 ]) GetParentSlotByTimestamp(timestamp math.U64) (math.Slot, error) {
 	return b.sb.BlockStore().GetParentSlotByTimestamp(timestamp)
 }
@@ -161,7 +188,8 @@ func (b *Backend[
 // stateFromSlot returns the state at the given slot, after also processing the
 // next slot to ensure the returned beacon state is up to date.
 func (b *Backend[
-	_, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+	_,
 ]) stateFromSlot(slot math.Slot) (BeaconStateT, math.Slot, error) {
 	var (
 		st  BeaconStateT
@@ -186,7 +214,8 @@ func (b *Backend[
 // resolving an input slot of 0 to the latest slot. It does not process the
 // next slot on the beacon state.
 func (b *Backend[
-	_, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+	_,
 ]) stateFromSlotRaw(slot math.Slot) (BeaconStateT, math.Slot, error) {
 	var st BeaconStateT
 	//#nosec:G701 // not an issue in practice.
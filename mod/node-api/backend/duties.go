@@ -0,0 +1,133 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend
+
+import (
+	"errors"
+	"fmt"
+
+	apitypes "github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+	validatortypes "github.com/berachain/beacon-kit/mod/node-api/handlers/validator/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core"
+)
+
+// ProposerDutiesAtEpoch returns the proposer of every slot of the given
+// epoch, along with the root of the head block the duties depend on. Slots
+// up to the head report the proposer of their block. Later slots report the
+// proposer the proposer schedule of the head state expects CometBFT to
+// select, assuming every block is committed in its first round and no
+// further validator updates. Epochs past the next one cannot be predicted.
+func (b Backend[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) ProposerDutiesAtEpoch(
+	epoch math.Epoch,
+) (common.Root, []*validatortypes.ProposerDutyData, error) {
+	st, head, err := b.stateFromSlotRaw(0)
+	if err != nil {
+		return common.Root{}, nil, err
+	}
+	if epoch > b.cs.SlotToEpoch(head)+1 {
+		return common.Root{}, nil, errors.Join(
+			apitypes.ErrInvalidRequest,
+			fmt.Errorf("epoch %d is past the next epoch", epoch),
+		)
+	}
+	root, err := b.BlockRootAtSlot(head)
+	if err != nil {
+		return common.Root{}, nil, err
+	}
+
+	slotsPerEpoch := b.cs.SlotsPerEpoch()
+	start := epoch.Unwrap() * slotsPerEpoch
+	end := start + slotsPerEpoch
+	duties := make([]*validatortypes.ProposerDutyData, 0, slotsPerEpoch)
+
+	// The genesis slot has no block, hence no proposer.
+	for slot := max(start, 1); slot < end && slot <= head.Unwrap(); slot++ {
+		//#nosec:G701 // not an issue in practice.
+		past, _, pastErr := b.stateFromSlotRaw(math.Slot(slot))
+		if pastErr != nil {
+			return common.Root{}, nil, pastErr
+		}
+		header, pastErr := past.GetLatestBlockHeader()
+		if pastErr != nil {
+			return common.Root{}, nil, pastErr
+		}
+		duty, pastErr := proposerDuty(
+			core.PubkeyOf(past.ValidatorByIndex), header.GetProposerIndex(), slot,
+		)
+		if pastErr != nil {
+			return common.Root{}, nil, pastErr
+		}
+		duties = append(duties, duty)
+	}
+	if end <= head.Unwrap()+1 {
+		return root, duties, nil
+	}
+
+	current, err := st.GetProposerSet()
+	if err != nil {
+		return common.Root{}, nil, err
+	}
+	next, err := st.GetNextProposerSet()
+	if err != nil {
+		return common.Root{}, nil, err
+	}
+	pubkeyOf := core.PubkeyOf(st.ValidatorByIndex)
+	proposers, err := core.ExpectedProposers(
+		current, next, end-head.Unwrap()-1, pubkeyOf,
+	)
+	if err != nil {
+		return common.Root{}, nil, err
+	}
+	for i, proposer := range proposers {
+		slot := head.Unwrap() + 1 + uint64(i)
+		if slot < start {
+			continue
+		}
+		duty, dutyErr := proposerDuty(pubkeyOf, proposer, slot)
+		if dutyErr != nil {
+			return common.Root{}, nil, dutyErr
+		}
+		duties = append(duties, duty)
+	}
+	return root, duties, nil
+}
+
+// proposerDuty builds the duty of the given proposer at the given slot.
+func proposerDuty(
+	pubkeyOf func(math.ValidatorIndex) (crypto.BLSPubkey, error),
+	proposer math.ValidatorIndex,
+	slot uint64,
+) (*validatortypes.ProposerDutyData, error) {
+	pubkey, err := pubkeyOf(proposer)
+	if err != nil {
+		return nil, err
+	}
+	return &validatortypes.ProposerDutyData{
+		Pubkey:         pubkey,
+		ValidatorIndex: proposer.Unwrap(),
+		Slot:           slot,
+	}, nil
+}
@@ -2,7 +2,10 @@
 
 package mocks
 
-import mock "github.com/stretchr/testify/mock"
+import (
+	p2p "github.com/berachain/beacon-kit/mod/primitives/pkg/net/p2p"
+	mock "github.com/stretchr/testify/mock"
+)
 
 // Node is an autogenerated mock type for the Node type
 type Node[ContextT any] struct {
@@ -76,6 +79,63 @@ func (_c *Node_CreateQueryContext_Call[ContextT]) RunAndReturn(run func(int64, b
 	return _c
 }
 
+// NetworkInfo provides a mock function with given fields:
+func (_m *Node[ContextT]) NetworkInfo() (*p2p.NetworkInfo, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for NetworkInfo")
+	}
+
+	var r0 *p2p.NetworkInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (*p2p.NetworkInfo, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() *p2p.NetworkInfo); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*p2p.NetworkInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Node_NetworkInfo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NetworkInfo'
+type Node_NetworkInfo_Call[ContextT any] struct {
+	*mock.Call
+}
+
+// NetworkInfo is a helper method to define mock.On call
+func (_e *Node_Expecter[ContextT]) NetworkInfo() *Node_NetworkInfo_Call[ContextT] {
+	return &Node_NetworkInfo_Call[ContextT]{Call: _e.mock.On("NetworkInfo")}
+}
+
+func (_c *Node_NetworkInfo_Call[ContextT]) Run(run func()) *Node_NetworkInfo_Call[ContextT] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Node_NetworkInfo_Call[ContextT]) Return(_a0 *p2p.NetworkInfo, _a1 error) *Node_NetworkInfo_Call[ContextT] {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Node_NetworkInfo_Call[ContextT]) RunAndReturn(run func() (*p2p.NetworkInfo, error)) *Node_NetworkInfo_Call[ContextT] {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewNode creates a new instance of Node. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewNode[ContextT any](t interface {
@@ -0,0 +1,194 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	nodetypes "github.com/berachain/beacon-kit/mod/node-api/handlers/node/types"
+	apitypes "github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/p2p"
+)
+
+// NodeIdentity returns the identity of the node on the CometBFT p2p network.
+func (b Backend[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) NodeIdentity() (*nodetypes.IdentityData, error) {
+	info, err := b.node.NetworkInfo()
+	if err != nil {
+		return nil, err
+	}
+	addresses := make([]string, 0, 1)
+	if info.ListenAddress != "" {
+		addresses = append(
+			addresses, peerAddress(info.NodeID, info.ListenAddress),
+		)
+	}
+	discoveryAddresses := make([]string, 0, len(addresses))
+	if info.Discovery {
+		discoveryAddresses = append(discoveryAddresses, addresses...)
+	}
+	return &nodetypes.IdentityData{
+		PeerID:             info.NodeID,
+		P2PAddresses:       addresses,
+		DiscoveryAddresses: discoveryAddresses,
+		Metadata: &nodetypes.MetadataData{
+			Attnets:  "0x0000000000000000",
+			Syncnets: "0x00",
+		},
+	}, nil
+}
+
+// Peers returns the peers of the node in any of the given states and
+// directions, or all of them if none are given. The node only knows of the
+// peers it is connected to.
+func (b Backend[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) Peers(states, directions []string) ([]*nodetypes.PeerData, error) {
+	info, err := b.node.NetworkInfo()
+	if err != nil {
+		return nil, err
+	}
+	peers := make([]*nodetypes.PeerData, 0, len(info.Peers))
+	if len(states) > 0 &&
+		!slices.Contains(states, nodetypes.PeerStateConnected) {
+		return peers, nil
+	}
+	for _, peer := range info.Peers {
+		data := peerData(peer)
+		if len(directions) > 0 &&
+			!slices.Contains(directions, data.Direction) {
+			continue
+		}
+		peers = append(peers, data)
+	}
+	return peers, nil
+}
+
+// PeerByID returns the peer of the node with the given ID.
+func (b Backend[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) PeerByID(id string) (*nodetypes.PeerData, error) {
+	info, err := b.node.NetworkInfo()
+	if err != nil {
+		return nil, err
+	}
+	for _, peer := range info.Peers {
+		if peer.ID == id {
+			return peerData(peer), nil
+		}
+	}
+	return nil, apitypes.ErrNotFound
+}
+
+// PeerCount returns the number of peers of the node in each state. Peers
+// being dialed are connecting, and disconnected peers are not tracked.
+func (b Backend[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) PeerCount() (*nodetypes.PeerCountData, error) {
+	info, err := b.node.NetworkInfo()
+	if err != nil {
+		return nil, err
+	}
+	//#nosec:G701 // counts are never negative.
+	return &nodetypes.PeerCountData{
+		Connecting: uint64(info.Dialing),
+		Connected:  uint64(len(info.Peers)),
+	}, nil
+}
+
+// elSyncingTimeout is how long the execution client is waited on for its
+// sync status.
+const elSyncingTimeout = 2 * time.Second
+
+// Syncing returns the sync status of the node. The node is syncing while
+// CometBFT is catching up with its peers, or while the execution client is
+// syncing or behind the latest finalized execution payload. Since the slot
+// of a block is its CometBFT height, the sync distance is how far the head
+// slot is behind the highest block committed by a peer. The execution
+// client is considered offline if it does not answer in time.
+func (b Backend[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) Syncing(ctx context.Context) (*nodetypes.SyncingData, error) {
+	info, err := b.node.NetworkInfo()
+	if err != nil {
+		return nil, err
+	}
+	st, head, err := b.stateFromSlotRaw(0)
+	if err != nil {
+		return nil, err
+	}
+	header, err := st.GetLatestExecutionPayloadHeader()
+	if err != nil {
+		return nil, err
+	}
+
+	data := &nodetypes.SyncingData{HeadSlot: head.Unwrap()}
+	for _, peer := range info.Peers {
+		// The height of a peer is the one it is voting on, which is one
+		// above its last committed block.
+		if peer.Height <= 0 {
+			continue
+		}
+		//#nosec:G701 // checked to be positive.
+		if height := uint64(peer.Height - 1); height > data.HeadSlot {
+			data.SyncDistance = max(data.SyncDistance, height-data.HeadSlot)
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, elSyncingTimeout)
+	defer cancel()
+	elSyncing, err := b.ec.Syncing(ctx)
+	if err != nil {
+		data.ELOffline = true
+	} else if !elSyncing {
+		number, numErr := b.ec.BlockNumber(ctx)
+		elSyncing = numErr != nil || number < header.GetNumber()
+	}
+	data.IsOptimistic = data.ELOffline || elSyncing
+	data.IsSyncing = info.CatchingUp || elSyncing
+	return data, nil
+}
+
+// peerData maps a peer of the node to the beacon API peer schema.
+func peerData(peer *p2p.Peer) *nodetypes.PeerData {
+	direction := nodetypes.PeerDirectionInbound
+	if peer.Outbound {
+		direction = nodetypes.PeerDirectionOutbound
+	}
+	return &nodetypes.PeerData{
+		PeerID:             peer.ID,
+		LastSeenP2PAddress: peerAddress(peer.ID, peer.Address),
+		State:              nodetypes.PeerStateConnected,
+		Direction:          direction,
+	}
+}
+
+// peerAddress returns the CometBFT address of the peer with the given ID at
+// the given address.
+func peerAddress(id, address string) string {
+	if address == "" {
+		return ""
+	}
+	return id + "@" + address
+}
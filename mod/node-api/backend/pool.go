@@ -0,0 +1,92 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend
+
+// SubmitProposerSlashing validates the proposer slashing against the head
+// state and adds it to the operation pool.
+func (b *Backend[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, ProposerSlashingT, _, _,
+	_, _, _, _, _,
+]) SubmitProposerSlashing(slashing ProposerSlashingT) error {
+	st, _, err := b.stateFromSlotRaw(0)
+	if err != nil {
+		return err
+	}
+	return b.pool.AddProposerSlashing(st, slashing)
+}
+
+// SubmitVoluntaryExit validates the voluntary exit against the head state and
+// adds it to the operation pool.
+func (b *Backend[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+	VoluntaryExitT, _, _,
+]) SubmitVoluntaryExit(exit VoluntaryExitT) error {
+	st, _, err := b.stateFromSlotRaw(0)
+	if err != nil {
+		return err
+	}
+	return b.pool.AddVoluntaryExit(st, exit)
+}
+
+// SubmitBLSToExecutionChanges validates each BLS to execution change against
+// the head state and adds the valid ones to the operation pool. It returns
+// one error per change, which is nil for the changes that were added.
+func (b *Backend[
+	_, _, _, _, _, _, _, _, BLSToExecutionChangeT, _, _, _, _, _, _, _, _, _,
+	_, _, _, _, _, _,
+]) SubmitBLSToExecutionChanges(
+	changes []BLSToExecutionChangeT,
+) ([]error, error) {
+	st, _, err := b.stateFromSlotRaw(0)
+	if err != nil {
+		return nil, err
+	}
+	errs := make([]error, len(changes))
+	for i, change := range changes {
+		errs[i] = b.pool.AddBLSToExecutionChange(st, change)
+	}
+	return errs, nil
+}
+
+// PoolProposerSlashings returns the proposer slashings in the operation pool.
+func (b *Backend[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, ProposerSlashingT, _, _,
+	_, _, _, _, _,
+]) PoolProposerSlashings() []ProposerSlashingT {
+	return b.pool.ProposerSlashings()
+}
+
+// PoolVoluntaryExits returns the voluntary exits in the operation pool.
+func (b *Backend[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+	VoluntaryExitT, _, _,
+]) PoolVoluntaryExits() []VoluntaryExitT {
+	return b.pool.VoluntaryExits()
+}
+
+// PoolBLSToExecutionChanges returns the BLS to execution changes in the
+// operation pool.
+func (b *Backend[
+	_, _, _, _, _, _, _, _, BLSToExecutionChangeT, _, _, _, _, _, _, _, _, _,
+	_, _, _, _, _, _,
+]) PoolBLSToExecutionChanges() []BLSToExecutionChangeT {
+	return b.pool.BLSToExecutionChanges()
+}
@@ -0,0 +1,94 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apitypes "github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+	validatortypes "github.com/berachain/beacon-kit/mod/node-api/handlers/validator/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// ProduceBlock builds the block and sidecars the node would propose at the
+// given slot on top of the latest state, which may be at most an epoch
+// behind the slot, and returns them along with the value of the payload in
+// Wei. The randao reveal is signed by the node unless one is given. The
+// block is built on the state of the query context, which is discarded
+// afterwards, and is neither published nor cached. Since the execution
+// client builds the payload synchronously, only one block is built at a
+// time and concurrent requests are rejected.
+func (b Backend[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) ProduceBlock(
+	ctx context.Context,
+	slot math.Slot,
+	reveal *crypto.BLSSignature,
+) (string, *validatortypes.BlockProposalData, error) {
+	select {
+	case b.dryRuns <- struct{}{}:
+		defer func() { <-b.dryRuns }()
+	default:
+		return "", nil, errors.Join(
+			apitypes.ErrTooManyRequests,
+			errors.New("a block is already being built"),
+		)
+	}
+
+	st, head, err := b.stateFromSlotRaw(0)
+	if err != nil {
+		return "", nil, err
+	}
+	//#nosec:G701 // not an issue in practice.
+	if slot <= head || slot > head+math.Slot(b.cs.SlotsPerEpoch()) {
+		return "", nil, errors.Join(
+			apitypes.ErrInvalidRequest,
+			fmt.Errorf(
+				"slot %d is not within an epoch after slot %d", slot, head,
+			),
+		)
+	}
+
+	proposal, err := b.producer.DryRunBlockAndSidecars(ctx, st, slot, reveal)
+	if err != nil {
+		return "", nil, err
+	}
+	var payloadValue string
+	if proposal.PayloadValue != nil {
+		payloadValue = proposal.PayloadValue.Dec()
+	}
+	timings := proposal.Timings
+	return payloadValue, &validatortypes.BlockProposalData{
+		Block:        proposal.Block,
+		BlobSidecars: proposal.Sidecars,
+		Timings: &validatortypes.ProposalTimingsData{
+			ProcessSlots: timings.ProcessSlots.String(),
+			Payload:      timings.Payload.String(),
+			Body:         timings.Body.String(),
+			Sidecars:     timings.Sidecars.String(),
+			StateRoot:    timings.StateRoot.String(),
+			Total:        timings.Total.String(),
+		},
+	}, nil
+}
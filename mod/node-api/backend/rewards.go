@@ -0,0 +1,151 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package backend
+
+import (
+	"sort"
+
+	"github.com/berachain/beacon-kit/mod/node-api/backend/utils"
+	types "github.com/berachain/beacon-kit/mod/node-api/handlers/beacon/types"
+	apitypes "github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+)
+
+// BlockRewardsAtSlot returns the reward the proposer of the block at the
+// given slot earns for it. The reward is paid out at the end of the epoch,
+// for including the commit of the previous block, and is reported as the
+// attestations component.
+func (b Backend[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) BlockRewardsAtSlot(slot math.Slot) (*types.BlockRewardsData, error) {
+	// The raw state is used so that the epoch is not processed, which would
+	// pay out and reset the rewards at the end of an epoch.
+	st, _, err := b.stateFromSlotRaw(slot)
+	if err != nil {
+		return nil, err
+	}
+
+	header, err := st.GetLatestBlockHeader()
+	if err != nil {
+		return nil, err
+	}
+
+	var reward math.Gwei
+	if quotient := b.cs.ProposerRewardQuotient(); quotient > 0 {
+		var baseReward math.Gwei
+		baseReward, err = st.BaseReward(header.GetProposerIndex())
+		if err != nil {
+			return nil, err
+		}
+		reward = baseReward / math.Gwei(quotient)
+	}
+
+	return &types.BlockRewardsData{
+		ProposerIndex: header.GetProposerIndex().Unwrap(),
+		Total:         reward.Unwrap(),
+		Attestations:  reward.Unwrap(),
+	}, nil
+}
+
+// AttestationRewardsAtEpoch returns the rewards of the given epoch for the
+// validators with the given IDs, or for all validators if no IDs are given.
+// The rewards are read from the state at the last slot of the epoch, before
+// they are paid out.
+func (b Backend[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) AttestationRewardsAtEpoch(
+	epoch math.Epoch, ids []string,
+) (*types.AttestationRewardsData, error) {
+	slot := math.Slot((epoch.Unwrap()+1)*b.cs.SlotsPerEpoch() - 1)
+	st, _, err := b.stateFromSlotRaw(slot)
+	if err != nil {
+		return nil, err
+	}
+
+	rewards, err := st.EpochRewards()
+	if err != nil {
+		return nil, err
+	}
+
+	// The ideal rewards are those of a validator that signed every commit,
+	// for each distinct effective balance.
+	ideal := make(map[math.Gwei]*types.IdealAttestationReward)
+	for _, r := range rewards {
+		if _, ok := ideal[r.EffectiveBalance]; ok {
+			continue
+		}
+		var baseReward math.Gwei
+		if baseReward, err = st.BaseReward(r.ValidatorIndex); err != nil {
+			return nil, err
+		}
+		ideal[r.EffectiveBalance] = &types.IdealAttestationReward{
+			EffectiveBalance: r.EffectiveBalance.Unwrap(),
+			//#nosec:G701 // rewards are far below the int64 limit.
+			Head: int64(baseReward),
+		}
+	}
+
+	data := &types.AttestationRewardsData{
+		IdealRewards: make([]*types.IdealAttestationReward, 0, len(ideal)),
+		TotalRewards: make([]*types.AttestationReward, 0, len(rewards)),
+	}
+	for _, r := range ideal {
+		data.IdealRewards = append(data.IdealRewards, r)
+	}
+	sort.Slice(data.IdealRewards, func(i, j int) bool {
+		return data.IdealRewards[i].EffectiveBalance <
+			data.IdealRewards[j].EffectiveBalance
+	})
+
+	if len(ids) == 0 {
+		for _, r := range rewards {
+			data.TotalRewards = append(data.TotalRewards, attestationReward(r))
+		}
+		return data, nil
+	}
+
+	var index math.ValidatorIndex
+	for _, id := range ids {
+		if index, err = utils.ValidatorIndexByID(st, id); err != nil {
+			return nil, err
+		}
+		if index.Unwrap() >= uint64(len(rewards)) {
+			return nil, apitypes.ErrNotFound
+		}
+		data.TotalRewards = append(
+			data.TotalRewards, attestationReward(rewards[index]),
+		)
+	}
+	return data, nil
+}
+
+// attestationReward converts the rewards of a validator into the beacon API
+// representation.
+func attestationReward(r *transition.Rewards) *types.AttestationReward {
+	return &types.AttestationReward{
+		ValidatorIndex: r.ValidatorIndex.Unwrap(),
+		//#nosec:G701 // rewards are far below the int64 limit.
+		Head:           int64(r.Participation) - int64(r.MissedParticipation),
+		InclusionDelay: r.Proposer.Unwrap(),
+		//#nosec:G701 // penalties are far below the int64 limit.
+		Inactivity: -int64(r.Inactivity),
+	}
+}
@@ -25,7 +25,9 @@ import (
 
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/p2p"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
 	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core"
 )
@@ -69,6 +71,13 @@ type BeaconState[
 ] interface {
 	// SetSlot sets the slot on the beacon state.
 	SetSlot(math.Slot) error
+	// DecreaseBalance decreases the balance of a validator.
+	DecreaseBalance(math.ValidatorIndex, math.Gwei) error
+	// SetNextWithdrawalIndex sets the index of the next withdrawal.
+	SetNextWithdrawalIndex(uint64) error
+	// SetNextWithdrawalValidatorIndex sets the index of the validator the
+	// next withdrawal sweep starts at.
+	SetNextWithdrawalValidatorIndex(math.ValidatorIndex) error
 
 	core.ReadOnlyBeaconState[
 		BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
@@ -76,6 +85,20 @@ type BeaconState[
 	]
 }
 
+// BlockProducer builds blocks without proposing them.
+type BlockProducer[BeaconBlockT, BeaconStateT, BlobSidecarsT any] interface {
+	// DryRunBlockAndSidecars builds the block and sidecars the node would
+	// propose at the given slot on top of the given state, without
+	// publishing them. The randao reveal is built by the node unless one is
+	// given.
+	DryRunBlockAndSidecars(
+		ctx context.Context,
+		st BeaconStateT,
+		slot math.Slot,
+		reveal *crypto.BLSSignature,
+	) (*transition.Proposal[BeaconBlockT, BlobSidecarsT], error)
+}
+
 // BlockStore is the interface for block storage.
 type BlockStore[BeaconBlockT any] interface {
 	// GetSlotByBlockRoot retrieves the slot by a given block root.
@@ -96,11 +119,49 @@ type DepositStore[DepositT any] interface {
 	EnqueueDeposits(deposits []DepositT) error
 }
 
+// ExecutionClient is the interface for the client of the execution layer.
+type ExecutionClient interface {
+	// Syncing reports whether the execution client is syncing.
+	Syncing(ctx context.Context) (bool, error)
+	// BlockNumber returns the number of the latest execution block.
+	BlockNumber(ctx context.Context) (math.U64, error)
+}
+
+// ExecutionPayloadHeader is the interface for an execution payload header.
+type ExecutionPayloadHeader interface {
+	// GetNumber returns the block number of the execution payload.
+	GetNumber() math.U64
+}
+
 // Node is the interface for a node.
 type Node[ContextT any] interface {
 	// CreateQueryContext creates a query context for a given height and proof
 	// flag.
 	CreateQueryContext(height int64, prove bool) (ContextT, error)
+	// NetworkInfo returns the state of the node on the p2p network.
+	NetworkInfo() (*p2p.NetworkInfo, error)
+}
+
+// OperationPool is the interface for the pool of operations awaiting
+// inclusion in a block.
+type OperationPool[
+	BeaconStateT, BLSToExecutionChangeT, ProposerSlashingT, VoluntaryExitT any,
+] interface {
+	// AddProposerSlashing validates the proposer slashing against the given
+	// state and adds it to the pool.
+	AddProposerSlashing(BeaconStateT, ProposerSlashingT) error
+	// AddVoluntaryExit validates the voluntary exit against the given state
+	// and adds it to the pool.
+	AddVoluntaryExit(BeaconStateT, VoluntaryExitT) error
+	// AddBLSToExecutionChange validates the BLS to execution change against
+	// the given state and adds it to the pool.
+	AddBLSToExecutionChange(BeaconStateT, BLSToExecutionChangeT) error
+	// ProposerSlashings returns the pooled proposer slashings.
+	ProposerSlashings() []ProposerSlashingT
+	// VoluntaryExits returns the pooled voluntary exits.
+	VoluntaryExits() []VoluntaryExitT
+	// BLSToExecutionChanges returns the pooled BLS to execution changes.
+	BLSToExecutionChanges() []BLSToExecutionChangeT
 }
 
 type StateProcessor[BeaconStateT any] interface {
@@ -121,6 +182,8 @@ type StorageBackend[
 // credentials. WithdrawalCredentialsT is a type parameter that must implement
 // the WithdrawalCredentials interface.
 type Validator[WithdrawalCredentialsT WithdrawalCredentials] interface {
+	// GetPubkey returns the public key of the validator.
+	GetPubkey() crypto.BLSPubkey
 	// GetWithdrawalCredentials returns the withdrawal credentials of the
 	// validator.
 	GetWithdrawalCredentials() WithdrawalCredentialsT
@@ -130,6 +193,17 @@ type Validator[WithdrawalCredentialsT WithdrawalCredentials] interface {
 	// IsPartiallyWithdrawable checks if the validator is partially withdrawable
 	// given two Gwei amounts.
 	IsPartiallyWithdrawable(amount1 math.Gwei, amount2 math.Gwei) bool
+	// IsSlashed returns whether the validator has been slashed.
+	IsSlashed() bool
+	// GetActivationEligibilityEpoch returns the epoch in which the validator
+	// became eligible for activation.
+	GetActivationEligibilityEpoch() math.Epoch
+	// GetActivationEpoch returns the epoch in which the validator activates.
+	GetActivationEpoch() math.Epoch
+	// GetExitEpoch returns the epoch in which the validator exits.
+	GetExitEpoch() math.Epoch
+	// GetWithdrawableEpoch returns the epoch when the validator can withdraw.
+	GetWithdrawableEpoch() math.Epoch
 }
 
 // Withdrawal represents an interface for a withdrawal.
@@ -140,6 +214,14 @@ type Withdrawal[T any] interface {
 		address common.ExecutionAddress,
 		amount math.Gwei,
 	) T
+	// GetAmount returns the amount of the withdrawal.
+	GetAmount() math.Gwei
+	// GetIndex returns the index of the withdrawal.
+	GetIndex() math.U64
+	// GetValidatorIndex returns the index of the validator.
+	GetValidatorIndex() math.ValidatorIndex
+	// GetAddress returns the address of the withdrawal.
+	GetAddress() common.ExecutionAddress
 }
 
 // WithdrawalCredentials represents an interface for withdrawal credentials.
@@ -0,0 +1,67 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package utils
+
+import (
+	beacontypes "github.com/berachain/beacon-kit/mod/node-api/handlers/beacon/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// ValidatorStatus returns the status of the validator in the given epoch, as
+// defined in the Beacon Node API specification.
+// https://hackmd.io/ofFJ5gOmQpu1jjHilHbdQQ
+func ValidatorStatus[
+	ValidatorT interface {
+		IsSlashed() bool
+		GetActivationEligibilityEpoch() math.Epoch
+		GetActivationEpoch() math.Epoch
+		GetExitEpoch() math.Epoch
+		GetWithdrawableEpoch() math.Epoch
+	},
+](validator ValidatorT, balance math.Gwei, epoch math.Epoch) string {
+	farFuture := math.Epoch(constants.FarFutureEpoch)
+	switch {
+	case epoch < validator.GetActivationEpoch():
+		if validator.GetActivationEligibilityEpoch() == farFuture {
+			return beacontypes.ValidatorStatusPendingInitialized
+		}
+		return beacontypes.ValidatorStatusPendingQueued
+	case epoch < validator.GetExitEpoch():
+		switch {
+		case validator.GetExitEpoch() == farFuture:
+			return beacontypes.ValidatorStatusActiveOngoing
+		case validator.IsSlashed():
+			return beacontypes.ValidatorStatusActiveSlashed
+		default:
+			return beacontypes.ValidatorStatusActiveExiting
+		}
+	case epoch < validator.GetWithdrawableEpoch():
+		if validator.IsSlashed() {
+			return beacontypes.ValidatorStatusExitedSlashed
+		}
+		return beacontypes.ValidatorStatusExitedUnslashed
+	case balance > 0:
+		return beacontypes.ValidatorStatusWithdrawalPossible
+	default:
+		return beacontypes.ValidatorStatusWithdrawalDone
+	}
+}
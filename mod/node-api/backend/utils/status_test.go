@@ -0,0 +1,104 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package utils_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/node-api/backend/utils"
+	beacontypes "github.com/berachain/beacon-kit/mod/node-api/handlers/beacon/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidatorStatus(t *testing.T) {
+	farFuture := math.Epoch(constants.FarFutureEpoch)
+	validator := func(
+		eligibility, activation, exit, withdrawable math.Epoch, slashed bool,
+	) *types.Validator {
+		return &types.Validator{
+			ActivationEligibilityEpoch: eligibility,
+			ActivationEpoch:            activation,
+			ExitEpoch:                  exit,
+			WithdrawableEpoch:          withdrawable,
+			Slashed:                    slashed,
+		}
+	}
+	tests := []struct {
+		name      string
+		validator *types.Validator
+		balance   math.Gwei
+		want      string
+	}{
+		{
+			name: "pending initialized",
+			validator: validator(
+				farFuture, farFuture, farFuture, farFuture, false,
+			),
+			want: beacontypes.ValidatorStatusPendingInitialized,
+		},
+		{
+			name:      "pending queued",
+			validator: validator(4, farFuture, farFuture, farFuture, false),
+			want:      beacontypes.ValidatorStatusPendingQueued,
+		},
+		{
+			name:      "active ongoing",
+			validator: validator(1, 2, farFuture, farFuture, false),
+			want:      beacontypes.ValidatorStatusActiveOngoing,
+		},
+		{
+			name:      "active slashed",
+			validator: validator(1, 2, 10, 20, true),
+			want:      beacontypes.ValidatorStatusActiveSlashed,
+		},
+		{
+			name:      "active exiting",
+			validator: validator(1, 2, 10, 20, false),
+			want:      beacontypes.ValidatorStatusActiveExiting,
+		},
+		{
+			name:      "exited unslashed",
+			validator: validator(1, 2, 3, 20, false),
+			want:      beacontypes.ValidatorStatusExitedUnslashed,
+		},
+		{
+			name:      "withdrawal possible",
+			validator: validator(1, 2, 3, 4, false),
+			balance:   1,
+			want:      beacontypes.ValidatorStatusWithdrawalPossible,
+		},
+		{
+			name:      "withdrawal done",
+			validator: validator(1, 2, 3, 4, false),
+			want:      beacontypes.ValidatorStatusWithdrawalDone,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(
+				t, tt.want, utils.ValidatorStatus(tt.validator, tt.balance, 5),
+			)
+		})
+	}
+}
@@ -21,20 +21,23 @@
 package backend
 
 import (
+	"slices"
+
 	"github.com/berachain/beacon-kit/mod/node-api/backend/utils"
 	beacontypes "github.com/berachain/beacon-kit/mod/node-api/handlers/beacon/types"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
 func (b Backend[
-	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, ValidatorT, _, _, _,
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, ValidatorT, _, _, _,
+	_,
 ]) ValidatorByID(
 	slot math.Slot, id string,
 ) (*beacontypes.ValidatorData[ValidatorT], error) {
 	// TODO: to adhere to the spec, this shouldn't error if the error
 	// is not found, but i can't think of a way to do that without coupling
 	// db impl to the api impl.
-	st, _, err := b.stateFromSlot(slot)
+	st, slot, err := b.stateFromSlot(slot)
 	if err != nil {
 		return nil, err
 	}
@@ -42,6 +45,68 @@ func (b Backend[
 	if err != nil {
 		return nil, err
 	}
+	return b.validatorData(st, index, b.cs.SlotToEpoch(slot))
+}
+
+// ValidatorsByIDs returns the validators with the given IDs, or all
+// validators if no IDs are given, filtered by the given statuses.
+func (b Backend[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, ValidatorT, _, _, _,
+	_,
+]) ValidatorsByIDs(
+	slot math.Slot, ids []string, statuses []string,
+) ([]*beacontypes.ValidatorData[ValidatorT], error) {
+	st, slot, err := b.stateFromSlot(slot)
+	if err != nil {
+		return nil, err
+	}
+
+	indices := make([]math.ValidatorIndex, 0, len(ids))
+	for _, id := range ids {
+		var index math.ValidatorIndex
+		if index, err = utils.ValidatorIndexByID(st, id); err != nil {
+			return nil, err
+		}
+		indices = append(indices, index)
+	}
+	if len(ids) == 0 {
+		var total uint64
+		if total, err = st.GetTotalValidators(); err != nil {
+			return nil, err
+		}
+		for i := range total {
+			indices = append(indices, math.ValidatorIndex(i))
+		}
+	}
+
+	var (
+		epoch          = b.cs.SlotToEpoch(slot)
+		validatorsData = make([]*beacontypes.ValidatorData[ValidatorT], 0)
+		validatorData  *beacontypes.ValidatorData[ValidatorT]
+	)
+	for _, index := range indices {
+		if validatorData, err = b.validatorData(
+			st, index, epoch,
+		); err != nil {
+			return nil, err
+		}
+		if len(statuses) > 0 &&
+			!slices.Contains(statuses, validatorData.Status) {
+			continue
+		}
+		validatorsData = append(validatorsData, validatorData)
+	}
+	return validatorsData, nil
+}
+
+// validatorData returns the validator at the given index along with its
+// balance and status in the given epoch.
+func (b Backend[
+	_, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _, _, ValidatorT,
+	_, _, _, _,
+]) validatorData(
+	st BeaconStateT, index math.ValidatorIndex, epoch math.Epoch,
+) (*beacontypes.ValidatorData[ValidatorT], error) {
 	validator, err := st.ValidatorByIndex(index)
 	if err != nil {
 		return nil, err
@@ -55,33 +120,13 @@ func (b Backend[
 			Index:   index.Unwrap(),
 			Balance: balance.Unwrap(),
 		},
-		Status:    "active_ongoing", // TODO: fix
+		Status:    utils.ValidatorStatus(validator, balance, epoch),
 		Validator: validator,
 	}, nil
 }
 
-// TODO: filter by status
-func (b Backend[
-	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, ValidatorT, _, _, _,
-]) ValidatorsByIDs(
-	slot math.Slot, ids []string, _ []string,
-) ([]*beacontypes.ValidatorData[ValidatorT], error) {
-	validatorsData := make([]*beacontypes.ValidatorData[ValidatorT], 0)
-	for _, id := range ids {
-		// TODO: we can probably optimize this via a getAllValidators
-		// query and then filtering but blocked by the fact that IDs
-		// can be indices and the hard type only holds its own pubkey.
-		validatorData, err := b.ValidatorByID(slot, id)
-		if err != nil {
-			return nil, err
-		}
-		validatorsData = append(validatorsData, validatorData)
-	}
-	return validatorsData, nil
-}
-
 func (b Backend[
-	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
 ]) ValidatorBalancesByIDs(
 	slot math.Slot, ids []string,
 ) ([]*beacontypes.ValidatorBalanceData, error) {
@@ -0,0 +1,204 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/berachain/beacon-kit/mod/node-api/backend/utils"
+	buildertypes "github.com/berachain/beacon-kit/mod/node-api/handlers/builder/types"
+	apitypes "github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core"
+)
+
+// MaxSweepPayloads is the maximum number of payloads a withdrawal sweep may
+// be simulated over.
+const MaxSweepPayloads = 1024
+
+// ExpectedWithdrawals returns the withdrawals the payload of the block
+// proposed at proposalSlot on top of the state at slot must contain. The
+// proposal slot defaults to the slot following the state, and may be at most
+// an epoch ahead of it.
+func (b Backend[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) ExpectedWithdrawals(
+	slot, proposalSlot math.Slot,
+) ([]*buildertypes.WithdrawalData, error) {
+	st, slot, err := b.stateFromSlotRaw(slot)
+	if err != nil {
+		return nil, err
+	}
+	if proposalSlot == 0 {
+		proposalSlot = slot + 1
+	}
+	//#nosec:G701 // not an issue in practice.
+	if proposalSlot <= slot ||
+		proposalSlot > slot+math.Slot(b.cs.SlotsPerEpoch()) {
+		return nil, errors.Join(
+			apitypes.ErrInvalidRequest,
+			fmt.Errorf(
+				"proposal slot %d is not within an epoch after slot %d",
+				proposalSlot, slot,
+			),
+		)
+	}
+	if _, err = b.sp.ProcessSlots(st, proposalSlot); err != nil {
+		return nil, err
+	}
+
+	withdrawals, err := st.ExpectedWithdrawals()
+	if err != nil {
+		return nil, err
+	}
+	data := make([]*buildertypes.WithdrawalData, 0, len(withdrawals))
+	for _, withdrawal := range withdrawals {
+		data = append(data, withdrawalData(withdrawal))
+	}
+	return data, nil
+}
+
+// WithdrawalSweep simulates the withdrawal sweep over the payloads of the
+// given number of slots following the state at slot, and finds the next
+// withdrawal of the validators with the given IDs within them.
+//
+// The sweep is simulated on the state of the query context, which is
+// discarded afterwards. Past the first payload, it assumes a block is
+// proposed every slot and skips epoch processing, hence later withdrawals
+// are estimates.
+func (b Backend[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) WithdrawalSweep(
+	slot math.Slot, payloads uint64, ids []string,
+) (*buildertypes.WithdrawalSweepData, error) {
+	if payloads == 0 || payloads > MaxSweepPayloads {
+		return nil, errors.Join(
+			apitypes.ErrInvalidRequest,
+			fmt.Errorf(
+				"payloads must be between 1 and %d, got %d",
+				MaxSweepPayloads, payloads,
+			),
+		)
+	}
+	st, slot, err := b.stateFromSlotRaw(slot)
+	if err != nil {
+		return nil, err
+	}
+
+	data := &buildertypes.WithdrawalSweepData{
+		Payloads: make([]*buildertypes.PayloadWithdrawalsData, 0, payloads),
+		NextWithdrawals: make(
+			[]*buildertypes.NextWithdrawalData, 0, len(ids),
+		),
+	}
+	next := make(map[math.ValidatorIndex]*buildertypes.NextWithdrawalData)
+	for _, id := range ids {
+		var index math.ValidatorIndex
+		if index, err = utils.ValidatorIndexByID(st, id); err != nil {
+			return nil, err
+		}
+		if _, ok := next[index]; ok {
+			continue
+		}
+		next[index] = &buildertypes.NextWithdrawalData{
+			ValidatorIndex: index.Unwrap(),
+		}
+		data.NextWithdrawals = append(data.NextWithdrawals, next[index])
+	}
+
+	if _, err = b.sp.ProcessSlots(st, slot+1); err != nil {
+		return nil, err
+	}
+	for i := range payloads {
+		//#nosec:G701 // not an issue in practice.
+		proposalSlot := slot + 1 + math.Slot(i)
+		if err = st.SetSlot(proposalSlot); err != nil {
+			return nil, err
+		}
+		withdrawals, wErr := st.ExpectedWithdrawals()
+		if wErr != nil {
+			return nil, wErr
+		}
+
+		payload := &buildertypes.PayloadWithdrawalsData{
+			Slot:        proposalSlot.Unwrap(),
+			Withdrawals: make([]*buildertypes.WithdrawalData, 0),
+		}
+		epoch := b.cs.SlotToEpoch(proposalSlot)
+		for _, withdrawal := range withdrawals {
+			payload.Withdrawals = append(
+				payload.Withdrawals, withdrawalData(withdrawal),
+			)
+			entry, ok := next[withdrawal.GetValidatorIndex()]
+			if !ok || entry.Slot != 0 || withdrawal.GetAmount() == 0 {
+				continue
+			}
+			if entry.Type, err = b.withdrawalType(
+				st, withdrawal.GetValidatorIndex(), epoch,
+			); err != nil {
+				return nil, err
+			}
+			entry.Slot = proposalSlot.Unwrap()
+			entry.Amount = withdrawal.GetAmount().Unwrap()
+		}
+		data.Payloads = append(data.Payloads, payload)
+
+		if err = core.ApplyWithdrawals(st, b.cs, withdrawals); err != nil {
+			return nil, err
+		}
+	}
+	return data, nil
+}
+
+// withdrawalType returns whether the withdrawal of the validator at the
+// given index in the given epoch is full or partial.
+func (b Backend[
+	_, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, _,
+]) withdrawalType(
+	st BeaconStateT, index math.ValidatorIndex, epoch math.Epoch,
+) (string, error) {
+	validator, err := st.ValidatorByIndex(index)
+	if err != nil {
+		return "", err
+	}
+	balance, err := st.GetBalance(index)
+	if err != nil {
+		return "", err
+	}
+	if validator.IsFullyWithdrawable(balance, epoch) {
+		return buildertypes.WithdrawalTypeFull, nil
+	}
+	return buildertypes.WithdrawalTypePartial, nil
+}
+
+// withdrawalData converts a withdrawal to its API representation.
+func withdrawalData[WithdrawalT Withdrawal[WithdrawalT]](
+	withdrawal WithdrawalT,
+) *buildertypes.WithdrawalData {
+	return &buildertypes.WithdrawalData{
+		Index:          withdrawal.GetIndex().Unwrap(),
+		ValidatorIndex: withdrawal.GetValidatorIndex().Unwrap(),
+		Address:        withdrawal.GetAddress(),
+		Amount:         withdrawal.GetAmount().Unwrap(),
+	}
+}
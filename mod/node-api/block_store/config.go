@@ -20,6 +20,8 @@
 
 package blockstore
 
+import "fmt"
+
 const (
 	DefaultAvailabilityWindow = 8192
 )
@@ -39,3 +41,15 @@ func DefaultConfig() Config {
 		AvailabilityWindow: DefaultAvailabilityWindow,
 	}
 }
+
+// Validate returns an error describing every invalid field of the
+// configuration.
+func (c Config) Validate() error {
+	if c.AvailabilityWindow <= 0 {
+		return fmt.Errorf(
+			"availability-window must be greater than zero, got %d",
+			c.AvailabilityWindow,
+		)
+	}
+	return nil
+}
@@ -0,0 +1,134 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package echo
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"strings"
+
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/jwt"
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	// bearerPrefix is the prefix of the Authorization header value.
+	bearerPrefix = "Bearer "
+	// adminGroup is the route path prefix of the admin API.
+	adminGroup = "/bkit/v1/admin"
+)
+
+// authenticator checks the bearer token of requests to protected routes.
+// A request is accepted if its token matches the static token or is a JWT
+// signed with the secret.
+type authenticator struct {
+	// token is the static bearer token, ignored if empty.
+	token string
+	// secret verifies JWT bearer tokens, ignored if nil.
+	secret *jwt.Secret
+	// groups are the route path prefixes that require authentication.
+	groups []string
+	// required is true if the groups are protected even when no token or
+	// secret is configured, in which case every request is rejected.
+	required bool
+	// metrics records rejected requests.
+	metrics *metrics
+}
+
+// newAuthenticator creates a new authenticator.
+func newAuthenticator(
+	token string,
+	secret *jwt.Secret,
+	groups []string,
+	metrics *metrics,
+) *authenticator {
+	normalized := make([]string, 0, len(groups))
+	for _, group := range groups {
+		normalized = append(normalized, normalizePath(group))
+	}
+	return &authenticator{
+		token:   token,
+		secret:  secret,
+		groups:  normalized,
+		metrics: metrics,
+	}
+}
+
+// newAdminAuthenticator creates a new authenticator for the admin API, which
+// only accepts the admin token and is disabled if the token is empty.
+func newAdminAuthenticator(token string, metrics *metrics) *authenticator {
+	return &authenticator{
+		token:    token,
+		groups:   []string{adminGroup},
+		required: true,
+		metrics:  metrics,
+	}
+}
+
+// protects returns true if the route with the given path requires
+// authentication.
+func (a *authenticator) protects(path string) bool {
+	if !a.required && a.token == "" && a.secret == nil {
+		return false
+	}
+	path = normalizePath(path)
+	for _, group := range a.groups {
+		if group == "/" || path == group ||
+			strings.HasPrefix(path, group+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// middleware returns the echo middleware that rejects unauthenticated
+// requests.
+func (a *authenticator) middleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		header := c.Request().Header.Get(echo.HeaderAuthorization)
+		token, found := strings.CutPrefix(header, bearerPrefix)
+		if found && a.authorized(token) {
+			return next(c)
+		}
+		a.metrics.markUnauthorized(c.Path())
+		return c.JSON(http.StatusUnauthorized, ErrorResponse{
+			Code:    http.StatusUnauthorized,
+			Message: types.ErrUnauthorized.Error(),
+		})
+	}
+}
+
+// authorized returns true if the token is accepted.
+func (a *authenticator) authorized(token string) bool {
+	if a.token != "" && subtle.ConstantTimeCompare(
+		[]byte(token), []byte(a.token),
+	) == 1 {
+		return true
+	}
+	return a.secret != nil && a.secret.VerifyToken(token) == nil
+}
+
+// normalizePath returns the path with a single leading slash and no
+// trailing slash.
+func normalizePath(path string) string {
+	return "/" + strings.Trim(path, "/")
+}
@@ -21,16 +21,37 @@
 package echo
 
 import (
+	"crypto/tls"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/node-api/handlers"
+	"github.com/berachain/beacon-kit/mod/node-api/server"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/jwt"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
+	"github.com/labstack/gommon/bytes"
 )
 
 // Engine is an implementation of the API engine interface using Echo.
 type Engine struct {
 	*echo.Echo
 	logger log.Logger
+	// auth authenticates requests to protected routes, nil if the engine
+	// does not require authentication.
+	auth *authenticator
+	// adminAuth authenticates requests to the admin API, nil if the engine
+	// does not require authentication.
+	adminAuth *authenticator
+	// cache caches responses by state root, nil if responses are not
+	// cached.
+	cache ResponseCache
+	// metrics records request metrics.
+	metrics *metrics
+	// rateLimiter throttles clients, nil if the engine was not created with
+	// a node API config.
+	rateLimiter *rateLimiter
 }
 
 // New initializes a new API engine with the given Echo instance.
@@ -53,9 +74,84 @@ func NewDefaultEngine() *Engine {
 	return New(engine)
 }
 
-// Run starts the Echo engine at the given address.
+// NewEngine returns a new Echo Engine configured with the authentication,
+// rate limiting, CORS, TLS and request limits of the given node API config.
+// The secret is used to verify JWT bearer tokens and the cache is used to
+// cache responses by state root, both may be nil.
+func NewEngine(
+	cfg server.Config,
+	secret *jwt.Secret,
+	cache ResponseCache,
+	telemetrySink TelemetrySink,
+) (*Engine, error) {
+	m := newMetrics(telemetrySink)
+	engine := echo.New()
+	engine.Use(requestDurationMiddleware(m))
+	engine.Use(middleware.CORSWithConfig(middleware.CORSConfig{
+		AllowOrigins: cfg.CORS.AllowedOrigins,
+	}))
+	extractor, err := newIPExtractor(cfg.TrustedProxies)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid trusted proxy")
+	}
+	engine.IPExtractor = extractor
+	limiter := newRateLimiter(cfg.RateLimit, m)
+	engine.Use(limiter.middleware)
+	if cfg.MaxBodySize != "" {
+		if _, err := bytes.Parse(cfg.MaxBodySize); err != nil {
+			return nil, errors.Wrapf(err, "invalid max body size")
+		}
+		engine.Use(middleware.BodyLimit(cfg.MaxBodySize))
+	}
+	engine.Server.MaxHeaderBytes = cfg.MaxHeaderBytes
+	if cfg.TLS.Enabled() {
+		reloader, err := newCertReloader(
+			cfg.TLS.CertPath, cfg.TLS.KeyPath, cfg.TLS.ReloadInterval,
+		)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to load TLS certificate")
+		}
+		engine.Server.TLSConfig = &tls.Config{
+			GetCertificate: reloader.GetCertificate,
+			MinVersion:     tls.VersionTLS12,
+		}
+	}
+	engine.Validator = &CustomValidator{
+		Validator: ConstructValidator(),
+	}
+	engine.HideBanner = true
+
+	e := New(engine)
+	e.auth = newAuthenticator(cfg.Auth.Token, secret, cfg.Auth.Groups, m)
+	e.adminAuth = newAdminAuthenticator(cfg.AdminToken, m)
+	e.cache = cache
+	e.metrics = m
+	e.rateLimiter = limiter
+	return e, nil
+}
+
+// SetRateLimit replaces the rate limiting configuration of the engine. The
+// rate limits of all clients are reset.
+func (e *Engine) SetRateLimit(cfg server.RateLimitConfig) {
+	if e.rateLimiter == nil {
+		return
+	}
+	e.rateLimiter.configure(cfg)
+	if e.logger != nil {
+		e.logger.Info(
+			"Node API rate limit updated",
+			"enabled", cfg.Enabled,
+			"requests_per_second", cfg.RequestsPerSecond,
+			"burst", cfg.Burst,
+		)
+	}
+}
+
+// Run starts the Echo engine at the given address, serving TLS if the engine
+// was configured with a certificate.
 func (e *Engine) Run(addr string) error {
-	return e.Echo.Start(addr)
+	e.Echo.Server.Addr = addr
+	return e.Echo.StartServer(e.Echo.Server)
 }
 
 // RegisterRoutes registers the given route set with the Echo engine.
@@ -67,10 +163,43 @@ func (e *Engine) RegisterRoutes(
 	group := e.Group(hs.BasePath)
 	for _, route := range hs.Routes {
 		route.DecorateWithLogs(e.logger)
+		var mws []echo.MiddlewareFunc
+		if auth := e.authFor(hs.BasePath + route.Path); auth != nil {
+			mws = append(mws, auth.middleware)
+		}
 		group.Add(
 			route.Method,
 			route.Path,
-			responseMiddleware(route),
+			responseMiddleware(route, e.cache, e.metrics),
+			mws...,
 		)
 	}
 }
+
+// authFor returns the authenticator protecting the route with the given path,
+// or nil if the route is open. Admin routes only accept the admin token.
+func (e *Engine) authFor(path string) *authenticator {
+	for _, auth := range []*authenticator{e.adminAuth, e.auth} {
+		if auth != nil && auth.protects(path) {
+			return auth
+		}
+	}
+	return nil
+}
+
+// requestDurationMiddleware records the duration of every request by route.
+func requestDurationMiddleware(m *metrics) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			start := time.Now()
+			if err := next(c); err != nil {
+				// Write the error response so that its status is recorded.
+				c.Error(err)
+			}
+			m.measureRequestDuration(
+				start, c.Request().Method, c.Path(), c.Response().Status,
+			)
+			return nil
+		}
+	}
+}
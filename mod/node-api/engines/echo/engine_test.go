@@ -0,0 +1,351 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package echo_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/node-api/engines/echo"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/mod/node-api/server"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/jwt"
+	"github.com/stretchr/testify/require"
+)
+
+type noopSink struct{}
+
+func (noopSink) IncrementCounter(string, ...string) {}
+
+func (noopSink) MeasureSince(string, time.Time, ...string) {}
+
+func newTestEngine(
+	t *testing.T,
+	cfg server.Config,
+	secret *jwt.Secret,
+) *echo.Engine {
+	t.Helper()
+	engine, err := echo.NewEngine(cfg, secret, nil, noopSink{})
+	require.NoError(t, err)
+	ok := func(echo.Context) (any, error) { return "ok", nil }
+	engine.RegisterRoutes(
+		handlers.NewRouteSet[echo.Context](
+			"",
+			&handlers.Route[echo.Context]{
+				Method: http.MethodGet, Path: "/eth/v1/node/version",
+				Handler: ok,
+			},
+			&handlers.Route[echo.Context]{
+				Method: http.MethodPost, Path: "/bkit/v1/open",
+				Handler: ok,
+			},
+			&handlers.Route[echo.Context]{
+				Method: http.MethodGet, Path: "/bkit/v1/admin/log_level",
+				Handler: ok,
+			},
+		),
+		noop.NewLogger[log.Logger](),
+	)
+	return engine
+}
+
+func serve(
+	engine *echo.Engine,
+	method, path, token, body string,
+) int {
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	rec := httptest.NewRecorder()
+	engine.ServeHTTP(rec, req)
+	return rec.Code
+}
+
+func TestEngine_Auth(t *testing.T) {
+	secret, err := jwt.NewRandom()
+	require.NoError(t, err)
+	signed, err := secret.BuildSignedToken()
+	require.NoError(t, err)
+
+	cfg := server.DefaultConfig()
+	cfg.Auth.Token = "static"
+	engine := newTestEngine(t, cfg, secret)
+
+	const path = "/eth/v1/node/version"
+	require.Equal(t, http.StatusUnauthorized,
+		serve(engine, http.MethodGet, path, "", ""))
+	require.Equal(t, http.StatusUnauthorized,
+		serve(engine, http.MethodGet, path, "wrong", ""))
+	require.Equal(t, http.StatusOK,
+		serve(engine, http.MethodGet, path, "static", ""))
+	require.Equal(t, http.StatusOK,
+		serve(engine, http.MethodGet, path, signed, ""))
+
+	// Routes outside of the protected groups stay open.
+	require.Equal(t, http.StatusOK,
+		serve(engine, http.MethodPost, "/bkit/v1/open", "", ""))
+}
+
+func TestEngine_AuthDisabled(t *testing.T) {
+	engine := newTestEngine(t, server.DefaultConfig(), nil)
+	require.Equal(t, http.StatusOK,
+		serve(engine, http.MethodGet, "/eth/v1/node/version", "", ""))
+}
+
+func TestEngine_AdminAuth(t *testing.T) {
+	const path = "/bkit/v1/admin/log_level"
+
+	// The admin API is disabled without an admin token.
+	engine := newTestEngine(t, server.DefaultConfig(), nil)
+	require.Equal(t, http.StatusUnauthorized,
+		serve(engine, http.MethodGet, path, "", ""))
+
+	cfg := server.DefaultConfig()
+	cfg.AdminToken = "admin"
+	cfg.Auth.Token = "static"
+	cfg.Auth.Groups = []string{"/"}
+	engine = newTestEngine(t, cfg, nil)
+	require.Equal(t, http.StatusUnauthorized,
+		serve(engine, http.MethodGet, path, "", ""))
+	require.Equal(t, http.StatusUnauthorized,
+		serve(engine, http.MethodGet, path, "static", ""))
+	require.Equal(t, http.StatusOK,
+		serve(engine, http.MethodGet, path, "admin", ""))
+}
+
+func TestEngine_RateLimit(t *testing.T) {
+	cfg := server.DefaultConfig()
+	cfg.RateLimit.Enabled = true
+	cfg.RateLimit.RequestsPerSecond = 0.001
+	cfg.RateLimit.Burst = 2
+	engine := newTestEngine(t, cfg, nil)
+
+	const path = "/eth/v1/node/version"
+	require.Equal(t, http.StatusOK,
+		serve(engine, http.MethodGet, path, "", ""))
+	require.Equal(t, http.StatusOK,
+		serve(engine, http.MethodGet, path, "", ""))
+	require.Equal(t, http.StatusTooManyRequests,
+		serve(engine, http.MethodGet, path, "", ""))
+
+	// Disabling the rate limit at runtime lets throttled clients through.
+	cfg.RateLimit.Enabled = false
+	engine.SetRateLimit(cfg.RateLimit)
+	require.Equal(t, http.StatusOK,
+		serve(engine, http.MethodGet, path, "", ""))
+}
+
+func TestEngine_RateLimitForwardedFor(t *testing.T) {
+	cfg := server.DefaultConfig()
+	cfg.RateLimit.Enabled = true
+	cfg.RateLimit.RequestsPerSecond = 0.001
+	cfg.RateLimit.Burst = 1
+	serveFrom := func(engine *echo.Engine, forwardedFor string) int {
+		req := httptest.NewRequest(
+			http.MethodGet, "/eth/v1/node/version", nil,
+		)
+		req.Header.Set("X-Forwarded-For", forwardedFor)
+		rec := httptest.NewRecorder()
+		engine.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	// Without trusted proxies, spoofed headers do not evade the limit.
+	engine := newTestEngine(t, cfg, nil)
+	require.Equal(t, http.StatusOK, serveFrom(engine, "203.0.113.1"))
+	require.Equal(t,
+		http.StatusTooManyRequests, serveFrom(engine, "203.0.113.2"))
+
+	// Behind a trusted proxy, the header identifies the client.
+	cfg.TrustedProxies = []string{"192.0.2.0/24"}
+	engine = newTestEngine(t, cfg, nil)
+	require.Equal(t, http.StatusOK, serveFrom(engine, "203.0.113.1"))
+	require.Equal(t, http.StatusOK, serveFrom(engine, "203.0.113.2"))
+	require.Equal(t,
+		http.StatusTooManyRequests, serveFrom(engine, "203.0.113.2"))
+}
+
+func TestEngine_MaxBodySize(t *testing.T) {
+	cfg := server.DefaultConfig()
+	cfg.MaxBodySize = "8B"
+	engine := newTestEngine(t, cfg, nil)
+
+	require.Equal(t, http.StatusOK,
+		serve(engine, http.MethodPost, "/bkit/v1/open", "", "small"))
+	require.Equal(t, http.StatusRequestEntityTooLarge,
+		serve(engine, http.MethodPost, "/bkit/v1/open", "", "far too large"))
+
+	cfg.MaxBodySize = "lots"
+	_, err := echo.NewEngine(cfg, nil, nil, noopSink{})
+	require.Error(t, err)
+}
+
+type mapCache struct {
+	roots     map[string]common.Root
+	responses map[string]any
+}
+
+func (m *mapCache) StateRootByStateID(id string) (common.Root, bool) {
+	root, ok := m.roots[id]
+	return root, ok
+}
+
+func (m *mapCache) StateRootByTimestampID(string) (common.Root, bool) {
+	return common.Root{}, false
+}
+
+func (m *mapCache) Get(key string) (any, bool) {
+	res, ok := m.responses[key]
+	return res, ok
+}
+
+func (m *mapCache) Add(key string, res any) {
+	m.responses[key] = res
+}
+
+type sszData struct {
+	Value string `json:"value"`
+}
+
+func (d sszData) MarshalSSZ() ([]byte, error) {
+	return []byte(d.Value), nil
+}
+
+func TestEngine_ContentNegotiationAndCache(t *testing.T) {
+	cache := &mapCache{
+		roots:     map[string]common.Root{"head": {0x01}},
+		responses: make(map[string]any),
+	}
+	engine, err := echo.NewEngine(
+		server.DefaultConfig(), nil, cache, noopSink{},
+	)
+	require.NoError(t, err)
+	calls := 0
+	engine.RegisterRoutes(
+		handlers.NewRouteSet[echo.Context](
+			"",
+			&handlers.Route[echo.Context]{
+				Method: http.MethodGet,
+				Path:   "/eth/v2/debug/beacon/states/:state_id",
+				Handler: func(echo.Context) (any, error) {
+					calls++
+					return types.VersionedResponse{
+						Version: "deneb",
+						Data:    sszData{Value: "state"},
+					}, nil
+				},
+			},
+		),
+		noop.NewLogger[log.Logger](),
+	)
+
+	get := func(
+		stateID, accept, ifNoneMatch string,
+	) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(
+			http.MethodGet, "/eth/v2/debug/beacon/states/"+stateID, nil,
+		)
+		req.Header.Set("Accept", accept)
+		req.Header.Set("If-None-Match", ifNoneMatch)
+		rec := httptest.NewRecorder()
+		engine.ServeHTTP(rec, req)
+		return rec
+	}
+
+	// JSON is served by default and the response is cached.
+	rec := get("head", "", "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "deneb", rec.Header().Get("Eth-Consensus-Version"))
+	require.Contains(t, rec.Body.String(), `"value":"state"`)
+	jsonTag := rec.Header().Get("ETag")
+	require.NotEmpty(t, jsonTag)
+
+	// SSZ is served from the cache when preferred.
+	const accept = "application/octet-stream;q=1,application/json;q=0.9"
+	rec = get("head", accept, "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t,
+		"application/octet-stream", rec.Header().Get("Content-Type"))
+	require.Equal(t, "state", rec.Body.String())
+	require.NotEqual(t, jsonTag, rec.Header().Get("ETag"))
+	require.Equal(t, 1, calls)
+
+	// A matching ETag is answered without a body.
+	rec = get("head", "", jsonTag)
+	require.Equal(t, http.StatusNotModified, rec.Code)
+	require.Equal(t, 1, calls)
+
+	// Unknown states bypass the cache.
+	rec = get("1234", "", "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Empty(t, rec.Header().Get("ETag"))
+	require.Equal(t, 2, calls)
+
+	// Clients accepting only unsupported media types are rejected.
+	rec = get("1234", "text/html", "")
+	require.Equal(t, http.StatusNotAcceptable, rec.Code)
+}
+
+func TestEngine_CacheStateCommittedWhileHandling(t *testing.T) {
+	cache := &mapCache{
+		roots:     map[string]common.Root{"head": {0x01}},
+		responses: make(map[string]any),
+	}
+	engine, err := echo.NewEngine(
+		server.DefaultConfig(), nil, cache, noopSink{},
+	)
+	require.NoError(t, err)
+	engine.RegisterRoutes(
+		handlers.NewRouteSet[echo.Context](
+			"",
+			&handlers.Route[echo.Context]{
+				Method: http.MethodGet,
+				Path:   "/eth/v1/beacon/states/:state_id/root",
+				Handler: func(echo.Context) (any, error) {
+					// The next state is committed while the request is
+					// handled.
+					cache.roots["head"] = common.Root{0x02}
+					return "root", nil
+				},
+			},
+		),
+		noop.NewLogger[log.Logger](),
+	)
+
+	req := httptest.NewRequest(
+		http.MethodGet, "/eth/v1/beacon/states/head/root", nil,
+	)
+	rec := httptest.NewRecorder()
+	engine.ServeHTTP(rec, req)
+
+	// The response is served, but neither tagged nor cached.
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Empty(t, rec.Header().Get("ETag"))
+	require.Empty(t, cache.responses)
+}
@@ -0,0 +1,90 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package echo
+
+import (
+	"strconv"
+	"time"
+)
+
+// unknownRoute is the route label used for requests that did not match a
+// registered route.
+const unknownRoute = "unknown"
+
+// metrics is a struct that contains metrics for the API engine.
+type metrics struct {
+	// sink is the telemetry sink.
+	sink TelemetrySink
+}
+
+// newMetrics creates a new instance of the metrics struct.
+func newMetrics(sink TelemetrySink) *metrics {
+	return &metrics{
+		sink: sink,
+	}
+}
+
+// measureRequestDuration records the duration of a request to a route.
+func (m *metrics) measureRequestDuration(
+	start time.Time,
+	method, route string,
+	status int,
+) {
+	if route == "" {
+		route = unknownRoute
+	}
+	m.sink.MeasureSince(
+		"beacon_kit.node_api.request_duration",
+		start,
+		"method", method,
+		"route", route,
+		"status", strconv.Itoa(status),
+	)
+}
+
+// markUnauthorized increments the counter for rejected unauthenticated
+// requests.
+func (m *metrics) markUnauthorized(route string) {
+	m.sink.IncrementCounter(
+		"beacon_kit.node_api.unauthorized",
+		"route", route,
+	)
+}
+
+// markRateLimited increments the counter for throttled requests.
+func (m *metrics) markRateLimited(route string) {
+	if route == "" {
+		route = unknownRoute
+	}
+	m.sink.IncrementCounter(
+		"beacon_kit.node_api.rate_limited",
+		"route", route,
+	)
+}
+
+// markResponseCache increments the counter for response cache lookups.
+func (m *metrics) markResponseCache(route string, hit bool) {
+	m.sink.IncrementCounter(
+		"beacon_kit.node_api.response_cache",
+		"route", route,
+		"hit", strconv.FormatBool(hit),
+	)
+}
@@ -36,15 +36,81 @@ type ErrorResponse struct {
 }
 
 // responseMiddleware is a middleware that converts errors to an HTTP status
-// code and response.
+// code and response. Responses to requests for a known state are cached and
+// tagged with an ETag if a cache is given.
 func responseMiddleware(
 	handler *handlers.Route[Context],
+	cache ResponseCache,
+	m *metrics,
 ) echo.HandlerFunc {
 	return func(c Context) error {
-		data, err := handler.Handler(c)
+		preferSSZ, acceptsJSON := negotiate(
+			c.Request().Header.Get(echo.HeaderAccept),
+		)
+		key, ok := cacheKey(c, cache)
+		if !ok {
+			data, err := handler.Handler(c)
+			return writeResponse(c, data, err, preferSSZ, acceptsJSON)
+		}
+
+		tag := etag(key, preferSSZ)
+		c.Response().Header().Set(headerETag, tag)
+		if etagMatches(c.Request().Header.Get(headerIfNoneMatch), tag) {
+			return c.NoContent(http.StatusNotModified)
+		}
+		data, found := cache.Get(key)
+		m.markResponseCache(c.Path(), found)
+		if !found {
+			var err error
+			if data, err = handler.Handler(c); err != nil {
+				c.Response().Header().Del(headerETag)
+				return writeResponse(c, nil, err, preferSSZ, acceptsJSON)
+			}
+			// The state may have been committed while handling the request,
+			// in which case the response may not belong to the key.
+			if after, _ := cacheKey(c, cache); after != key {
+				c.Response().Header().Del(headerETag)
+				return writeResponse(c, data, nil, preferSSZ, acceptsJSON)
+			}
+			cache.Add(key, data)
+		}
+		return writeResponse(c, data, nil, preferSSZ, acceptsJSON)
+	}
+}
+
+// writeResponse writes the handler result, encoding the data as SSZ if the
+// client prefers it and the data supports it.
+func writeResponse(
+	c Context,
+	data any,
+	err error,
+	preferSSZ, acceptsJSON bool,
+) error {
+	if err != nil {
 		code, response := responseFromError(data, err)
 		return c.JSON(code, response)
 	}
+	if v, ok := data.(interface{ ConsensusVersion() string }); ok {
+		c.Response().Header().Set(headerConsensusVersion, v.ConsensusVersion())
+	}
+	if preferSSZ {
+		bz, sszErr := marshalSSZ(data)
+		switch {
+		case sszErr == nil:
+			return c.Blob(http.StatusOK, mimeSSZ, bz)
+		case !errors.Is(sszErr, types.ErrNotAcceptable):
+			code, response := responseFromError(nil, sszErr)
+			return c.JSON(code, response)
+		case !acceptsJSON:
+			code, response := responseFromError(nil, sszErr)
+			return c.JSON(code, response)
+		}
+	}
+	if !acceptsJSON {
+		code, response := responseFromError(nil, types.ErrNotAcceptable)
+		return c.JSON(code, response)
+	}
+	return c.JSON(http.StatusOK, data)
 }
 
 // responseFromErr converts an error to an HTTP status code and response. If
@@ -63,6 +129,21 @@ func responseFromError(data any, err error) (int, any) {
 			Code:    http.StatusBadRequest,
 			Message: err.Error(),
 		}
+	case errors.Is(err, types.ErrUnauthorized):
+		return http.StatusUnauthorized, ErrorResponse{
+			Code:    http.StatusUnauthorized,
+			Message: err.Error(),
+		}
+	case errors.Is(err, types.ErrNotAcceptable):
+		return http.StatusNotAcceptable, ErrorResponse{
+			Code:    http.StatusNotAcceptable,
+			Message: err.Error(),
+		}
+	case errors.Is(err, types.ErrTooManyRequests):
+		return http.StatusTooManyRequests, ErrorResponse{
+			Code:    http.StatusTooManyRequests,
+			Message: err.Error(),
+		}
 	case errors.Is(err, types.ErrNotImplemented):
 		return http.StatusNotImplemented, ErrorResponse{
 			Code:    http.StatusNotImplemented,
@@ -0,0 +1,154 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package echo
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	// mimeSSZ is the media type of SSZ encoded responses.
+	mimeSSZ = echo.MIMEOctetStream
+	// headerConsensusVersion is the header reporting the fork of versioned
+	// responses.
+	headerConsensusVersion = "Eth-Consensus-Version"
+	// headerETag is the header carrying the ETag of cached responses.
+	headerETag = "ETag"
+	// headerIfNoneMatch is the header carrying the ETags known to a client.
+	headerIfNoneMatch = "If-None-Match"
+	// etagLength is the number of bytes of the key digest used as ETag.
+	etagLength = 16
+)
+
+// negotiate parses the Accept header, returning whether the client prefers
+// SSZ over JSON and whether it accepts JSON at all.
+func negotiate(accept string) (bool, bool) {
+	if accept == "" {
+		return false, true
+	}
+	sszQ, jsonQ := -1.0, -1.0
+	for _, mediaRange := range strings.Split(accept, ",") {
+		mediaType, q := parseMediaRange(mediaRange)
+		switch mediaType {
+		case mimeSSZ:
+			sszQ = max(sszQ, q)
+		case echo.MIMEApplicationJSON, "application/*", "*/*":
+			jsonQ = max(jsonQ, q)
+		}
+	}
+	return sszQ > 0 && sszQ >= jsonQ, jsonQ > 0
+}
+
+// parseMediaRange returns the media type and quality of a media range of an
+// Accept header.
+func parseMediaRange(mediaRange string) (string, float64) {
+	mediaType, params, _ := strings.Cut(mediaRange, ";")
+	q := 1.0
+	for _, param := range strings.Split(params, ";") {
+		value, found := strings.CutPrefix(strings.TrimSpace(param), "q=")
+		if !found {
+			continue
+		}
+		if parsed, err := strconv.ParseFloat(value, 64); err == nil {
+			q = parsed
+		}
+	}
+	return strings.ToLower(strings.TrimSpace(mediaType)), q
+}
+
+// marshalSSZ returns the SSZ encoding of the data, or ErrNotAcceptable if the
+// data cannot be encoded as SSZ.
+func marshalSSZ(data any) ([]byte, error) {
+	m, ok := data.(interface{ MarshalSSZ() ([]byte, error) })
+	if !ok {
+		return nil, types.ErrNotAcceptable
+	}
+	return m.MarshalSSZ()
+}
+
+// cacheKey returns the key of the response to a GET request for a state
+// known to the cache. The key is made of the route, the state root and the
+// remaining request parameters.
+func cacheKey(c Context, cache ResponseCache) (string, bool) {
+	if cache == nil || c.Request().Method != http.MethodGet {
+		return "", false
+	}
+	var (
+		key   strings.Builder
+		found bool
+	)
+	key.WriteString(c.Path())
+	values := c.ParamValues()
+	for i, name := range c.ParamNames() {
+		switch name {
+		case "state_id":
+			root, ok := cache.StateRootByStateID(values[i])
+			if !ok {
+				return "", false
+			}
+			key.WriteString("|" + root.Hex())
+			found = true
+		case "timestamp_id":
+			root, ok := cache.StateRootByTimestampID(values[i])
+			if !ok {
+				return "", false
+			}
+			key.WriteString("|" + root.Hex())
+			found = true
+		default:
+			key.WriteString("|" + name + "=" + values[i])
+		}
+	}
+	key.WriteString("?" + c.QueryParams().Encode())
+	return key.String(), found
+}
+
+// etag returns the ETag of the representation of the response with the given
+// cache key.
+func etag(key string, ssz bool) string {
+	representation := "json"
+	if ssz {
+		representation = "ssz"
+	}
+	digest := sha256.Sum256([]byte(key + "|" + representation))
+	return `"` + hex.EncodeToString(digest[:etagLength]) + `"`
+}
+
+// etagMatches returns true if the If-None-Match header matches the ETag.
+func etagMatches(ifNoneMatch, tag string) bool {
+	if ifNoneMatch == "" {
+		return false
+	}
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+		if candidate == "*" || candidate == tag {
+			return true
+		}
+	}
+	return false
+}
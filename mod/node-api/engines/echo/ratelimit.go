@@ -0,0 +1,106 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package echo
+
+import (
+	"net"
+	"net/http"
+	"sync/atomic"
+
+	"github.com/berachain/beacon-kit/mod/node-api/server"
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+	"golang.org/x/time/rate"
+)
+
+// rateLimiter throttles each client, identified by its IP address, with a
+// token bucket. Its configuration may be replaced at runtime.
+type rateLimiter struct {
+	// store holds the token buckets of all clients, nil if rate limiting is
+	// disabled.
+	store atomic.Pointer[middleware.RateLimiterMemoryStore]
+	// metrics records throttled requests.
+	metrics *metrics
+}
+
+// newRateLimiter returns a rate limiter configured with the given config.
+func newRateLimiter(cfg server.RateLimitConfig, m *metrics) *rateLimiter {
+	rl := &rateLimiter{metrics: m}
+	rl.configure(cfg)
+	return rl
+}
+
+// configure replaces the configuration of the rate limiter. The token
+// buckets of all clients are reset.
+func (rl *rateLimiter) configure(cfg server.RateLimitConfig) {
+	if !cfg.Enabled {
+		rl.store.Store(nil)
+		return
+	}
+	rl.store.Store(middleware.NewRateLimiterMemoryStoreWithConfig(
+		middleware.RateLimiterMemoryStoreConfig{
+			Rate:      rate.Limit(cfg.RequestsPerSecond),
+			Burst:     cfg.Burst,
+			ExpiresIn: cfg.ExpiresIn,
+		},
+	))
+}
+
+// middleware rejects requests of clients that exceeded their rate limit.
+func (rl *rateLimiter) middleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		store := rl.store.Load()
+		if store == nil {
+			return next(c)
+		}
+		if allowed, err := store.Allow(c.RealIP()); err != nil || !allowed {
+			rl.metrics.markRateLimited(c.Path())
+			return c.JSON(http.StatusTooManyRequests, ErrorResponse{
+				Code:    http.StatusTooManyRequests,
+				Message: http.StatusText(http.StatusTooManyRequests),
+			})
+		}
+		return next(c)
+	}
+}
+
+// newIPExtractor returns the extractor of the client IP that requests are
+// rate limited by. The client is the peer of the connection, unless the
+// peer is one of the trusted proxies, in which case the client is read from
+// the X-Forwarded-For header. Only the given ranges are trusted, so that
+// clients cannot spoof the header to evade their rate limit.
+func newIPExtractor(trustedProxies []string) (echo.IPExtractor, error) {
+	if len(trustedProxies) == 0 {
+		return echo.ExtractIPDirect(), nil
+	}
+	opts := []echo.TrustOption{
+		echo.TrustLoopback(false),
+		echo.TrustLinkLocal(false),
+		echo.TrustPrivateNet(false),
+	}
+	for _, proxy := range trustedProxies {
+		_, ipNet, err := net.ParseCIDR(proxy)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, echo.TrustIPRange(ipNet))
+	}
+	return echo.ExtractIPFromXFFHeader(opts...), nil
+}
@@ -0,0 +1,120 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package echo
+
+import (
+	"crypto/tls"
+	"os"
+	"sync"
+	"time"
+)
+
+// certReloader serves a TLS certificate loaded from disk, reloading it when
+// the certificate or key file changes.
+type certReloader struct {
+	certPath string
+	keyPath  string
+	interval time.Duration
+
+	mu          sync.RWMutex
+	cert        *tls.Certificate
+	modTime     time.Time
+	lastChecked time.Time
+}
+
+// newCertReloader creates a new certReloader and loads the initial
+// certificate.
+func newCertReloader(
+	certPath, keyPath string,
+	interval time.Duration,
+) (*certReloader, error) {
+	r := &certReloader{
+		certPath: certPath,
+		keyPath:  keyPath,
+		interval: interval,
+	}
+	if err := r.reload(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// GetCertificate returns the current certificate, reloading it first if the
+// files changed since the last check. It implements the
+// tls.Config.GetCertificate callback.
+func (r *certReloader) GetCertificate(
+	*tls.ClientHelloInfo,
+) (*tls.Certificate, error) {
+	if r.shouldCheck() {
+		// If the reload fails we keep serving the previous certificate.
+		_ = r.reload()
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.cert, nil
+}
+
+// shouldCheck returns true if the reload interval has elapsed.
+func (r *certReloader) shouldCheck() bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.interval > 0 && time.Since(r.lastChecked) >= r.interval
+}
+
+// reload loads the certificate and key pair if either file has been modified
+// since it was last loaded.
+func (r *certReloader) reload() error {
+	modTime, err := r.latestModTime()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.lastChecked = time.Now()
+	if err != nil {
+		return err
+	}
+	if r.cert != nil && !modTime.After(r.modTime) {
+		return nil
+	}
+
+	cert, err := tls.LoadX509KeyPair(r.certPath, r.keyPath)
+	if err != nil {
+		return err
+	}
+	r.cert = &cert
+	r.modTime = modTime
+	return nil
+}
+
+// latestModTime returns the most recent modification time of the certificate
+// and key files.
+func (r *certReloader) latestModTime() (time.Time, error) {
+	var latest time.Time
+	for _, path := range []string{r.certPath, r.keyPath} {
+		info, err := os.Stat(path)
+		if err != nil {
+			return time.Time{}, err
+		}
+		if info.ModTime().After(latest) {
+			latest = info.ModTime()
+		}
+	}
+	return latest, nil
+}
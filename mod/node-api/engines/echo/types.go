@@ -20,8 +20,39 @@
 
 package echo
 
-import "github.com/labstack/echo/v4"
+import (
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/labstack/echo/v4"
+)
 
 type (
 	Context = echo.Context
+
+	// TelemetrySink is an interface for sending metrics to a telemetry
+	// backend.
+	TelemetrySink interface {
+		// IncrementCounter increments a counter metric identified by the
+		// provided keys.
+		IncrementCounter(key string, args ...string)
+		// MeasureSince measures the time since the provided start time,
+		// identified by the provided keys.
+		MeasureSince(key string, start time.Time, args ...string)
+	}
+
+	// ResponseCache caches responses by the state root they were computed
+	// from.
+	ResponseCache interface {
+		// StateRootByStateID returns the state root referred to by the
+		// given state ID, if it is known.
+		StateRootByStateID(stateID string) (common.Root, bool)
+		// StateRootByTimestampID returns the root of the state referred to
+		// by the given timestamp ID, if it is known.
+		StateRootByTimestampID(timestampID string) (common.Root, bool)
+		// Get returns the cached response for the given key.
+		Get(key string) (any, bool)
+		// Add caches the response for the given key.
+		Add(key string, response any)
+	}
 )
@@ -67,7 +67,10 @@ func ConstructValidator() *validator.Validate {
 		"validator_id":     ValidateValidatorID,
 		"epoch":            ValidateUint64,
 		"slot":             ValidateUint64,
+		"uint64":           ValidateUint64,
 		"validator_status": ValidateValidatorStatus,
+		"peer_state":       ValidatePeerState,
+		"peer_direction":   ValidatePeerDirection,
 	}
 	validate := validator.New()
 	for tag, fn := range validators {
@@ -166,6 +169,24 @@ func ValidateValidatorStatus(fl validator.FieldLevel) bool {
 	return validateAllowedStrings(fl.Field().String(), allowedStatuses)
 }
 
+func ValidatePeerState(fl validator.FieldLevel) bool {
+	allowedStates := map[string]bool{
+		"disconnected":  true,
+		"connecting":    true,
+		"connected":     true,
+		"disconnecting": true,
+	}
+	return validateAllowedStrings(fl.Field().String(), allowedStates)
+}
+
+func ValidatePeerDirection(fl validator.FieldLevel) bool {
+	allowedDirections := map[string]bool{
+		"inbound":  true,
+		"outbound": true,
+	}
+	return validateAllowedStrings(fl.Field().String(), allowedDirections)
+}
+
 func validateAllowedStrings(
 	value string,
 	allowedValues map[string]bool,
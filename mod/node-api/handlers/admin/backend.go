@@ -0,0 +1,78 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package admin
+
+import "github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+
+// LogLevelController changes the log levels of a running node.
+type LogLevelController interface {
+	// SetLevel sets the global log level.
+	SetLevel(level string) error
+	// SetModuleLevel overrides the log level of a single module. An empty
+	// level removes the override.
+	SetModuleLevel(module, level string) error
+	// Levels returns the global log level and per-module overrides.
+	Levels() (string, map[string]string)
+}
+
+// DBManager controls the pruners of a running node.
+type DBManager interface {
+	// PrunerStatuses returns whether each pruner is paused.
+	PrunerStatuses() map[string]bool
+	// PausePruner pauses the pruner with the given name.
+	PausePruner(name string) error
+	// ResumePruner resumes the pruner with the given name.
+	ResumePruner(name string) error
+	// Prune triggers the pruner with the given name to prune [start, end).
+	Prune(name string, start, end uint64) error
+}
+
+// DepositService fetches deposits from the execution layer.
+type DepositService interface {
+	// ForceCatchup (re)fetches deposits for the given execution blocks and
+	// all previously failed blocks.
+	ForceCatchup(blockNums ...math.U64)
+}
+
+// PayloadIDCache caches the payload IDs of payloads being built.
+type PayloadIDCache[
+	PayloadIDT ~[8]byte, RootT ~[32]byte, SlotT ~uint64,
+] interface {
+	// Entries returns a copy of all cached payload IDs.
+	Entries() map[SlotT]map[RootT]PayloadIDT
+}
+
+// ChainService toggles optimistic payload building.
+type ChainService interface {
+	// OptimisticPayloadBuilds returns whether optimistic payload builds are
+	// enabled.
+	OptimisticPayloadBuilds() bool
+	// SetOptimisticPayloadBuilds enables or disables optimistic payload
+	// builds.
+	SetOptimisticPayloadBuilds(enabled bool)
+}
+
+// TelemetrySink is an interface for sending metrics to a telemetry backend.
+type TelemetrySink interface {
+	// IncrementCounter increments a counter metric identified by the provided
+	// keys.
+	IncrementCounter(key string, args ...string)
+}
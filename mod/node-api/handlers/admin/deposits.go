@@ -0,0 +1,50 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package admin
+
+import (
+	admintypes "github.com/berachain/beacon-kit/mod/node-api/handlers/admin/types"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/utils"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// ForceDepositCatchup forces deposits for the requested execution blocks, and
+// all previously failed blocks, to be fetched immediately.
+func (h *Handler[ContextT, _, _, _]) ForceDepositCatchup(
+	c ContextT,
+) (any, error) {
+	req, err := utils.BindAndValidate[admintypes.DepositCatchupRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	blockNums := make([]math.U64, len(req.BlockNumbers))
+	for i, blockNum := range req.BlockNumbers {
+		if blockNums[i], err = utils.U64FromString(blockNum); err != nil {
+			return nil, types.ErrInvalidRequest
+		}
+	}
+	h.depositService.ForceCatchup(blockNums...)
+	h.logger.Info("Deposit catchup forced", "blocks", blockNums)
+	return types.Wrap(req), nil
+}
@@ -0,0 +1,99 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package admin
+
+import (
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers"
+	"github.com/berachain/beacon-kit/mod/node-api/server/context"
+)
+
+// Handler is the handler for the admin API. Every action is logged and
+// counted regardless of whether API logging is enabled. Requests are
+// authenticated with the admin token by the engine before reaching it.
+type Handler[
+	ContextT context.Context,
+	PayloadIDT ~[8]byte,
+	RootT ~[32]byte,
+	SlotT ~uint64,
+] struct {
+	*handlers.BaseHandler[ContextT]
+	// logger is used to record every admin action.
+	logger log.Logger
+	// metrics records admin actions.
+	metrics *metrics
+
+	logLevels      LogLevelController
+	dbManager      DBManager
+	depositService DepositService
+	payloadIDCache PayloadIDCache[PayloadIDT, RootT, SlotT]
+	chainService   ChainService
+}
+
+// NewHandler creates a new handler for the admin API.
+func NewHandler[
+	ContextT context.Context,
+	PayloadIDT ~[8]byte,
+	RootT ~[32]byte,
+	SlotT ~uint64,
+](
+	logger log.Logger,
+	telemetrySink TelemetrySink,
+	logLevels LogLevelController,
+	dbManager DBManager,
+	depositService DepositService,
+	payloadIDCache PayloadIDCache[PayloadIDT, RootT, SlotT],
+	chainService ChainService,
+) *Handler[ContextT, PayloadIDT, RootT, SlotT] {
+	h := &Handler[ContextT, PayloadIDT, RootT, SlotT]{
+		BaseHandler: handlers.NewBaseHandler(
+			handlers.NewRouteSet[ContextT](""),
+		),
+		logger:         logger,
+		metrics:        newMetrics(telemetrySink),
+		logLevels:      logLevels,
+		dbManager:      dbManager,
+		depositService: depositService,
+		payloadIDCache: payloadIDCache,
+		chainService:   chainService,
+	}
+	return h
+}
+
+// audited wraps an admin action with audit logging and metrics.
+func (h *Handler[ContextT, _, _, _]) audited(
+	action string,
+	fn func(ContextT) (any, error),
+) func(ContextT) (any, error) {
+	return func(c ContextT) (any, error) {
+		res, err := fn(c)
+		if err != nil {
+			h.logger.Error(
+				"Admin action failed", "action", action, "error", err,
+			)
+			h.metrics.markAction(action, false)
+			return nil, err
+		}
+		h.logger.Info("Admin action performed", "action", action)
+		h.metrics.markAction(action, true)
+		return res, nil
+	}
+}
@@ -0,0 +1,64 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package admin
+
+import (
+	"github.com/berachain/beacon-kit/mod/errors"
+	admintypes "github.com/berachain/beacon-kit/mod/node-api/handlers/admin/types"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/utils"
+)
+
+// GetLogLevels returns the global log level and per-module overrides.
+func (h *Handler[ContextT, _, _, _]) GetLogLevels(ContextT) (any, error) {
+	level, modules := h.logLevels.Levels()
+	return types.Wrap(admintypes.LogLevelsResponse{
+		Level:   level,
+		Modules: modules,
+	}), nil
+}
+
+// SetLogLevel sets the global log level or the level of a single module.
+func (h *Handler[ContextT, _, _, _]) SetLogLevel(c ContextT) (any, error) {
+	req, err := utils.BindAndValidate[admintypes.SetLogLevelRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Module == "" {
+		if req.Level == "" {
+			return nil, types.ErrInvalidRequest
+		}
+		err = h.logLevels.SetLevel(req.Level)
+	} else {
+		err = h.logLevels.SetModuleLevel(req.Module, req.Level)
+	}
+	if err != nil {
+		return nil, errors.Join(types.ErrInvalidRequest, err)
+	}
+
+	h.logger.Info(
+		"Log level changed", "module", req.Module, "level", req.Level,
+	)
+	return h.GetLogLevels(c)
+}
@@ -0,0 +1,72 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package admin
+
+import (
+	"maps"
+	"slices"
+	"strconv"
+
+	admintypes "github.com/berachain/beacon-kit/mod/node-api/handlers/admin/types"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/utils"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+)
+
+// GetPayloadIDs returns the contents of the payload ID cache.
+func (h *Handler[ContextT, _, _, _]) GetPayloadIDs(ContextT) (any, error) {
+	cached := h.payloadIDCache.Entries()
+	entries := make([]admintypes.PayloadIDEntry, 0, len(cached))
+	for _, slot := range slices.Sorted(maps.Keys(cached)) {
+		for root, pid := range cached[slot] {
+			entries = append(entries, admintypes.PayloadIDEntry{
+				Slot:      strconv.FormatUint(uint64(slot), 10),
+				StateRoot: bytes.B32(root).String(),
+				PayloadID: bytes.B8(pid).String(),
+			})
+		}
+	}
+	return types.Wrap(entries), nil
+}
+
+// GetOptimisticPayloadBuilds returns whether optimistic payload builds are
+// enabled.
+func (h *Handler[ContextT, _, _, _]) GetOptimisticPayloadBuilds(
+	ContextT,
+) (any, error) {
+	return types.Wrap(admintypes.OptimisticPayloadBuildsResponse{
+		Enabled: h.chainService.OptimisticPayloadBuilds(),
+	}), nil
+}
+
+// SetOptimisticPayloadBuilds enables or disables optimistic payload builds.
+func (h *Handler[ContextT, _, _, _]) SetOptimisticPayloadBuilds(
+	c ContextT,
+) (any, error) {
+	req, err := utils.BindAndValidate[
+		admintypes.SetOptimisticPayloadBuildsRequest,
+	](c, h.Logger())
+	if err != nil {
+		return nil, err
+	}
+	h.chainService.SetOptimisticPayloadBuilds(*req.Enabled)
+	return h.GetOptimisticPayloadBuilds(c)
+}
@@ -0,0 +1,120 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package admin
+
+import (
+	"slices"
+	"strings"
+
+	admintypes "github.com/berachain/beacon-kit/mod/node-api/handlers/admin/types"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/utils"
+)
+
+// GetPruners returns the status of every pruner.
+func (h *Handler[ContextT, _, _, _]) GetPruners(ContextT) (any, error) {
+	statuses := h.dbManager.PrunerStatuses()
+	pruners := make([]admintypes.PrunerStatus, 0, len(statuses))
+	for name, paused := range statuses {
+		pruners = append(pruners, admintypes.PrunerStatus{
+			Name:   name,
+			Paused: paused,
+		})
+	}
+	slices.SortFunc(pruners, func(a, b admintypes.PrunerStatus) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return types.Wrap(pruners), nil
+}
+
+// PausePruner pauses pruning on finalized blocks for the named pruner.
+func (h *Handler[ContextT, _, _, _]) PausePruner(c ContextT) (any, error) {
+	req, err := utils.BindAndValidate[admintypes.PrunerRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err = h.ensurePruner(req.Name); err != nil {
+		return nil, err
+	}
+	if err = h.dbManager.PausePruner(req.Name); err != nil {
+		return nil, err
+	}
+	h.logger.Info("Pruner paused", "name", req.Name)
+	return h.GetPruners(c)
+}
+
+// ResumePruner resumes pruning on finalized blocks for the named pruner.
+func (h *Handler[ContextT, _, _, _]) ResumePruner(c ContextT) (any, error) {
+	req, err := utils.BindAndValidate[admintypes.PrunerRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err = h.ensurePruner(req.Name); err != nil {
+		return nil, err
+	}
+	if err = h.dbManager.ResumePruner(req.Name); err != nil {
+		return nil, err
+	}
+	h.logger.Info("Pruner resumed", "name", req.Name)
+	return h.GetPruners(c)
+}
+
+// TriggerPrune triggers the named pruner to prune the requested range.
+func (h *Handler[ContextT, _, _, _]) TriggerPrune(c ContextT) (any, error) {
+	req, err := utils.BindAndValidate[admintypes.PruneRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err = h.ensurePruner(req.Name); err != nil {
+		return nil, err
+	}
+	start, err := utils.U64FromString(req.Start)
+	if err != nil {
+		return nil, types.ErrInvalidRequest
+	}
+	end, err := utils.U64FromString(req.End)
+	if err != nil {
+		return nil, types.ErrInvalidRequest
+	}
+	if err = h.dbManager.Prune(
+		req.Name, start.Unwrap(), end.Unwrap(),
+	); err != nil {
+		return nil, err
+	}
+	h.logger.Info(
+		"Pruning triggered", "name", req.Name, "start", start, "end", end,
+	)
+	return h.GetPruners(c)
+}
+
+// ensurePruner returns ErrNotFound if no pruner with the given name exists.
+func (h *Handler[_, _, _, _]) ensurePruner(name string) error {
+	if _, ok := h.dbManager.PrunerStatuses()[name]; !ok {
+		return types.ErrNotFound
+	}
+	return nil
+}
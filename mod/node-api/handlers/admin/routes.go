@@ -0,0 +1,90 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package admin
+
+import (
+	"net/http"
+
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers"
+)
+
+func (h *Handler[ContextT, _, _, _]) RegisterRoutes(logger log.Logger) {
+	h.SetLogger(logger)
+	h.BaseHandler.AddRoutes([]*handlers.Route[ContextT]{
+		{
+			Method:  http.MethodGet,
+			Path:    "/bkit/v1/admin/log_level",
+			Handler: h.audited("get_log_levels", h.GetLogLevels),
+		},
+		{
+			Method:  http.MethodPost,
+			Path:    "/bkit/v1/admin/log_level",
+			Handler: h.audited("set_log_level", h.SetLogLevel),
+		},
+		{
+			Method:  http.MethodGet,
+			Path:    "/bkit/v1/admin/pruners",
+			Handler: h.audited("get_pruners", h.GetPruners),
+		},
+		{
+			Method:  http.MethodPost,
+			Path:    "/bkit/v1/admin/pruners/:name/pause",
+			Handler: h.audited("pause_pruner", h.PausePruner),
+		},
+		{
+			Method:  http.MethodPost,
+			Path:    "/bkit/v1/admin/pruners/:name/resume",
+			Handler: h.audited("resume_pruner", h.ResumePruner),
+		},
+		{
+			Method:  http.MethodPost,
+			Path:    "/bkit/v1/admin/pruners/:name/prune",
+			Handler: h.audited("trigger_prune", h.TriggerPrune),
+		},
+		{
+			Method:  http.MethodPost,
+			Path:    "/bkit/v1/admin/deposits/catchup",
+			Handler: h.audited("force_deposit_catchup", h.ForceDepositCatchup),
+		},
+		{
+			Method:  http.MethodGet,
+			Path:    "/bkit/v1/admin/payload_ids",
+			Handler: h.audited("get_payload_ids", h.GetPayloadIDs),
+		},
+		{
+			Method: http.MethodGet,
+			Path:   "/bkit/v1/admin/optimistic_payload_builds",
+			Handler: h.audited(
+				"get_optimistic_payload_builds",
+				h.GetOptimisticPayloadBuilds,
+			),
+		},
+		{
+			Method: http.MethodPost,
+			Path:   "/bkit/v1/admin/optimistic_payload_builds",
+			Handler: h.audited(
+				"set_optimistic_payload_builds",
+				h.SetOptimisticPayloadBuilds,
+			),
+		},
+	})
+}
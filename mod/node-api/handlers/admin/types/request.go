@@ -0,0 +1,52 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+// SetLogLevelRequest sets the global log level, or the level of a single
+// module if Module is set. An empty Level removes a module override.
+type SetLogLevelRequest struct {
+	Module string `json:"module"`
+	Level  string `json:"level"`
+}
+
+// PrunerRequest identifies a pruner by name.
+type PrunerRequest struct {
+	Name string `param:"name" validate:"required"`
+}
+
+// PruneRequest triggers the named pruner to prune [start, end).
+type PruneRequest struct {
+	PrunerRequest
+	Start string `json:"start" validate:"required,numeric"`
+	End   string `json:"end"   validate:"required,numeric"`
+}
+
+// DepositCatchupRequest forces deposits for the given execution block numbers
+// to be (re)fetched, along with all previously failed blocks.
+type DepositCatchupRequest struct {
+	BlockNumbers []string `json:"block_numbers" validate:"dive,numeric"`
+}
+
+// SetOptimisticPayloadBuildsRequest enables or disables optimistic payload
+// builds.
+type SetOptimisticPayloadBuildsRequest struct {
+	Enabled *bool `json:"enabled" validate:"required"`
+}
@@ -27,9 +27,14 @@ import (
 )
 
 // Backend is the interface for backend of the beacon API.
-type Backend[BlockHeaderT, ForkT, ValidatorT any] interface {
+type Backend[
+	BlockHeaderT, BLSToExecutionChangeT, ForkT,
+	ProposerSlashingT, ValidatorT, VoluntaryExitT any,
+] interface {
 	GenesisBackend
 	BlockBackend[BlockHeaderT]
+	PoolBackend[BLSToExecutionChangeT, ProposerSlashingT, VoluntaryExitT]
+	RewardsBackend
 	RandaoBackend
 	StateBackend[ForkT]
 	ValidatorBackend[ValidatorT]
@@ -49,16 +54,35 @@ type HistoricalBackend[ForkT any] interface {
 	StateForkAtSlot(slot math.Slot) (ForkT, error)
 }
 
+type PoolBackend[
+	BLSToExecutionChangeT, ProposerSlashingT, VoluntaryExitT any,
+] interface {
+	SubmitProposerSlashing(slashing ProposerSlashingT) error
+	SubmitVoluntaryExit(exit VoluntaryExitT) error
+	SubmitBLSToExecutionChanges(
+		changes []BLSToExecutionChangeT,
+	) ([]error, error)
+	PoolProposerSlashings() []ProposerSlashingT
+	PoolVoluntaryExits() []VoluntaryExitT
+	PoolBLSToExecutionChanges() []BLSToExecutionChangeT
+}
+
 type RandaoBackend interface {
 	RandaoAtEpoch(slot math.Slot, epoch math.Epoch) (common.Bytes32, error)
 }
 
 type BlockBackend[BeaconBlockHeaderT any] interface {
 	BlockRootAtSlot(slot math.Slot) (common.Root, error)
-	BlockRewardsAtSlot(slot math.Slot) (*types.BlockRewardsData, error)
 	BlockHeaderAtSlot(slot math.Slot) (BeaconBlockHeaderT, error)
 }
 
+type RewardsBackend interface {
+	BlockRewardsAtSlot(slot math.Slot) (*types.BlockRewardsData, error)
+	AttestationRewardsAtEpoch(
+		epoch math.Epoch, ids []string,
+	) (*types.AttestationRewardsData, error)
+}
+
 type StateBackend[ForkT any] interface {
 	StateRootAtSlot(slot math.Slot) (common.Root, error)
 	StateForkAtSlot(slot math.Slot) (ForkT, error)
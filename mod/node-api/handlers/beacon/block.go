@@ -25,7 +25,9 @@ import (
 	"github.com/berachain/beacon-kit/mod/node-api/handlers/utils"
 )
 
-func (h *Handler[_, ContextT, _, _]) GetBlockRewards(c ContextT) (any, error) {
+func (h *Handler[
+	_, _, ContextT, _, _, _, _,
+]) GetBlockRewards(c ContextT) (any, error) {
 	req, err := utils.BindAndValidate[beacontypes.GetBlockRewardsRequest](
 		c, h.Logger(),
 	)
@@ -29,24 +29,42 @@ import (
 // Handler is the handler for the beacon API.
 type Handler[
 	BeaconBlockHeaderT types.BeaconBlockHeader,
+	BLSToExecutionChangeT any,
 	ContextT context.Context,
 	ForkT any,
+	ProposerSlashingT any,
 	ValidatorT any,
+	VoluntaryExitT any,
 ] struct {
 	*handlers.BaseHandler[ContextT]
-	backend Backend[BeaconBlockHeaderT, ForkT, ValidatorT]
+	backend Backend[
+		BeaconBlockHeaderT, BLSToExecutionChangeT, ForkT,
+		ProposerSlashingT, ValidatorT, VoluntaryExitT,
+	]
 }
 
 // NewHandler creates a new handler for the beacon API.
 func NewHandler[
 	BeaconBlockHeaderT types.BeaconBlockHeader,
+	BLSToExecutionChangeT any,
 	ContextT context.Context,
 	ForkT any,
+	ProposerSlashingT any,
 	ValidatorT any,
+	VoluntaryExitT any,
 ](
-	backend Backend[BeaconBlockHeaderT, ForkT, ValidatorT],
-) *Handler[BeaconBlockHeaderT, ContextT, ForkT, ValidatorT] {
-	h := &Handler[BeaconBlockHeaderT, ContextT, ForkT, ValidatorT]{
+	backend Backend[
+		BeaconBlockHeaderT, BLSToExecutionChangeT, ForkT,
+		ProposerSlashingT, ValidatorT, VoluntaryExitT,
+	],
+) *Handler[
+	BeaconBlockHeaderT, BLSToExecutionChangeT, ContextT, ForkT,
+	ProposerSlashingT, ValidatorT, VoluntaryExitT,
+] {
+	h := &Handler[
+		BeaconBlockHeaderT, BLSToExecutionChangeT, ContextT, ForkT,
+		ProposerSlashingT, ValidatorT, VoluntaryExitT,
+	]{
 		BaseHandler: handlers.NewBaseHandler(
 			handlers.NewRouteSet[ContextT](""),
 		),
@@ -0,0 +1,104 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package beacon
+
+import (
+	"fmt"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/utils"
+)
+
+func (h *Handler[
+	_, _, ContextT, _, _, _, _,
+]) GetPoolProposerSlashings(ContextT) (any, error) {
+	return types.Wrap(h.backend.PoolProposerSlashings()), nil
+}
+
+func (h *Handler[
+	_, _, ContextT, _, ProposerSlashingT, _, _,
+]) PostPoolProposerSlashings(c ContextT) (any, error) {
+	slashing, err := utils.BindAndValidate[ProposerSlashingT](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err = h.backend.SubmitProposerSlashing(slashing); err != nil {
+		return nil, errors.Join(types.ErrInvalidRequest, err)
+	}
+	return nil, nil
+}
+
+func (h *Handler[
+	_, _, ContextT, _, _, _, _,
+]) GetPoolVoluntaryExits(ContextT) (any, error) {
+	return types.Wrap(h.backend.PoolVoluntaryExits()), nil
+}
+
+func (h *Handler[
+	_, _, ContextT, _, _, _, VoluntaryExitT,
+]) PostPoolVoluntaryExits(c ContextT) (any, error) {
+	exit, err := utils.BindAndValidate[VoluntaryExitT](c, h.Logger())
+	if err != nil {
+		return nil, err
+	}
+	if err = h.backend.SubmitVoluntaryExit(exit); err != nil {
+		return nil, errors.Join(types.ErrInvalidRequest, err)
+	}
+	return nil, nil
+}
+
+func (h *Handler[
+	_, _, ContextT, _, _, _, _,
+]) GetPoolBLSToExecutionChanges(ContextT) (any, error) {
+	return types.Wrap(h.backend.PoolBLSToExecutionChanges()), nil
+}
+
+// PostPoolBLSToExecutionChanges submits a list of BLS to execution changes.
+// The valid changes are pooled even if others in the list are rejected.
+func (h *Handler[
+	_, BLSToExecutionChangeT, ContextT, _, _, _, _,
+]) PostPoolBLSToExecutionChanges(c ContextT) (any, error) {
+	changes, err := utils.BindAndValidate[[]BLSToExecutionChangeT](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	errs, err := h.backend.SubmitBLSToExecutionChanges(changes)
+	if err != nil {
+		return nil, err
+	}
+	var failures error
+	for i, submitErr := range errs {
+		if submitErr != nil {
+			failures = errors.Join(
+				failures, fmt.Errorf("change at index %d: %w", i, submitErr),
+			)
+		}
+	}
+	if failures != nil {
+		return nil, errors.Join(types.ErrInvalidRequest, failures)
+	}
+	return nil, nil
+}
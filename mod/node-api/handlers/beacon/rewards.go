@@ -0,0 +1,67 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package beacon
+
+import (
+	beacontypes "github.com/berachain/beacon-kit/mod/node-api/handlers/beacon/types"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/utils"
+)
+
+func (h *Handler[_, _, ContextT, _, _, _, _]) PostAttestationsRewards(
+	c ContextT,
+) (any, error) {
+	req, err := utils.BindAndValidate[beacontypes.PostAttestationsRewardsRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	epoch, err := utils.U64FromString(req.Epoch)
+	if err != nil {
+		return nil, err
+	}
+	rewards, err := h.backend.AttestationRewardsAtEpoch(epoch, req.IDs)
+	if err != nil {
+		return nil, err
+	}
+	return beacontypes.ValidatorResponse{
+		ExecutionOptimistic: false, // stubbed
+		Finalized:           false, // stubbed
+		Data:                rewards,
+	}, nil
+}
+
+// PostSyncCommitteeRewards always returns no rewards, since there are no
+// sync committees.
+func (h *Handler[_, _, ContextT, _, _, _, _]) PostSyncCommitteeRewards(
+	c ContextT,
+) (any, error) {
+	_, err := utils.BindAndValidate[beacontypes.PostRewardsSyncCommitteeRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return beacontypes.ValidatorResponse{
+		ExecutionOptimistic: false, // stubbed
+		Finalized:           false, // stubbed
+		Data:                []*beacontypes.SyncCommitteeRewardData{},
+	}, nil
+}
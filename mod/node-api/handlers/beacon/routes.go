@@ -28,7 +28,7 @@ import (
 )
 
 //nolint:funlen // routes are long
-func (h *Handler[_, ContextT, _, _]) RegisterRoutes(
+func (h *Handler[_, _, ContextT, _, _, _, _]) RegisterRoutes(
 	logger log.Logger,
 ) {
 	h.SetLogger(logger)
@@ -146,7 +146,7 @@ func (h *Handler[_, ContextT, _, _]) RegisterRoutes(
 		{
 			Method:  http.MethodPost,
 			Path:    "/eth/v1/beacon/rewards/sync_committee/:block_id",
-			Handler: h.NotImplemented,
+			Handler: h.PostSyncCommitteeRewards,
 		},
 		{
 			Method:  http.MethodGet,
@@ -155,8 +155,13 @@ func (h *Handler[_, ContextT, _, _]) RegisterRoutes(
 		},
 		{
 			Method:  http.MethodPost,
-			Path:    "/eth/v1/beacon/rewards/attestation/:epoch",
-			Handler: h.NotImplemented,
+			Path:    "/eth/v1/beacon/rewards/attestations/:epoch",
+			Handler: h.PostAttestationsRewards,
+		},
+		{
+			Method:  http.MethodGet,
+			Path:    "/eth/v1/beacon/rewards/blocks/:block_id",
+			Handler: h.GetBlockRewards,
 		},
 		{
 			Method:  http.MethodGet,
@@ -206,12 +211,12 @@ func (h *Handler[_, ContextT, _, _]) RegisterRoutes(
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/beacon/pool/proposer_slashings",
-			Handler: h.NotImplemented,
+			Handler: h.GetPoolProposerSlashings,
 		},
 		{
 			Method:  http.MethodPost,
 			Path:    "/eth/v1/beacon/pool/proposer_slashings",
-			Handler: h.NotImplemented,
+			Handler: h.PostPoolProposerSlashings,
 		},
 		{
 			Method:  http.MethodPost,
@@ -221,22 +226,22 @@ func (h *Handler[_, ContextT, _, _]) RegisterRoutes(
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/beacon/pool/voluntary_exits",
-			Handler: h.NotImplemented,
+			Handler: h.GetPoolVoluntaryExits,
 		},
 		{
 			Method:  http.MethodPost,
 			Path:    "/eth/v1/beacon/pool/voluntary_exits",
-			Handler: h.NotImplemented,
+			Handler: h.PostPoolVoluntaryExits,
 		},
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/beacon/pool/bls_to_execution_changes",
-			Handler: h.NotImplemented,
+			Handler: h.GetPoolBLSToExecutionChanges,
 		},
 		{
 			Method:  http.MethodPost,
 			Path:    "/eth/v1/beacon/pool/bls_to_execution_changes",
-			Handler: h.NotImplemented,
+			Handler: h.PostPoolBLSToExecutionChanges,
 		},
 	})
 }
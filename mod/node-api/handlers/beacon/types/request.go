@@ -20,7 +20,10 @@
 
 package types
 
-import "github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+import (
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/json"
+)
 
 type GetGenesisRequest struct{}
 
@@ -136,6 +139,12 @@ type PostRewardsSyncCommitteeRequest struct {
 	IDs []string `validate:"dive,validator_id"`
 }
 
+// UnmarshalJSON decodes the validator IDs, which are sent as a bare JSON
+// array in the request body.
+func (r *PostRewardsSyncCommitteeRequest) UnmarshalJSON(data []byte) error {
+	return json.Unmarshal(data, &r.IDs)
+}
+
 type GetDepositTreeSnapshotRequest struct{}
 
 type GetBlockRewardsRequest struct {
@@ -147,6 +156,12 @@ type PostAttestationsRewardsRequest struct {
 	IDs []string `validate:"dive,validator_id"`
 }
 
+// UnmarshalJSON decodes the validator IDs, which are sent as a bare JSON
+// array in the request body.
+func (r *PostAttestationsRewardsRequest) UnmarshalJSON(data []byte) error {
+	return json.Unmarshal(data, &r.IDs)
+}
+
 type GetBlindedBlockRequest struct {
 	types.BlockIDRequest
 }
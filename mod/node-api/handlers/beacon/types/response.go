@@ -57,6 +57,20 @@ type RootData struct {
 	Root common.Root `json:"root"`
 }
 
+// Validator statuses as defined in the Beacon Node API specification.
+// https://hackmd.io/ofFJ5gOmQpu1jjHilHbdQQ
+const (
+	ValidatorStatusPendingInitialized = "pending_initialized"
+	ValidatorStatusPendingQueued      = "pending_queued"
+	ValidatorStatusActiveOngoing      = "active_ongoing"
+	ValidatorStatusActiveExiting      = "active_exiting"
+	ValidatorStatusActiveSlashed      = "active_slashed"
+	ValidatorStatusExitedUnslashed    = "exited_unslashed"
+	ValidatorStatusExitedSlashed      = "exited_slashed"
+	ValidatorStatusWithdrawalPossible = "withdrawal_possible"
+	ValidatorStatusWithdrawalDone     = "withdrawal_done"
+)
+
 type ValidatorData[ValidatorT any] struct {
 	ValidatorBalanceData
 	Status    string     `json:"status"`
@@ -83,3 +97,36 @@ type BlockRewardsData struct {
 	ProposerSlashings uint64 `json:"proposer_slashings,string"`
 	AttesterSlashings uint64 `json:"attester_slashings,string"`
 }
+
+// AttestationRewardsData is the breakdown of the rewards of an epoch. Beacon
+// kit has no attestations, so the head component carries the net reward for
+// the CometBFT commits a validator signed or missed, the inclusion delay
+// component carries the proposer reward and the source and target components
+// are always zero.
+type AttestationRewardsData struct {
+	IdealRewards []*IdealAttestationReward `json:"ideal_rewards"`
+	TotalRewards []*AttestationReward      `json:"total_rewards"`
+}
+
+type IdealAttestationReward struct {
+	EffectiveBalance uint64 `json:"effective_balance,string"`
+	Head             int64  `json:"head,string"`
+	Target           int64  `json:"target,string"`
+	Source           int64  `json:"source,string"`
+	InclusionDelay   uint64 `json:"inclusion_delay,string"`
+	Inactivity       int64  `json:"inactivity,string"`
+}
+
+type AttestationReward struct {
+	ValidatorIndex uint64 `json:"validator_index,string"`
+	Head           int64  `json:"head,string"`
+	Target         int64  `json:"target,string"`
+	Source         int64  `json:"source,string"`
+	InclusionDelay uint64 `json:"inclusion_delay,string"`
+	Inactivity     int64  `json:"inactivity,string"`
+}
+
+type SyncCommitteeRewardData struct {
+	ValidatorIndex uint64 `json:"validator_index,string"`
+	Reward         int64  `json:"reward,string"`
+}
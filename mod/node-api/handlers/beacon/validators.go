@@ -26,7 +26,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/node-api/handlers/utils"
 )
 
-func (h *Handler[_, ContextT, _, _]) GetStateValidators(
+func (h *Handler[_, _, ContextT, _, _, _, _]) GetStateValidators(
 	c ContextT,
 ) (any, error) {
 	req, err := utils.BindAndValidate[beacontypes.GetStateValidatorsRequest](
@@ -35,10 +35,6 @@ func (h *Handler[_, ContextT, _, _]) GetStateValidators(
 	if err != nil {
 		return nil, err
 	}
-	// TODO: remove this once status filter is implemented.
-	if len(req.Statuses) > 0 {
-		return nil, types.ErrNotImplemented
-	}
 	slot, err := utils.SlotFromStateID(req.StateID, h.backend)
 	if err != nil {
 		return nil, err
@@ -61,7 +57,7 @@ func (h *Handler[_, ContextT, _, _]) GetStateValidators(
 	}, nil
 }
 
-func (h *Handler[_, ContextT, _, _]) PostStateValidators(
+func (h *Handler[_, _, ContextT, _, _, _, _]) PostStateValidators(
 	c ContextT,
 ) (any, error) {
 	req, err := utils.BindAndValidate[beacontypes.PostStateValidatorsRequest](
@@ -70,10 +66,6 @@ func (h *Handler[_, ContextT, _, _]) PostStateValidators(
 	if err != nil {
 		return nil, err
 	}
-	// TODO: remove this once status filter is implemented.
-	if len(req.Statuses) > 0 {
-		return nil, types.ErrNotImplemented
-	}
 	slot, err := utils.SlotFromStateID(req.StateID, h.backend)
 	if err != nil {
 		return nil, err
@@ -93,7 +85,7 @@ func (h *Handler[_, ContextT, _, _]) PostStateValidators(
 	}, nil
 }
 
-func (h *Handler[_, ContextT, _, _]) GetStateValidator(
+func (h *Handler[_, _, ContextT, _, _, _, _]) GetStateValidator(
 	c ContextT,
 ) (any, error) {
 	req, err := utils.BindAndValidate[beacontypes.GetStateValidatorRequest](
@@ -116,7 +108,7 @@ func (h *Handler[_, ContextT, _, _]) GetStateValidator(
 	return validator, nil
 }
 
-func (h *Handler[_, ContextT, _, _]) GetStateValidatorBalances(
+func (h *Handler[_, _, ContextT, _, _, _, _]) GetStateValidatorBalances(
 	c ContextT,
 ) (any, error) {
 	req, err := utils.BindAndValidate[beacontypes.GetValidatorBalancesRequest](
@@ -143,7 +135,7 @@ func (h *Handler[_, ContextT, _, _]) GetStateValidatorBalances(
 	}, nil
 }
 
-func (h *Handler[_, ContextT, _, _]) PostStateValidatorBalances(
+func (h *Handler[_, _, ContextT, _, _, _, _]) PostStateValidatorBalances(
 	c ContextT,
 ) (any, error) {
 	req, err := utils.BindAndValidate[beacontypes.PostValidatorBalancesRequest](
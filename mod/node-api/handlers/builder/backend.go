@@ -0,0 +1,44 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package builder
+
+import (
+	buildertypes "github.com/berachain/beacon-kit/mod/node-api/handlers/builder/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// Backend is the interface for backend of the builder API.
+type Backend interface {
+	// ExpectedWithdrawals returns the withdrawals the payload of the block
+	// proposed at proposalSlot on top of the state at slot must contain.
+	ExpectedWithdrawals(
+		slot, proposalSlot math.Slot,
+	) ([]*buildertypes.WithdrawalData, error)
+	// WithdrawalSweep simulates the withdrawal sweep over the payloads of
+	// the given number of slots following the state at slot, and finds the
+	// next withdrawal of the validators with the given IDs within them.
+	WithdrawalSweep(
+		slot math.Slot, payloads uint64, ids []string,
+	) (*buildertypes.WithdrawalSweepData, error)
+	// GetSlotByStateRoot retrieves the slot by a given state root.
+	GetSlotByStateRoot(root common.Root) (math.Slot, error)
+}
@@ -35,7 +35,12 @@ func (h *Handler[ContextT]) RegisterRoutes(
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/builder/states/:state_id/expected_withdrawals",
-			Handler: h.NotImplemented,
+			Handler: h.GetExpectedWithdrawals,
+		},
+		{
+			Method:  http.MethodGet,
+			Path:    "/bkit/v1/builder/states/:state_id/withdrawal_sweep",
+			Handler: h.GetWithdrawalSweep,
 		},
 	})
 }
@@ -0,0 +1,64 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import "github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+
+// Types of the next withdrawal of a validator.
+const (
+	WithdrawalTypeFull    = "full"
+	WithdrawalTypePartial = "partial"
+)
+
+type ExpectedWithdrawalsResponse struct {
+	ExecutionOptimistic bool              `json:"execution_optimistic"`
+	Finalized           bool              `json:"finalized"`
+	Data                []*WithdrawalData `json:"data"`
+}
+
+type WithdrawalData struct {
+	Index          uint64                  `json:"index,string"`
+	ValidatorIndex uint64                  `json:"validator_index,string"`
+	Address        common.ExecutionAddress `json:"address"`
+	Amount         uint64                  `json:"amount,string"`
+}
+
+// WithdrawalSweepData is the withdrawal sweep simulated over the payloads
+// of the following slots, along with the next withdrawal of the requested
+// validators within them.
+type WithdrawalSweepData struct {
+	Payloads        []*PayloadWithdrawalsData `json:"payloads"`
+	NextWithdrawals []*NextWithdrawalData     `json:"next_withdrawals"`
+}
+
+type PayloadWithdrawalsData struct {
+	Slot        uint64            `json:"slot,string"`
+	Withdrawals []*WithdrawalData `json:"withdrawals"`
+}
+
+// NextWithdrawalData is the next withdrawal of a validator. The slot, type
+// and amount are omitted if it is not swept within the simulated payloads.
+type NextWithdrawalData struct {
+	ValidatorIndex uint64 `json:"validator_index,string"`
+	Slot           uint64 `json:"slot,string,omitempty"`
+	Type           string `json:"type,omitempty"`
+	Amount         uint64 `json:"amount,string,omitempty"`
+}
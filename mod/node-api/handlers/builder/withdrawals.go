@@ -0,0 +1,86 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package builder
+
+import (
+	buildertypes "github.com/berachain/beacon-kit/mod/node-api/handlers/builder/types"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/utils"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// defaultSweepPayloads is the number of payloads the withdrawal sweep is
+// simulated over when none is requested.
+const defaultSweepPayloads = 1
+
+func (h *Handler[ContextT]) GetExpectedWithdrawals(c ContextT) (any, error) {
+	req, err := utils.BindAndValidate[buildertypes.GetExpectedWithdrawalsRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	slot, err := utils.SlotFromStateID(req.StateID, h.backend)
+	if err != nil {
+		return nil, err
+	}
+	var proposalSlot math.Slot
+	if req.ProposalSlot != "" {
+		if proposalSlot, err = utils.U64FromString(
+			req.ProposalSlot,
+		); err != nil {
+			return nil, err
+		}
+	}
+	withdrawals, err := h.backend.ExpectedWithdrawals(slot, proposalSlot)
+	if err != nil {
+		return nil, err
+	}
+	return buildertypes.ExpectedWithdrawalsResponse{
+		ExecutionOptimistic: false, // stubbed
+		Finalized:           false, // stubbed
+		Data:                withdrawals,
+	}, nil
+}
+
+func (h *Handler[ContextT]) GetWithdrawalSweep(c ContextT) (any, error) {
+	req, err := utils.BindAndValidate[buildertypes.GetWithdrawalSweepRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	slot, err := utils.SlotFromStateID(req.StateID, h.backend)
+	if err != nil {
+		return nil, err
+	}
+	payloads := math.U64(defaultSweepPayloads)
+	if req.Payloads != "" {
+		if payloads, err = utils.U64FromString(req.Payloads); err != nil {
+			return nil, err
+		}
+	}
+	sweep, err := h.backend.WithdrawalSweep(slot, payloads.Unwrap(), req.IDs)
+	if err != nil {
+		return nil, err
+	}
+	return types.Wrap(sweep), nil
+}
@@ -0,0 +1,110 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package config_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/chain-spec/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/node-api/engines/echo"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/config"
+	configtypes "github.com/berachain/beacon-kit/mod/node-api/handlers/config/types"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+type backend struct {
+	cs common.ChainSpec
+}
+
+func (b backend) ChainSpec() common.ChainSpec { return b.cs }
+
+func newTestHandler(
+	denebPlus, electra math.Epoch,
+) *config.Handler[echo.Context] {
+	return config.NewHandler[echo.Context](backend{
+		cs: chain.NewChainSpec(chain.SpecData[
+			common.DomainType, math.Epoch, common.ExecutionAddress,
+			math.Slot, any,
+		]{
+			SlotsPerEpoch:          32,
+			DomainTypeDeposit:      common.DomainType{0x03},
+			DepositContractAddress: common.ExecutionAddress{0x42},
+			DepositEth1ChainID:     80084,
+			DenebPlusForkEpoch:     denebPlus,
+			ElectraForkEpoch:       electra,
+		}),
+	})
+}
+
+func TestGetSpec(t *testing.T) {
+	res, err := newTestHandler(10, 20).GetSpec(nil)
+	require.NoError(t, err)
+	spec, ok := res.(types.DataResponse).Data.(map[string]string)
+	require.True(t, ok)
+	require.Equal(t, "32", spec["SLOTS_PER_EPOCH"])
+	require.Equal(t, "0x03000000", spec["DOMAIN_DEPOSIT"])
+	require.Equal(t, "80084", spec["DEPOSIT_CHAIN_ID"])
+	require.Equal(t, "0x04000000", spec["GENESIS_FORK_VERSION"])
+	require.Equal(t, "10", spec["DENEB_PLUS_FORK_EPOCH"])
+	require.Equal(t, "0x06000000", spec["ELECTRA_FORK_VERSION"])
+}
+
+func TestGetForkSchedule(t *testing.T) {
+	res, err := newTestHandler(10, 20).GetForkSchedule(nil)
+	require.NoError(t, err)
+	require.Equal(t, types.Wrap([]*configtypes.ForkData{
+		{
+			PreviousVersion: common.Version{0x04},
+			CurrentVersion:  common.Version{0x04},
+			Epoch:           0,
+		},
+		{
+			PreviousVersion: common.Version{0x04},
+			CurrentVersion:  common.Version{0x05},
+			Epoch:           10,
+		},
+		{
+			PreviousVersion: common.Version{0x05},
+			CurrentVersion:  common.Version{0x06},
+			Epoch:           20,
+		},
+	}), res)
+
+	// Forks that activate together only list the later one.
+	res, err = newTestHandler(20, 20).GetForkSchedule(nil)
+	require.NoError(t, err)
+	forks, ok := res.(types.DataResponse).Data.([]*configtypes.ForkData)
+	require.True(t, ok)
+	require.Len(t, forks, 2)
+	require.Equal(t, common.Version{0x06}, forks[1].CurrentVersion)
+}
+
+func TestGetDepositContract(t *testing.T) {
+	res, err := newTestHandler(10, 20).GetDepositContract(nil)
+	require.NoError(t, err)
+	require.Equal(t, types.Wrap(configtypes.DepositContractData{
+		ChainID: 80084,
+		Address: common.ExecutionAddress{0x42},
+	}), res)
+}
@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package config
+
+import (
+	"slices"
+
+	configtypes "github.com/berachain/beacon-kit/mod/node-api/handlers/config/types"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+)
+
+// GetForkSchedule returns the forks of the chain, including the ones
+// scheduled in the future, in the order they activate.
+func (h *Handler[ContextT]) GetForkSchedule(ContextT) (any, error) {
+	return types.Wrap(forkSchedule(h.backend.ChainSpec())), nil
+}
+
+// forkSchedule derives the forks from the epochs the chain spec activates
+// them at, skipping those that do not change the active fork version.
+func forkSchedule(cs common.ChainSpec) []*configtypes.ForkData {
+	epochs := []math.Epoch{0, cs.DenebPlusForkEpoch(), cs.ElectraForkEpoch()}
+	slices.Sort(epochs)
+
+	forks := make([]*configtypes.ForkData, 0, len(epochs))
+	previous := cs.ActiveForkVersionForEpoch(0)
+	for i, epoch := range epochs {
+		current := cs.ActiveForkVersionForEpoch(epoch)
+		if i > 0 && current == previous {
+			continue
+		}
+		forks = append(forks, &configtypes.ForkData{
+			PreviousVersion: version.FromUint32[common.Version](previous),
+			CurrentVersion:  version.FromUint32[common.Version](current),
+			Epoch:           epoch.Unwrap(),
+		})
+		previous = current
+	}
+	return forks
+}
@@ -0,0 +1,148 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package config
+
+import (
+	"strconv"
+
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+)
+
+// GetSpec returns the values of the chain spec, keyed by the names of their
+// Ethereum counterparts. Values without one, such as the Deneb+ fork and the
+// validator set cap, are keyed in the same style.
+func (h *Handler[ContextT]) GetSpec(ContextT) (any, error) {
+	return types.Wrap(specValues(h.backend.ChainSpec())), nil
+}
+
+// specValues returns the values of the chain spec as strings, as the Beacon
+// Node API serves them.
+//
+//nolint:funlen // one line per value.
+func specValues(cs common.ChainSpec) map[string]string {
+	u := func(value uint64) string {
+		return strconv.FormatUint(value, 10)
+	}
+	forkVersion := func(fork uint32) string {
+		return version.FromUint32[common.Version](fork).String()
+	}
+
+	return map[string]string{
+		// Gwei values.
+		"MIN_DEPOSIT_AMOUNT":          u(cs.MinDepositAmount()),
+		"MAX_EFFECTIVE_BALANCE":       u(cs.MaxEffectiveBalance()),
+		"EJECTION_BALANCE":            u(cs.EjectionBalance()),
+		"EFFECTIVE_BALANCE_INCREMENT": u(cs.EffectiveBalanceIncrement()),
+		"HYSTERESIS_QUOTIENT":         u(cs.HysteresisQuotient()),
+		"HYSTERESIS_DOWNWARD_MULTIPLIER": u(
+			cs.HysteresisDownwardMultiplier(),
+		),
+		"HYSTERESIS_UPWARD_MULTIPLIER": u(cs.HysteresisUpwardMultiplier()),
+
+		// Time parameters.
+		"SLOTS_PER_EPOCH":           u(cs.SlotsPerEpoch()),
+		"SLOTS_PER_HISTORICAL_ROOT": u(cs.SlotsPerHistoricalRoot()),
+		"MIN_EPOCHS_TO_INACTIVITY_PENALTY": u(
+			cs.MinEpochsToInactivityPenalty(),
+		),
+		"MIN_VALIDATOR_WITHDRAWABILITY_DELAY": u(
+			cs.MinValidatorWithdrawabilityDelay(),
+		),
+		"SHARD_COMMITTEE_PERIOD": u(cs.ShardCommitteePeriod()),
+
+		// Signature domains.
+		"DOMAIN_BEACON_PROPOSER":  cs.DomainTypeProposer().String(),
+		"DOMAIN_BEACON_ATTESTER":  cs.DomainTypeAttester().String(),
+		"DOMAIN_RANDAO":           cs.DomainTypeRandao().String(),
+		"DOMAIN_DEPOSIT":          cs.DomainTypeDeposit().String(),
+		"DOMAIN_VOLUNTARY_EXIT":   cs.DomainTypeVoluntaryExit().String(),
+		"DOMAIN_SELECTION_PROOF":  cs.DomainTypeSelectionProof().String(),
+		"DOMAIN_APPLICATION_MASK": cs.DomainTypeApplicationMask().String(),
+		"DOMAIN_AGGREGATE_AND_PROOF": cs.DomainTypeAggregateAndProof().
+			String(),
+		"DOMAIN_BLS_TO_EXECUTION_CHANGE": cs.DomainTypeBLSToExecutionChange().
+			String(),
+
+		// Eth1 values.
+		"DEPOSIT_CONTRACT_ADDRESS": cs.DepositContractAddress().Hex(),
+		"DEPOSIT_CHAIN_ID":         u(cs.DepositEth1ChainID()),
+		"DEPOSIT_NETWORK_ID":       u(cs.DepositEth1ChainID()),
+		"ETH1_FOLLOW_DISTANCE":     u(cs.Eth1FollowDistance()),
+		"SECONDS_PER_ETH1_BLOCK":   u(cs.TargetSecondsPerEth1Block()),
+
+		// Max operations per block.
+		"MAX_DEPOSITS":           u(cs.MaxDepositsPerBlock()),
+		"MAX_PROPOSER_SLASHINGS": u(cs.MaxProposerSlashingsPerBlock()),
+		"MAX_VOLUNTARY_EXITS":    u(cs.MaxVoluntaryExitsPerBlock()),
+		"MAX_BLS_TO_EXECUTION_CHANGES": u(
+			cs.MaxBlsToExecutionChangesPerBlock(),
+		),
+
+		// Forks.
+		"GENESIS_FORK_VERSION":    forkVersion(cs.ActiveForkVersionForEpoch(0)),
+		"DENEB_FORK_VERSION":      forkVersion(version.Deneb),
+		"DENEB_FORK_EPOCH":        "0",
+		"DENEB_PLUS_FORK_VERSION": forkVersion(version.DenebPlus),
+		"DENEB_PLUS_FORK_EPOCH":   u(cs.DenebPlusForkEpoch().Unwrap()),
+		"ELECTRA_FORK_VERSION":    forkVersion(version.Electra),
+		"ELECTRA_FORK_EPOCH":      u(cs.ElectraForkEpoch().Unwrap()),
+
+		// State list lengths.
+		"EPOCHS_PER_HISTORICAL_VECTOR": u(cs.EpochsPerHistoricalVector()),
+		"EPOCHS_PER_SLASHINGS_VECTOR":  u(cs.EpochsPerSlashingsVector()),
+		"HISTORICAL_ROOTS_LIMIT":       u(cs.HistoricalRootsLimit()),
+		"VALIDATOR_REGISTRY_LIMIT":     u(cs.ValidatorRegistryLimit()),
+		"VALIDATOR_SET_CAP":            u(cs.ValidatorSetCap()),
+
+		// Validator cycle, in Gwei as in Electra.
+		"MIN_PER_EPOCH_CHURN_LIMIT_ELECTRA": u(cs.MinPerEpochChurnLimit()),
+		"MAX_PER_EPOCH_ACTIVATION_EXIT_CHURN_LIMIT": u(
+			cs.MaxPerEpochActivationChurnLimit(),
+		),
+		"CHURN_LIMIT_QUOTIENT": u(cs.ChurnLimitQuotient()),
+
+		// Rewards and penalties.
+		"BASE_REWARD_FACTOR":          u(cs.BaseRewardFactor()),
+		"PROPOSER_REWARD_QUOTIENT":    u(cs.ProposerRewardQuotient()),
+		"INACTIVITY_PENALTY_QUOTIENT": u(cs.InactivityPenaltyQuotient()),
+		"PROPORTIONAL_SLASHING_MULTIPLIER": u(
+			cs.ProportionalSlashingMultiplier(),
+		),
+		"MIN_SLASHING_PENALTY_QUOTIENT": u(cs.MinSlashingPenaltyQuotient()),
+
+		// Capella values.
+		"MAX_WITHDRAWALS_PER_PAYLOAD": u(cs.MaxWithdrawalsPerPayload()),
+		"MAX_VALIDATORS_PER_WITHDRAWALS_SWEEP": u(
+			cs.MaxValidatorsPerWithdrawalsSweep(),
+		),
+
+		// Deneb values.
+		"MIN_EPOCHS_FOR_BLOB_SIDECARS_REQUESTS": u(
+			cs.MinEpochsForBlobsSidecarsRequest(),
+		),
+		"MAX_BLOB_COMMITMENTS_PER_BLOCK": u(cs.MaxBlobCommitmentsPerBlock()),
+		"MAX_BLOBS_PER_BLOCK":            u(cs.MaxBlobsPerBlock()),
+		"FIELD_ELEMENTS_PER_BLOB":        u(cs.FieldElementsPerBlob()),
+		"BYTES_PER_BLOB":                 u(cs.BytesPerBlob()),
+	}
+}
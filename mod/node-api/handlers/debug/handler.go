@@ -25,15 +25,29 @@ import (
 	"github.com/berachain/beacon-kit/mod/node-api/server/context"
 )
 
-type Handler[ContextT context.Context] struct {
+// Handler is the handler for the debug API.
+type Handler[
+	BeaconStateT BeaconState[BeaconStateMarshallableT],
+	BeaconStateMarshallableT any,
+	ContextT context.Context,
+] struct {
 	*handlers.BaseHandler[ContextT]
+	backend Backend[BeaconStateT]
 }
 
-func NewHandler[ContextT context.Context]() *Handler[ContextT] {
-	h := &Handler[ContextT]{
+// NewHandler creates a new handler for the debug API.
+func NewHandler[
+	BeaconStateT BeaconState[BeaconStateMarshallableT],
+	BeaconStateMarshallableT any,
+	ContextT context.Context,
+](
+	backend Backend[BeaconStateT],
+) *Handler[BeaconStateT, BeaconStateMarshallableT, ContextT] {
+	h := &Handler[BeaconStateT, BeaconStateMarshallableT, ContextT]{
 		BaseHandler: handlers.NewBaseHandler(
 			handlers.NewRouteSet[ContextT](""),
 		),
+		backend: backend,
 	}
 	return h
 }
@@ -0,0 +1,59 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package debug
+
+import (
+	debugtypes "github.com/berachain/beacon-kit/mod/node-api/handlers/debug/types"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/utils"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+)
+
+// GetState returns the full beacon state for the given state ID. The state
+// is served as SSZ to clients requesting application/octet-stream.
+func (h *Handler[_, _, ContextT]) GetState(c ContextT) (any, error) {
+	req, err := utils.BindAndValidate[debugtypes.GetStateRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	slot, err := utils.SlotFromStateID(req.StateID, h.backend)
+	if err != nil {
+		return nil, err
+	}
+	st, slot, err := h.backend.StateFromSlotForProof(slot)
+	if err != nil {
+		return nil, err
+	}
+	data, err := st.GetMarshallable()
+	if err != nil {
+		return nil, err
+	}
+	return types.VersionedResponse{
+		Version: version.Name(
+			h.backend.ChainSpec().ActiveForkVersionForSlot(slot),
+		),
+		ExecutionOptimistic: false, // stubbed
+		Finalized:           false, // stubbed
+		Data:                data,
+	}, nil
+}
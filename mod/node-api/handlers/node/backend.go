@@ -0,0 +1,42 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package node
+
+import (
+	"context"
+
+	nodetypes "github.com/berachain/beacon-kit/mod/node-api/handlers/node/types"
+)
+
+// Backend is the interface for backend of the node API.
+type Backend interface {
+	// NodeIdentity returns the identity of the node.
+	NodeIdentity() (*nodetypes.IdentityData, error)
+	// Peers returns the peers of the node in any of the given states and
+	// directions, or all of them if none are given.
+	Peers(states, directions []string) ([]*nodetypes.PeerData, error)
+	// PeerByID returns the peer of the node with the given ID.
+	PeerByID(id string) (*nodetypes.PeerData, error)
+	// PeerCount returns the number of peers of the node in each state.
+	PeerCount() (*nodetypes.PeerCountData, error)
+	// Syncing returns the sync status of the node.
+	Syncing(ctx context.Context) (*nodetypes.SyncingData, error)
+}
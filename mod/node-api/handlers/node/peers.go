@@ -0,0 +1,74 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package node
+
+import (
+	nodetypes "github.com/berachain/beacon-kit/mod/node-api/handlers/node/types"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/utils"
+)
+
+func (h *Handler[ContextT]) GetIdentity(ContextT) (any, error) {
+	identity, err := h.backend.NodeIdentity()
+	if err != nil {
+		return nil, err
+	}
+	return types.Wrap(identity), nil
+}
+
+func (h *Handler[ContextT]) GetPeers(c ContextT) (any, error) {
+	req, err := utils.BindAndValidate[nodetypes.GetPeersRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	peers, err := h.backend.Peers(req.States, req.Directions)
+	if err != nil {
+		return nil, err
+	}
+	return nodetypes.PeersResponse{
+		Data: peers,
+		Meta: nodetypes.PeersMeta{Count: len(peers)},
+	}, nil
+}
+
+func (h *Handler[ContextT]) GetPeer(c ContextT) (any, error) {
+	req, err := utils.BindAndValidate[nodetypes.GetPeerRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	peer, err := h.backend.PeerByID(req.PeerID)
+	if err != nil {
+		return nil, err
+	}
+	return types.Wrap(peer), nil
+}
+
+func (h *Handler[ContextT]) GetPeerCount(ContextT) (any, error) {
+	count, err := h.backend.PeerCount()
+	if err != nil {
+		return nil, err
+	}
+	return types.Wrap(count), nil
+}
@@ -35,22 +35,22 @@ func (h *Handler[ContextT]) RegisterRoutes(
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/node/identity",
-			Handler: h.NotImplemented,
+			Handler: h.GetIdentity,
 		},
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/node/peers",
-			Handler: h.NotImplemented,
+			Handler: h.GetPeers,
 		},
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/node/peers/:peer_id",
-			Handler: h.NotImplemented,
+			Handler: h.GetPeer,
 		},
 		{
 			Method:  http.MethodGet,
-			Path:    "/eth/v1/node/peers/peer_count",
-			Handler: h.NotImplemented,
+			Path:    "/eth/v1/node/peer_count",
+			Handler: h.GetPeerCount,
 		},
 		{
 			Method:  http.MethodGet,
@@ -60,7 +60,7 @@ func (h *Handler[ContextT]) RegisterRoutes(
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/node/syncing",
-			Handler: h.Syncing,
+			Handler: h.GetSyncing,
 		},
 		{
 			Method:  http.MethodGet,
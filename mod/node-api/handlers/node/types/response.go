@@ -0,0 +1,86 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+// States of a peer.
+const (
+	PeerStateDisconnected  = "disconnected"
+	PeerStateConnecting    = "connecting"
+	PeerStateConnected     = "connected"
+	PeerStateDisconnecting = "disconnecting"
+)
+
+// Directions of the connection to a peer.
+const (
+	PeerDirectionInbound  = "inbound"
+	PeerDirectionOutbound = "outbound"
+)
+
+// IdentityData is the identity of the node. The addresses are CometBFT
+// addresses of the form <node_id>@<host>:<port>, as the node has no ENR or
+// libp2p multiaddress.
+type IdentityData struct {
+	PeerID             string        `json:"peer_id"`
+	ENR                string        `json:"enr"`
+	P2PAddresses       []string      `json:"p2p_addresses"`
+	DiscoveryAddresses []string      `json:"discovery_addresses"`
+	Metadata           *MetadataData `json:"metadata"`
+}
+
+// MetadataData is the p2p metadata of the node. The node subscribes to no
+// attestation or sync committee subnets, which are empty.
+type MetadataData struct {
+	SeqNumber uint64 `json:"seq_number,string"`
+	Attnets   string `json:"attnets"`
+	Syncnets  string `json:"syncnets"`
+}
+
+type PeerData struct {
+	PeerID             string  `json:"peer_id"`
+	ENR                *string `json:"enr"`
+	LastSeenP2PAddress string  `json:"last_seen_p2p_address"`
+	State              string  `json:"state"`
+	Direction          string  `json:"direction"`
+}
+
+type PeersResponse struct {
+	Data []*PeerData `json:"data"`
+	Meta PeersMeta   `json:"meta"`
+}
+
+type PeersMeta struct {
+	Count int `json:"count"`
+}
+
+type PeerCountData struct {
+	Disconnected  uint64 `json:"disconnected,string"`
+	Connecting    uint64 `json:"connecting,string"`
+	Connected     uint64 `json:"connected,string"`
+	Disconnecting uint64 `json:"disconnecting,string"`
+}
+
+type SyncingData struct {
+	HeadSlot     uint64 `json:"head_slot,string"`
+	SyncDistance uint64 `json:"sync_distance,string"`
+	IsSyncing    bool   `json:"is_syncing"`
+	IsOptimistic bool   `json:"is_optimistic"`
+	ELOffline    bool   `json:"el_offline"`
+}
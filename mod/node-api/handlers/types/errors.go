@@ -23,7 +23,10 @@ package types
 import "errors"
 
 var (
-	ErrNotFound       = errors.New("not found")
-	ErrNotImplemented = errors.New("not implemented")
-	ErrInvalidRequest = errors.New("invalid request")
+	ErrNotFound        = errors.New("not found")
+	ErrNotImplemented  = errors.New("not implemented")
+	ErrInvalidRequest  = errors.New("invalid request")
+	ErrUnauthorized    = errors.New("unauthorized")
+	ErrNotAcceptable   = errors.New("not acceptable")
+	ErrTooManyRequests = errors.New("too many requests")
 )
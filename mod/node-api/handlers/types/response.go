@@ -29,3 +29,27 @@ func Wrap(data any) DataResponse {
 		Data: data,
 	}
 }
+
+// VersionedResponse is a response whose data depends on the consensus fork
+// version, which is reported in the Eth-Consensus-Version header.
+type VersionedResponse struct {
+	Version             string `json:"version"`
+	ExecutionOptimistic bool   `json:"execution_optimistic"`
+	Finalized           bool   `json:"finalized"`
+	Data                any    `json:"data"`
+}
+
+// ConsensusVersion returns the name of the fork of the response data.
+func (r VersionedResponse) ConsensusVersion() string {
+	return r.Version
+}
+
+// MarshalSSZ returns the SSZ encoding of the response data, which is what is
+// served to clients requesting application/octet-stream.
+func (r VersionedResponse) MarshalSSZ() ([]byte, error) {
+	data, ok := r.Data.(interface{ MarshalSSZ() ([]byte, error) })
+	if !ok {
+		return nil, ErrNotAcceptable
+	}
+	return data.MarshalSSZ()
+}
@@ -0,0 +1,48 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validator
+
+import (
+	"context"
+
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/validator/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// Backend is the interface for backend of the validator API.
+type Backend interface {
+	// ProposerDutiesAtEpoch returns the proposer of every slot of the given
+	// epoch, along with the root of the block the duties depend on.
+	ProposerDutiesAtEpoch(
+		epoch math.Epoch,
+	) (common.Root, []*types.ProposerDutyData, error)
+	// ProduceBlock builds the block and sidecars the node would propose at
+	// the given slot without proposing them, and returns them along with the
+	// value of the payload in Wei. The randao reveal is signed by the node
+	// unless one is given.
+	ProduceBlock(
+		ctx context.Context, slot math.Slot, reveal *crypto.BLSSignature,
+	) (string, *types.BlockProposalData, error)
+	// ChainSpec returns the chain spec of the node.
+	ChainSpec() common.ChainSpec
+}
@@ -0,0 +1,94 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validator
+
+import (
+	"errors"
+
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/utils"
+	validatortypes "github.com/berachain/beacon-kit/mod/node-api/handlers/validator/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+)
+
+// errMissingRandaoReveal is returned when a dry run outside of the admin API
+// does not supply a randao reveal.
+var errMissingRandaoReveal = errors.New("randao_reveal is required")
+
+// ProduceBlock returns the block and sidecars the node would propose at the
+// requested slot, built by a dry run that neither publishes nor caches them.
+// The randao reveal must be supplied, since the node only signs one for
+// requests to the admin API.
+func (h *Handler[ContextT]) ProduceBlock(c ContextT) (any, error) {
+	return h.produceBlock(c, false)
+}
+
+// ProduceBlockAdmin returns the block and sidecars the node would propose at
+// the requested slot like ProduceBlock, but the randao reveal is signed by
+// the node unless one is supplied.
+func (h *Handler[ContextT]) ProduceBlockAdmin(c ContextT) (any, error) {
+	return h.produceBlock(c, true)
+}
+
+// produceBlock builds the block of a dry run for the requested slot. The
+// node signs the randao reveal if none is supplied and signing is allowed.
+func (h *Handler[ContextT]) produceBlock(
+	c ContextT, signReveal bool,
+) (any, error) {
+	req, err := utils.BindAndValidate[validatortypes.ProduceBlockRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	slot, err := utils.U64FromString(req.Slot)
+	if err != nil {
+		return nil, err
+	}
+	var reveal *crypto.BLSSignature
+	switch {
+	case req.RandaoReveal != "":
+		reveal = new(crypto.BLSSignature)
+		if err = reveal.UnmarshalText(
+			[]byte(req.RandaoReveal),
+		); err != nil {
+			return nil, errors.Join(types.ErrInvalidRequest, err)
+		}
+	case !signReveal:
+		return nil, errors.Join(
+			types.ErrInvalidRequest, errMissingRandaoReveal,
+		)
+	}
+	payloadValue, proposal, err := h.backend.ProduceBlock(
+		c.Request().Context(), slot, reveal,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return validatortypes.ProduceBlockResponse{
+		Version: version.Name(
+			h.backend.ChainSpec().ActiveForkVersionForSlot(slot),
+		),
+		ExecutionPayloadValue: payloadValue,
+		Data:                  proposal,
+	}, nil
+}
@@ -0,0 +1,68 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+)
+
+type DutiesResponse struct {
+	DependentRoot       common.Root `json:"dependent_root"`
+	ExecutionOptimistic bool        `json:"execution_optimistic"`
+	Data                any         `json:"data"`
+}
+
+type ProposerDutyData struct {
+	Pubkey         crypto.BLSPubkey `json:"pubkey"`
+	ValidatorIndex uint64           `json:"validator_index,string"`
+	Slot           uint64           `json:"slot,string"`
+}
+
+// ProduceBlockResponse is the block the node would propose at a slot, built
+// by a dry run.
+type ProduceBlockResponse struct {
+	Version               string             `json:"version"`
+	ExecutionPayloadValue string             `json:"execution_payload_value"`
+	Data                  *BlockProposalData `json:"data"`
+}
+
+// ConsensusVersion returns the name of the fork of the block.
+func (r ProduceBlockResponse) ConsensusVersion() string {
+	return r.Version
+}
+
+type BlockProposalData struct {
+	Block        any                  `json:"block"`
+	BlobSidecars any                  `json:"blob_sidecars"`
+	Timings      *ProposalTimingsData `json:"timings"`
+}
+
+// ProposalTimingsData is the time taken by each step of building the block.
+// The sidecars and the state root are computed concurrently.
+type ProposalTimingsData struct {
+	ProcessSlots string `json:"process_slots"`
+	Payload      string `json:"payload"`
+	Body         string `json:"body"`
+	Sidecars     string `json:"sidecars"`
+	StateRoot    string `json:"state_root"`
+	Total        string `json:"total"`
+}
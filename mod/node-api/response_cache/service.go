@@ -0,0 +1,241 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package responsecache
+
+import (
+	"context"
+	"sync"
+
+	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/utils"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/async"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	lru "github.com/hashicorp/golang-lru/v2"
+)
+
+// errUnknownRoot is returned when a state ID refers to a state root which is
+// not tracked by the cache.
+var errUnknownRoot = errors.New("unknown state root")
+
+// Service caches node API responses by the state root they were computed
+// from. It tracks the state roots of finalized blocks so that state and
+// timestamp IDs can be resolved without reading the state, and drops all
+// cached responses whenever a block is finalized. Since blocks are finalized
+// before their state is committed, IDs are only resolved up to the last
+// committed block, whose state is the one read by the node API.
+type Service[BeaconBlockT BeaconBlock] struct {
+	// logger is used for logging information and errors.
+	logger log.Logger
+	// node reports the last committed block.
+	node Node
+	// dispatcher is the dispatcher for the service.
+	dispatcher asynctypes.EventDispatcher
+	// subFinalizedBlkEvents is a channel holding BeaconBlockFinalized
+	// events.
+	subFinalizedBlkEvents chan async.Event[BeaconBlockT]
+
+	// responses holds the cached responses, nil if caching is disabled.
+	responses *lru.Cache[string, any]
+
+	// mu protects the fields below.
+	mu sync.RWMutex
+	// stateRoots maps the slots of recent blocks to their state roots.
+	stateRoots *lru.Cache[math.Slot, common.Root]
+	// timestamps maps the timestamps of recent blocks to their slots.
+	timestamps *lru.Cache[math.U64, math.Slot]
+}
+
+// NewService creates a new response cache holding up to size responses. The
+// cache is disabled if size is zero.
+func NewService[BeaconBlockT BeaconBlock](
+	size int,
+	logger log.Logger,
+	node Node,
+	dispatcher asynctypes.EventDispatcher,
+) (*Service[BeaconBlockT], error) {
+	s := &Service[BeaconBlockT]{
+		logger:                logger,
+		node:                  node,
+		dispatcher:            dispatcher,
+		subFinalizedBlkEvents: make(chan async.Event[BeaconBlockT]),
+	}
+	if size <= 0 {
+		return s, nil
+	}
+
+	var err error
+	if s.responses, err = lru.New[string, any](size); err != nil {
+		return nil, err
+	}
+	if s.stateRoots, err = lru.New[math.Slot, common.Root](size); err != nil {
+		return nil, err
+	}
+	if s.timestamps, err = lru.New[math.U64, math.Slot](size); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Name returns the name of the service.
+func (s *Service[_]) Name() string {
+	return "node-api-response-cache"
+}
+
+// Start subscribes the service to BeaconBlockFinalized events and starts the
+// main event loop to handle them.
+func (s *Service[_]) Start(ctx context.Context) error {
+	if !s.Enabled() {
+		return nil
+	}
+	if err := s.dispatcher.Subscribe(
+		async.BeaconBlockFinalized, s.subFinalizedBlkEvents,
+	); err != nil {
+		s.logger.Error("failed to subscribe to block events", "error", err)
+		return err
+	}
+	go s.eventLoop(ctx)
+	return nil
+}
+
+// eventLoop is the main event loop for the service.
+func (s *Service[_]) eventLoop(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event := <-s.subFinalizedBlkEvents:
+			s.onFinalizeBlock(event.Data())
+		}
+	}
+}
+
+// onFinalizeBlock records the state root of the finalized block and drops
+// all cached responses, since the head state is about to move on.
+func (s *Service[BeaconBlockT]) onFinalizeBlock(blk BeaconBlockT) {
+	slot := blk.GetSlot()
+	s.mu.Lock()
+	s.stateRoots.Add(slot, blk.GetStateRoot())
+	s.timestamps.Add(blk.GetTimestamp(), slot)
+	s.mu.Unlock()
+	s.responses.Purge()
+}
+
+// Enabled returns true if responses are cached.
+func (s *Service[_]) Enabled() bool {
+	return s.responses != nil
+}
+
+// Get returns the cached response for the given key.
+func (s *Service[_]) Get(key string) (any, bool) {
+	if !s.Enabled() {
+		return nil, false
+	}
+	return s.responses.Get(key)
+}
+
+// Add caches the response for the given key.
+func (s *Service[_]) Add(key string, response any) {
+	if !s.Enabled() {
+		return
+	}
+	s.responses.Add(key, response)
+}
+
+// StateRootByStateID returns the state root referred to by the given state
+// ID, if it is known.
+func (s *Service[_]) StateRootByStateID(stateID string) (common.Root, bool) {
+	if !s.Enabled() {
+		return common.Root{}, false
+	}
+	if root, err := common.NewRootFromHex(stateID); err == nil {
+		return root, true
+	}
+	slot, err := utils.SlotFromStateID(stateID, rootResolver{})
+	if err != nil {
+		return common.Root{}, false
+	}
+	return s.stateRootAtSlot(slot)
+}
+
+// StateRootByTimestampID returns the root of the state referred to by the
+// given timestamp ID, if it is known.
+func (s *Service[BeaconBlockT]) StateRootByTimestampID(
+	timestampID string,
+) (common.Root, bool) {
+	if !s.Enabled() {
+		return common.Root{}, false
+	}
+	slot, err := utils.ParentSlotFromTimestampID(
+		timestampID, timestampResolver[BeaconBlockT]{s},
+	)
+	if err != nil {
+		return common.Root{}, false
+	}
+	return s.stateRootAtSlot(slot)
+}
+
+// stateRootAtSlot returns the state root at the given slot, resolving slot 0
+// to the last committed slot as the node API backend does. Slots which are
+// not committed yet are not resolved, as their state cannot be read.
+func (s *Service[_]) stateRootAtSlot(slot math.Slot) (common.Root, bool) {
+	//#nosec:G701 // heights are never negative.
+	committed := math.Slot(s.node.LastBlockHeight())
+	if slot == utils.Head {
+		slot = committed
+	}
+	if slot > committed {
+		return common.Root{}, false
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.stateRoots.Peek(slot)
+}
+
+// rootResolver satisfies utils.SlotFromStateID for state IDs which are not
+// roots, which are handled before resolving the slot.
+type rootResolver struct{}
+
+// GetSlotByStateRoot always fails, as roots are used directly as keys.
+func (rootResolver) GetSlotByStateRoot(common.Root) (math.Slot, error) {
+	return 0, errUnknownRoot
+}
+
+// timestampResolver resolves timestamp IDs from the tracked blocks.
+type timestampResolver[BeaconBlockT BeaconBlock] struct {
+	s *Service[BeaconBlockT]
+}
+
+// GetParentSlotByTimestamp returns the parent slot of the block with the
+// given timestamp.
+func (r timestampResolver[_]) GetParentSlotByTimestamp(
+	timestamp math.U64,
+) (math.Slot, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+	slot, ok := r.s.timestamps.Peek(timestamp)
+	if !ok || slot == 0 {
+		return 0, errUnknownRoot
+	}
+	return slot - 1, nil
+}
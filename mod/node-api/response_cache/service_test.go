@@ -0,0 +1,99 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package responsecache_test
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/async/pkg/dispatcher"
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	responsecache "github.com/berachain/beacon-kit/mod/node-api/response_cache"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/async"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+type block struct {
+	slot math.Slot
+	root common.Root
+}
+
+func (b *block) GetSlot() math.U64      { return b.slot }
+func (b *block) GetTimestamp() math.U64 { return b.slot * 2 }
+func (b *block) GetStateRoot() common.Root {
+	return b.root
+}
+
+type node struct{ height atomic.Int64 }
+
+func (n *node) LastBlockHeight() int64 { return n.height.Load() }
+
+func TestService_ResolvesCommittedStates(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	d, err := dispatcher.New(
+		noop.NewLogger[log.Logger](),
+		dispatcher.WithEvent[async.Event[*block]](async.BeaconBlockFinalized),
+	)
+	require.NoError(t, err)
+	require.NoError(t, d.Start(ctx))
+
+	n := &node{}
+	s, err := responsecache.NewService[*block](
+		8, noop.NewLogger[log.Logger](), n, d,
+	)
+	require.NoError(t, err)
+	require.NoError(t, s.Start(ctx))
+
+	n.height.Store(1)
+	for slot := range math.Slot(3) {
+		require.NoError(t, d.Publish(async.NewEvent(
+			ctx, async.BeaconBlockFinalized,
+			&block{slot: slot + 1, root: common.Root{byte(slot + 1)}},
+		)))
+	}
+	require.Eventually(t, func() bool {
+		_, ok := s.StateRootByStateID("1")
+		return ok
+	}, time.Second, 10*time.Millisecond)
+
+	// The head is the last committed block, even though later blocks have
+	// been finalized.
+	root, ok := s.StateRootByStateID("head")
+	require.True(t, ok)
+	require.Equal(t, common.Root{0x01}, root)
+	_, ok = s.StateRootByStateID("2")
+	require.False(t, ok)
+
+	n.height.Store(3)
+	require.Eventually(t, func() bool {
+		root, ok = s.StateRootByStateID("head")
+		return ok && root == common.Root{0x03}
+	}, time.Second, 10*time.Millisecond)
+	root, ok = s.StateRootByTimestampID("t6")
+	require.True(t, ok)
+	require.Equal(t, common.Root{0x02}, root)
+}
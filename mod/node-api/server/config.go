@@ -20,10 +20,39 @@
 
 package server
 
+import (
+	"errors"
+	"fmt"
+	"net"
+	"regexp"
+	"time"
+)
+
 const (
-	defaultAddress = "127.0.0.1:3500"
+	defaultAddress        = "127.0.0.1:3500"
+	defaultMaxBodySize    = "2M"
+	defaultMaxHeaderBytes = 1 << 20
+	// defaultRequestsPerSecond is the default sustained request rate allowed
+	// per client.
+	defaultRequestsPerSecond = 50
+	// defaultBurst is the default number of requests a client may issue at
+	// once before being throttled.
+	defaultBurst = 100
+	// defaultRateLimitExpiresIn is the default duration after which an idle
+	// client's token bucket is dropped.
+	defaultRateLimitExpiresIn = 3 * time.Minute
+	// defaultResponseCacheSize is the default number of responses kept in
+	// the response cache.
+	defaultResponseCacheSize = 128
+	// defaultCertReloadInterval is the default interval at which the TLS
+	// certificate files are checked for changes.
+	defaultCertReloadInterval = time.Minute
 )
 
+// bodySizePattern matches the sizes accepted by MaxBodySize, e.g. "512K" or
+// "2M".
+var bodySizePattern = regexp.MustCompile(`(?i)^\d+(\.\d+)?([KMGTPE]B?|B)?$`)
+
 // Config is the configuration for the node API server.
 type Config struct {
 	// Enabled is the flag to enable the node API server.
@@ -32,13 +61,206 @@ type Config struct {
 	Address string `mapstructure:"address"`
 	// Logging is the flag to enable API logging.
 	Logging bool `mapstructure:"logging"`
+	// AdminToken is the bearer token required by the admin API. The admin
+	// API is disabled if it is empty.
+	AdminToken string `mapstructure:"admin-token"`
+	// MaxBodySize is the maximum size of a request body, e.g. "2M".
+	MaxBodySize string `mapstructure:"max-body-size"`
+	// MaxHeaderBytes is the maximum size of the request headers in bytes.
+	MaxHeaderBytes int `mapstructure:"max-header-bytes"`
+	// ResponseCacheSize is the number of responses to state queries kept in
+	// the response cache. The cache is disabled if it is zero.
+	ResponseCacheSize int `mapstructure:"response-cache-size"`
+	// TrustedProxies is the list of CIDR ranges of the proxies whose
+	// X-Forwarded-For header identifies the client. The client is the peer
+	// of the connection if it is empty.
+	TrustedProxies []string `mapstructure:"trusted-proxies"`
+	// Auth is the authentication configuration.
+	Auth AuthConfig `mapstructure:"auth"`
+	// RateLimit is the per-client rate limiting configuration.
+	RateLimit RateLimitConfig `mapstructure:"rate-limit"`
+	// CORS is the cross-origin resource sharing configuration.
+	CORS CORSConfig `mapstructure:"cors"`
+	// TLS is the TLS configuration.
+	TLS TLSConfig `mapstructure:"tls"`
+}
+
+// AuthConfig is the configuration for authenticating node API requests.
+// Authentication is enabled if either a token or a JWT secret is set.
+type AuthConfig struct {
+	// Token is a static bearer token accepted by protected routes.
+	Token string `mapstructure:"token"`
+	// JWTSecretPath is the path to a hex encoded secret used to verify HS256
+	// signed bearer tokens.
+	JWTSecretPath string `mapstructure:"jwt-secret-path"`
+	// Groups is the list of route path prefixes that require
+	// authentication.
+	Groups []string `mapstructure:"groups"`
+}
+
+// Enabled returns true if authentication is configured.
+func (c AuthConfig) Enabled() bool {
+	return c.Token != "" || c.JWTSecretPath != ""
+}
+
+// RateLimitConfig is the configuration for the per-client token bucket rate
+// limiter.
+type RateLimitConfig struct {
+	// Enabled determines if rate limiting is enabled.
+	Enabled bool `mapstructure:"enabled"`
+	// RequestsPerSecond is the rate at which a client's bucket is refilled.
+	RequestsPerSecond float64 `mapstructure:"requests-per-second"`
+	// Burst is the size of a client's bucket.
+	Burst int `mapstructure:"burst"`
+	// ExpiresIn is the duration after which an idle client is forgotten.
+	ExpiresIn time.Duration `mapstructure:"expires-in"`
+}
+
+// CORSConfig is the configuration for cross-origin resource sharing.
+type CORSConfig struct {
+	// AllowedOrigins is the list of origins allowed to make cross-origin
+	// requests.
+	AllowedOrigins []string `mapstructure:"allowed-origins"`
+}
+
+// TLSConfig is the configuration for serving the node API over TLS. TLS is
+// enabled if both the certificate and key paths are set.
+type TLSConfig struct {
+	// CertPath is the path to the PEM encoded certificate.
+	CertPath string `mapstructure:"cert-path"`
+	// KeyPath is the path to the PEM encoded private key.
+	KeyPath string `mapstructure:"key-path"`
+	// ReloadInterval is the interval at which the certificate and key files
+	// are checked for changes.
+	ReloadInterval time.Duration `mapstructure:"reload-interval"`
+}
+
+// Enabled returns true if TLS is configured.
+func (c TLSConfig) Enabled() bool {
+	return c.CertPath != "" && c.KeyPath != ""
 }
 
 // DefaultConfig returns the default configuration for the node API server.
 func DefaultConfig() Config {
 	return Config{
-		Enabled: false,
-		Address: defaultAddress,
-		Logging: false,
+		Enabled:           false,
+		Address:           defaultAddress,
+		Logging:           false,
+		AdminToken:        "",
+		MaxBodySize:       defaultMaxBodySize,
+		MaxHeaderBytes:    defaultMaxHeaderBytes,
+		ResponseCacheSize: defaultResponseCacheSize,
+		TrustedProxies:    []string{},
+		Auth: AuthConfig{
+			Token:         "",
+			JWTSecretPath: "",
+			Groups:        []string{"/eth", "/bkit/v1/proof"},
+		},
+		RateLimit: RateLimitConfig{
+			Enabled:           false,
+			RequestsPerSecond: defaultRequestsPerSecond,
+			Burst:             defaultBurst,
+			ExpiresIn:         defaultRateLimitExpiresIn,
+		},
+		CORS: CORSConfig{
+			AllowedOrigins: []string{"*"},
+		},
+		TLS: TLSConfig{
+			CertPath:       "",
+			KeyPath:        "",
+			ReloadInterval: defaultCertReloadInterval,
+		},
+	}
+}
+
+// Validate returns an error describing every invalid field of the
+// configuration.
+func (c Config) Validate() error {
+	var errs []error
+	if c.Enabled {
+		if _, _, err := net.SplitHostPort(c.Address); err != nil {
+			errs = append(errs, fmt.Errorf(
+				"address must be a host:port pair such as %q, got %q",
+				defaultAddress, c.Address,
+			))
+		}
+	}
+	if c.MaxBodySize != "" && !bodySizePattern.MatchString(c.MaxBodySize) {
+		errs = append(errs, fmt.Errorf(
+			"max-body-size must be a size such as \"512K\" or \"2M\", got %q",
+			c.MaxBodySize,
+		))
+	}
+	if c.MaxHeaderBytes < 0 {
+		errs = append(errs, fmt.Errorf(
+			"max-header-bytes must not be negative, got %d", c.MaxHeaderBytes,
+		))
+	}
+	if c.ResponseCacheSize < 0 {
+		errs = append(errs, fmt.Errorf(
+			"response-cache-size must not be negative, got %d, "+
+				"use 0 to disable the cache", c.ResponseCacheSize,
+		))
+	}
+	for _, proxy := range c.TrustedProxies {
+		if _, _, err := net.ParseCIDR(proxy); err != nil {
+			errs = append(errs, fmt.Errorf(
+				"trusted-proxies must be CIDR ranges such as "+
+					"\"10.0.0.0/8\", got %q", proxy,
+			))
+		}
+	}
+	if err := c.RateLimit.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+	if err := c.TLS.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
+
+// Validate returns an error describing every invalid field of the rate
+// limiting configuration.
+func (c RateLimitConfig) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	var errs []error
+	if c.RequestsPerSecond <= 0 {
+		errs = append(errs, fmt.Errorf(
+			"rate-limit.requests-per-second must be greater than zero, "+
+				"got %g, set rate-limit.enabled to false to disable it",
+			c.RequestsPerSecond,
+		))
+	}
+	if c.Burst <= 0 {
+		errs = append(errs, fmt.Errorf(
+			"rate-limit.burst must be greater than zero, got %d", c.Burst,
+		))
+	}
+	if c.ExpiresIn <= 0 {
+		errs = append(errs, fmt.Errorf(
+			"rate-limit.expires-in must be greater than zero, got %s",
+			c.ExpiresIn,
+		))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate returns an error describing every invalid field of the TLS
+// configuration.
+func (c TLSConfig) Validate() error {
+	if (c.CertPath == "") != (c.KeyPath == "") {
+		return errors.New(
+			"tls.cert-path and tls.key-path must either both be set to " +
+				"enable TLS or both be empty",
+		)
+	}
+	if c.Enabled() && c.ReloadInterval <= 0 {
+		return fmt.Errorf(
+			"tls.reload-interval must be greater than zero, got %s",
+			c.ReloadInterval,
+		)
 	}
+	return nil
 }
@@ -20,7 +20,11 @@
 
 package context
 
+import "net/http"
+
 type Context interface {
 	Bind(any) error
 	Validate(any) error
+	// Request returns the underlying HTTP request.
+	Request() *http.Request
 }
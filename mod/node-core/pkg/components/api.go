@@ -23,35 +23,97 @@ package components
 import (
 	"cosmossdk.io/depinject"
 	"github.com/berachain/beacon-kit/mod/config"
+	cometbft "github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/node-api/backend"
 	"github.com/berachain/beacon-kit/mod/node-api/engines/echo"
 	"github.com/berachain/beacon-kit/mod/node-api/handlers"
+	responsecache "github.com/berachain/beacon-kit/mod/node-api/response_cache"
 	"github.com/berachain/beacon-kit/mod/node-api/server"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/metrics"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/jwt"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// NodeAPIEngineInput is the input for the node API engine provider.
+type NodeAPIEngineInput[BeaconBlockT responsecache.BeaconBlock] struct {
+	depinject.In
+
+	Config        *config.Config
+	ResponseCache *responsecache.Service[BeaconBlockT]
+	TelemetrySink *metrics.TelemetrySink
+}
+
 // TODO: we could make engine type configurable
-func ProvideNodeAPIEngine() *echo.Engine {
-	return echo.NewDefaultEngine()
+func ProvideNodeAPIEngine[
+	BeaconBlockT BeaconBlock[BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT],
+	BeaconBlockBodyT any,
+	BeaconBlockHeaderT any,
+](in NodeAPIEngineInput[BeaconBlockT]) (*echo.Engine, error) {
+	var (
+		secret *jwt.Secret
+		err    error
+	)
+	if path := in.Config.NodeAPI.Auth.JWTSecretPath; path != "" {
+		if secret, err = LoadJWTFromFile(path); err != nil {
+			return nil, err
+		}
+	}
+	return echo.NewEngine(
+		in.Config.NodeAPI, secret, in.ResponseCache, in.TelemetrySink,
+	)
+}
+
+// NodeAPIResponseCacheInput is the input for the node API response cache
+// provider.
+type NodeAPIResponseCacheInput[LoggerT log.AdvancedLogger[LoggerT]] struct {
+	depinject.In
+
+	CometBFTService *cometbft.Service[LoggerT]
+	Config          *config.Config
+	Dispatcher      Dispatcher
+	Logger          LoggerT
+}
+
+// ProvideNodeAPIResponseCache provides the node API response cache.
+func ProvideNodeAPIResponseCache[
+	BeaconBlockT BeaconBlock[BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT],
+	BeaconBlockBodyT any,
+	BeaconBlockHeaderT any,
+	LoggerT log.AdvancedLogger[LoggerT],
+](
+	in NodeAPIResponseCacheInput[LoggerT],
+) (*responsecache.Service[BeaconBlockT], error) {
+	return responsecache.NewService[BeaconBlockT](
+		in.Config.NodeAPI.ResponseCacheSize,
+		in.Logger.With("service", "node-api-response-cache"),
+		in.CometBFTService,
+		in.Dispatcher,
+	)
 }
 
 type NodeAPIBackendInput[
 	BeaconBlockT any,
+	BeaconBlockBodyT any,
 	BeaconStateT any,
 	DepositT any,
+	EngineClientT any,
 	ExecutionPayloadHeaderT ExecutionPayloadHeader[ExecutionPayloadHeaderT],
 	StorageBackendT any,
+	ValidatorServiceT any,
 ] struct {
 	depinject.In
 
 	ChainSpec      common.ChainSpec
+	EngineClient   EngineClientT
+	OperationPool  OperationPool[BeaconStateT, BeaconBlockBodyT]
 	StateProcessor StateProcessor[
 		BeaconBlockT, BeaconStateT, *Context,
 		DepositT, ExecutionPayloadHeaderT,
 	]
-	StorageBackend StorageBackendT
+	StorageBackend   StorageBackendT
+	ValidatorService ValidatorServiceT
 }
 
 func ProvideNodeAPIBackend[
@@ -69,25 +131,28 @@ func ProvideNodeAPIBackend[
 	BlobSidecarsT any,
 	DepositT any,
 	DepositStoreT DepositStore[DepositT],
+	EngineClientT backend.ExecutionClient,
 	ExecutionPayloadHeaderT ExecutionPayloadHeader[ExecutionPayloadHeaderT],
 	KVStoreT any,
-	NodeT interface {
-		CreateQueryContext(height int64, prove bool) (sdk.Context, error)
-	},
+	NodeT backend.Node[sdk.Context],
 	StorageBackendT StorageBackend[
 		AvailabilityStoreT, BeaconStateT, BeaconBlockStoreT, DepositStoreT,
 	],
+	ValidatorServiceT backend.BlockProducer[
+		BeaconBlockT, BeaconStateT, BlobSidecarsT,
+	],
 	WithdrawalT Withdrawal[WithdrawalT],
 ](
 	in NodeAPIBackendInput[
-		BeaconBlockT, BeaconStateT, DepositT, ExecutionPayloadHeaderT,
-		StorageBackendT,
+		BeaconBlockT, BeaconBlockBodyT, BeaconStateT, DepositT, EngineClientT,
+		ExecutionPayloadHeaderT, StorageBackendT, ValidatorServiceT,
 	],
 ) *backend.Backend[
 	AvailabilityStoreT, BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
 	BeaconStateT, BeaconStateMarshallableT, BlobSidecarsT, BeaconBlockStoreT,
-	sdk.Context, DepositT, DepositStoreT, *Eth1Data, ExecutionPayloadHeaderT,
-	*Fork, NodeT, KVStoreT, StorageBackendT, *Validator, Validators,
+	*SignedBLSToExecutionChange, sdk.Context, DepositT, DepositStoreT,
+	*Eth1Data, ExecutionPayloadHeaderT, *Fork, NodeT, *ProposerSlashing,
+	KVStoreT, StorageBackendT, *Validator, Validators, *SignedVoluntaryExit,
 	WithdrawalT, WithdrawalCredentials,
 ] {
 	return backend.New[
@@ -99,6 +164,7 @@ func ProvideNodeAPIBackend[
 		BeaconStateMarshallableT,
 		BlobSidecarsT,
 		BeaconBlockStoreT,
+		*SignedBLSToExecutionChange,
 		sdk.Context,
 		DepositT,
 		DepositStoreT,
@@ -106,16 +172,21 @@ func ProvideNodeAPIBackend[
 		ExecutionPayloadHeaderT,
 		*Fork,
 		NodeT,
+		*ProposerSlashing,
 		KVStoreT,
 		StorageBackendT,
 		*Validator,
 		Validators,
+		*SignedVoluntaryExit,
 		WithdrawalT,
 		WithdrawalCredentials,
 	](
 		in.StorageBackend,
 		in.ChainSpec,
 		in.StateProcessor,
+		in.OperationPool,
+		in.ValidatorService,
+		in.EngineClient,
 	)
 }
 
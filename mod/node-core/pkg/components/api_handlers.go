@@ -22,7 +22,13 @@ package components
 
 import (
 	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/mod/beacon/blockchain"
+	"github.com/berachain/beacon-kit/mod/config"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/execution/pkg/deposit"
+	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/node-api/handlers"
+	adminapi "github.com/berachain/beacon-kit/mod/node-api/handlers/admin"
 	beaconapi "github.com/berachain/beacon-kit/mod/node-api/handlers/beacon"
 	builderapi "github.com/berachain/beacon-kit/mod/node-api/handlers/builder"
 	configapi "github.com/berachain/beacon-kit/mod/node-api/handlers/config"
@@ -30,6 +36,10 @@ import (
 	eventsapi "github.com/berachain/beacon-kit/mod/node-api/handlers/events"
 	nodeapi "github.com/berachain/beacon-kit/mod/node-api/handlers/node"
 	proofapi "github.com/berachain/beacon-kit/mod/node-api/handlers/proof"
+	validatorapi "github.com/berachain/beacon-kit/mod/node-api/handlers/validator"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/metrics"
+	"github.com/berachain/beacon-kit/mod/payload/pkg/cache"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
 type NodeAPIHandlersInput[
@@ -49,18 +59,25 @@ type NodeAPIHandlersInput[
 	WithdrawalT Withdrawal[WithdrawalT],
 ] struct {
 	depinject.In
+	AdminAPIHandler *adminapi.Handler[
+		NodeAPIContextT, PayloadID, [32]byte, math.Slot,
+	]
 	BeaconAPIHandler *beaconapi.Handler[
-		BeaconBlockHeaderT, NodeAPIContextT, *Fork, *Validator,
+		BeaconBlockHeaderT, *SignedBLSToExecutionChange, NodeAPIContextT,
+		*Fork, *ProposerSlashing, *Validator, *SignedVoluntaryExit,
 	]
 	BuilderAPIHandler *builderapi.Handler[NodeAPIContextT]
 	ConfigAPIHandler  *configapi.Handler[NodeAPIContextT]
-	DebugAPIHandler   *debugapi.Handler[NodeAPIContextT]
-	EventsAPIHandler  *eventsapi.Handler[NodeAPIContextT]
-	NodeAPIHandler    *nodeapi.Handler[NodeAPIContextT]
-	ProofAPIHandler   *proofapi.Handler[
+	DebugAPIHandler   *debugapi.Handler[
+		BeaconStateT, BeaconStateMarshallableT, NodeAPIContextT,
+	]
+	EventsAPIHandler *eventsapi.Handler[NodeAPIContextT]
+	NodeAPIHandler   *nodeapi.Handler[NodeAPIContextT]
+	ProofAPIHandler  *proofapi.Handler[
 		BeaconBlockHeaderT, BeaconStateT, BeaconStateMarshallableT,
 		NodeAPIContextT, ExecutionPayloadHeaderT, *Validator,
 	]
+	ValidatorAPIHandler *validatorapi.Handler[NodeAPIContextT]
 }
 
 func ProvideNodeAPIHandlers[
@@ -86,6 +103,7 @@ func ProvideNodeAPIHandlers[
 	],
 ) []handlers.Handlers[NodeAPIContextT] {
 	return []handlers.Handlers[NodeAPIContextT]{
+		in.AdminAPIHandler,
 		in.BeaconAPIHandler,
 		in.BuilderAPIHandler,
 		in.ConfigAPIHandler,
@@ -93,9 +111,110 @@ func ProvideNodeAPIHandlers[
 		in.EventsAPIHandler,
 		in.NodeAPIHandler,
 		in.ProofAPIHandler,
+		in.ValidatorAPIHandler,
 	}
 }
 
+// NodeAPIAdminHandlerInput is the input for the admin API handler provider.
+type NodeAPIAdminHandlerInput[
+	AvailabilityStoreT AvailabilityStore[BeaconBlockBodyT, BlobSidecarsT],
+	ConsensusBlockT ConsensusBlock[BeaconBlockT],
+	BeaconBlockT BeaconBlock[BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT],
+	BeaconBlockBodyT BeaconBlockBody[
+		BeaconBlockBodyT, *AttestationData, DepositT,
+		*Eth1Data, ExecutionPayloadT, *SlashingInfo,
+	],
+	BeaconBlockHeaderT BeaconBlockHeader[BeaconBlockHeaderT],
+	BeaconStateT BeaconState[
+		BeaconStateT, BeaconBlockHeaderT, BeaconStateMarshallableT,
+		*Eth1Data, ExecutionPayloadHeaderT, *Fork, KVStoreT,
+		*Validator, Validators, WithdrawalT,
+	],
+	BeaconStateMarshallableT any,
+	BlobSidecarsT any,
+	DepositT Deposit[DepositT, *ForkData, WithdrawalCredentials],
+	ExecutionPayloadT ExecutionPayload[
+		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalsT,
+	],
+	ExecutionPayloadHeaderT ExecutionPayloadHeader[ExecutionPayloadHeaderT],
+	GenesisT Genesis[DepositT, ExecutionPayloadHeaderT],
+	KVStoreT any,
+	LoggerT any,
+	WithdrawalT Withdrawal[WithdrawalT],
+	WithdrawalsT Withdrawals[WithdrawalT],
+] struct {
+	depinject.In
+	ChainService *blockchain.Service[
+		AvailabilityStoreT,
+		ConsensusBlockT, BeaconBlockT, BeaconBlockBodyT,
+		BeaconBlockHeaderT, BeaconStateT, DepositT, ExecutionPayloadT,
+		ExecutionPayloadHeaderT, GenesisT,
+		*engineprimitives.PayloadAttributes[WithdrawalT],
+	]
+	Config         *config.Config
+	DBManager      *DBManager
+	DepositService *deposit.Service[
+		BeaconBlockT, BeaconBlockBodyT, DepositT,
+		ExecutionPayloadT, WithdrawalCredentials,
+	]
+	Logger         LoggerT
+	PayloadIDCache *cache.PayloadIDCache[PayloadID, [32]byte, math.Slot]
+	TelemetrySink  *metrics.TelemetrySink
+}
+
+// ProvideNodeAPIAdminHandler is a depinject provider for the admin API
+// handler.
+func ProvideNodeAPIAdminHandler[
+	AvailabilityStoreT AvailabilityStore[BeaconBlockBodyT, BlobSidecarsT],
+	ConsensusBlockT ConsensusBlock[BeaconBlockT],
+	BeaconBlockT BeaconBlock[BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT],
+	BeaconBlockBodyT BeaconBlockBody[
+		BeaconBlockBodyT, *AttestationData, DepositT,
+		*Eth1Data, ExecutionPayloadT, *SlashingInfo,
+	],
+	BeaconBlockHeaderT BeaconBlockHeader[BeaconBlockHeaderT],
+	BeaconStateT BeaconState[
+		BeaconStateT, BeaconBlockHeaderT, BeaconStateMarshallableT,
+		*Eth1Data, ExecutionPayloadHeaderT, *Fork, KVStoreT,
+		*Validator, Validators, WithdrawalT,
+	],
+	BeaconStateMarshallableT any,
+	BlobSidecarsT any,
+	DepositT Deposit[DepositT, *ForkData, WithdrawalCredentials],
+	ExecutionPayloadT ExecutionPayload[
+		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalsT,
+	],
+	ExecutionPayloadHeaderT ExecutionPayloadHeader[ExecutionPayloadHeaderT],
+	GenesisT Genesis[DepositT, ExecutionPayloadHeaderT],
+	KVStoreT any,
+	LoggerT interface {
+		log.AdvancedLogger[LoggerT]
+		adminapi.LogLevelController
+	},
+	NodeAPIContextT NodeAPIContext,
+	WithdrawalT Withdrawal[WithdrawalT],
+	WithdrawalsT Withdrawals[WithdrawalT],
+](
+	in NodeAPIAdminHandlerInput[
+		AvailabilityStoreT, ConsensusBlockT, BeaconBlockT, BeaconBlockBodyT,
+		BeaconBlockHeaderT, BeaconStateT, BeaconStateMarshallableT,
+		BlobSidecarsT, DepositT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+		GenesisT, KVStoreT, LoggerT, WithdrawalT, WithdrawalsT,
+	],
+) *adminapi.Handler[NodeAPIContextT, PayloadID, [32]byte, math.Slot] {
+	return adminapi.NewHandler[
+		NodeAPIContextT, PayloadID, [32]byte, math.Slot,
+	](
+		in.Logger.With("service", "node-api-admin"),
+		in.TelemetrySink,
+		in.Logger,
+		in.DBManager,
+		in.DepositService,
+		in.PayloadIDCache,
+		in.ChainService,
+	)
+}
+
 func ProvideNodeAPIBeaconHandler[
 	BeaconBlockHeaderT BeaconBlockHeader[BeaconBlockHeaderT],
 	BeaconStateT any,
@@ -108,32 +227,78 @@ func ProvideNodeAPIBeaconHandler[
 	NodeT,
 	*Validator,
 ]) *beaconapi.Handler[
-	BeaconBlockHeaderT, NodeAPIContextT, *Fork, *Validator,
+	BeaconBlockHeaderT, *SignedBLSToExecutionChange, NodeAPIContextT, *Fork,
+	*ProposerSlashing, *Validator, *SignedVoluntaryExit,
 ] {
 	return beaconapi.NewHandler[
 		BeaconBlockHeaderT,
+		*SignedBLSToExecutionChange,
 		NodeAPIContextT,
 		*Fork,
+		*ProposerSlashing,
 		*Validator,
+		*SignedVoluntaryExit,
 	](b)
 }
 
 func ProvideNodeAPIBuilderHandler[
+	BeaconBlockHeaderT BeaconBlockHeader[BeaconBlockHeaderT],
+	BeaconStateT any,
+	NodeT any,
 	NodeAPIContextT NodeAPIContext,
-]() *builderapi.Handler[NodeAPIContextT] {
-	return builderapi.NewHandler[NodeAPIContextT]()
+](b NodeAPIBackend[
+	BeaconBlockHeaderT,
+	BeaconStateT,
+	*Fork,
+	NodeT,
+	*Validator,
+]) *builderapi.Handler[NodeAPIContextT] {
+	return builderapi.NewHandler[NodeAPIContextT](b)
 }
 
 func ProvideNodeAPIConfigHandler[
+	BeaconBlockHeaderT BeaconBlockHeader[BeaconBlockHeaderT],
+	BeaconStateT any,
+	NodeT any,
 	NodeAPIContextT NodeAPIContext,
-]() *configapi.Handler[NodeAPIContextT] {
-	return configapi.NewHandler[NodeAPIContextT]()
+](b NodeAPIBackend[
+	BeaconBlockHeaderT,
+	BeaconStateT,
+	*Fork,
+	NodeT,
+	*Validator,
+]) *configapi.Handler[NodeAPIContextT] {
+	return configapi.NewHandler[NodeAPIContextT](b)
 }
 
 func ProvideNodeAPIDebugHandler[
+	BeaconBlockHeaderT BeaconBlockHeader[BeaconBlockHeaderT],
+	BeaconStateT BeaconState[
+		BeaconStateT, BeaconBlockHeaderT, BeaconStateMarshallableT,
+		*Eth1Data, ExecutionPayloadHeaderT, *Fork, KVStoreT,
+		*Validator, Validators, WithdrawalT,
+	],
+	BeaconStateMarshallableT BeaconStateMarshallable[
+		BeaconStateMarshallableT, BeaconBlockHeaderT, *Eth1Data,
+		ExecutionPayloadHeaderT, *Fork, *Validator,
+	],
+	ExecutionPayloadHeaderT ExecutionPayloadHeader[ExecutionPayloadHeaderT],
+	KVStoreT any,
+	NodeT any,
 	NodeAPIContextT NodeAPIContext,
-]() *debugapi.Handler[NodeAPIContextT] {
-	return debugapi.NewHandler[NodeAPIContextT]()
+	WithdrawalT Withdrawal[WithdrawalT],
+](b NodeAPIBackend[
+	BeaconBlockHeaderT,
+	BeaconStateT,
+	*Fork,
+	NodeT,
+	*Validator,
+]) *debugapi.Handler[
+	BeaconStateT, BeaconStateMarshallableT, NodeAPIContextT,
+] {
+	return debugapi.NewHandler[
+		BeaconStateT, BeaconStateMarshallableT, NodeAPIContextT,
+	](b)
 }
 
 func ProvideNodeAPIEventsHandler[
@@ -143,9 +308,18 @@ func ProvideNodeAPIEventsHandler[
 }
 
 func ProvideNodeAPINodeHandler[
+	BeaconBlockHeaderT BeaconBlockHeader[BeaconBlockHeaderT],
+	BeaconStateT any,
+	NodeT any,
 	NodeAPIContextT NodeAPIContext,
-]() *nodeapi.Handler[NodeAPIContextT] {
-	return nodeapi.NewHandler[NodeAPIContextT]()
+](b NodeAPIBackend[
+	BeaconBlockHeaderT,
+	BeaconStateT,
+	*Fork,
+	NodeT,
+	*Validator,
+]) *nodeapi.Handler[NodeAPIContextT] {
+	return nodeapi.NewHandler[NodeAPIContextT](b)
 }
 
 func ProvideNodeAPIProofHandler[
@@ -183,3 +357,18 @@ func ProvideNodeAPIProofHandler[
 		*Validator,
 	](b)
 }
+
+func ProvideNodeAPIValidatorHandler[
+	BeaconBlockHeaderT BeaconBlockHeader[BeaconBlockHeaderT],
+	BeaconStateT any,
+	NodeT any,
+	NodeAPIContextT NodeAPIContext,
+](b NodeAPIBackend[
+	BeaconBlockHeaderT,
+	BeaconStateT,
+	*Fork,
+	NodeT,
+	*Validator,
+]) *validatorapi.Handler[NodeAPIContextT] {
+	return validatorapi.NewHandler[NodeAPIContextT](b)
+}
@@ -30,6 +30,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/async"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/db"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/filedb"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/manager"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/pruner"
@@ -43,6 +44,7 @@ type AvailabilityStoreInput[LoggerT any] struct {
 	depinject.In
 	AppOpts   config.AppOptions
 	ChainSpec common.ChainSpec
+	Config    *config.Config
 	Logger    LoggerT
 }
 
@@ -55,19 +57,34 @@ func ProvideAvailibilityStore[
 ](
 	in AvailabilityStoreInput[LoggerT],
 ) (*dastore.Store[BeaconBlockBodyT], error) {
-	return dastore.New[BeaconBlockBodyT](
-		filedb.NewRangeDB(
+	dataDir := cast.ToString(in.AppOpts.Get(flags.FlagHome)) + "/data"
+
+	// Store blob sidecars either in a file each or in a single database.
+	var indexDB dastore.IndexDB
+	switch in.Config.Storage.BlobStore {
+	case db.BlobStoreKV:
+		kvdb, err := db.NewDB("blobs", dataDir, in.Config.Storage)
+		if err != nil {
+			return nil, err
+		}
+		indexDB = db.NewRangeDB(kvdb)
+	default:
+		rangeDB := filedb.NewRangeDB(
 			filedb.NewDB(
-				filedb.WithRootDirectory(
-					cast.ToString(
-						in.AppOpts.Get(flags.FlagHome),
-					)+"/data/blobs",
-				),
+				filedb.WithRootDirectory(dataDir+"/blobs"),
 				filedb.WithFileExtension("ssz"),
 				filedb.WithDirectoryPermissions(os.ModePerm),
 				filedb.WithLogger(in.Logger),
 			),
-		),
+		)
+		if err := rangeDB.Recover(); err != nil {
+			return nil, err
+		}
+		indexDB = rangeDB
+	}
+
+	return dastore.New[BeaconBlockBodyT](
+		indexDB,
 		in.Logger.With("service", "da-store"),
 		in.ChainSpec,
 	), nil
@@ -0,0 +1,85 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+import (
+	"cosmossdk.io/depinject"
+	cometbft "github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/execution/pkg/client"
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/debug"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+)
+
+// BlockReplayerInput is the input for the block replayer provider.
+type BlockReplayerInput[
+	BeaconBlockT any,
+	BeaconStateT any,
+	ExecutionPayloadT ExecutionPayload[
+		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalsT,
+	],
+	ExecutionPayloadHeaderT ExecutionPayloadHeader[ExecutionPayloadHeaderT],
+	LoggerT log.AdvancedLogger[LoggerT],
+	StorageBackendT any,
+	WithdrawalT Withdrawal[WithdrawalT],
+	WithdrawalsT Withdrawals[WithdrawalT],
+] struct {
+	depinject.In
+	ChainSpec       common.ChainSpec
+	CometBFTService *cometbft.Service[LoggerT]
+	EngineClient    *client.EngineClient[
+		ExecutionPayloadT,
+		*engineprimitives.PayloadAttributes[WithdrawalT],
+	]
+	StateProcessor debug.StateProcessor[BeaconBlockT, BeaconStateT]
+	StorageBackend StorageBackendT
+}
+
+// ProvideBlockReplayer is a depinject provider for the block replayer.
+func ProvideBlockReplayer[
+	BeaconBlockT debug.BeaconBlock[BeaconBlockT],
+	BeaconStateT debug.BeaconState[BeaconStateMarshallableT],
+	BeaconStateMarshallableT debug.StateDiffer[BeaconStateMarshallableT],
+	ExecutionPayloadT ExecutionPayload[
+		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalsT,
+	],
+	ExecutionPayloadHeaderT ExecutionPayloadHeader[ExecutionPayloadHeaderT],
+	LoggerT log.AdvancedLogger[LoggerT],
+	StorageBackendT debug.StorageBackend[BeaconStateT],
+	WithdrawalT Withdrawal[WithdrawalT],
+	WithdrawalsT Withdrawals[WithdrawalT],
+](
+	in BlockReplayerInput[
+		BeaconBlockT, BeaconStateT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+		LoggerT, StorageBackendT, WithdrawalT, WithdrawalsT,
+	],
+) *debug.BlockReplayer[BeaconBlockT, BeaconStateT, BeaconStateMarshallableT] {
+	return debug.NewBlockReplayer[
+		BeaconBlockT, BeaconStateT, BeaconStateMarshallableT,
+	](
+		in.ChainSpec,
+		in.EngineClient,
+		in.CometBFTService,
+		in.StateProcessor,
+		in.StorageBackend,
+	)
+}
@@ -0,0 +1,79 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package components
+
+import (
+	"errors"
+
+	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/mod/config"
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/node-api/engines/echo"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/services/reload"
+	"github.com/spf13/viper"
+)
+
+// ConfigReloaderInput is the input for the config reloader provider.
+type ConfigReloaderInput[
+	BlockStoreT any,
+	LocalBuilderT any,
+	LoggerT any,
+	ValidatorServiceT any,
+] struct {
+	depinject.In
+	AppOpts          config.AppOptions
+	BlockStore       BlockStoreT
+	Config           *config.Config
+	LocalBuilder     LocalBuilderT
+	Logger           LoggerT
+	NodeAPIEngine    *echo.Engine
+	ValidatorService ValidatorServiceT
+}
+
+// ProvideConfigReloader is a depinject provider for the service that
+// reloads the configuration on SIGHUP.
+func ProvideConfigReloader[
+	BlockStoreT reload.BlockStore,
+	LocalBuilderT reload.FeeRecipientSetter,
+	LoggerT interface {
+		log.AdvancedLogger[LoggerT]
+		reload.LogLevelController
+	},
+	ValidatorServiceT reload.GraffitiSetter,
+](
+	in ConfigReloaderInput[
+		BlockStoreT, LocalBuilderT, LoggerT, ValidatorServiceT,
+	],
+) (*ConfigReloader, error) {
+	v, ok := in.AppOpts.(*viper.Viper)
+	if !ok {
+		return nil, errors.New("invalid application options type")
+	}
+	return reload.NewService(
+		in.Logger.With("service", "config-reloader"),
+		v,
+		in.Config,
+		in.Logger,
+		in.ValidatorService,
+		in.LocalBuilder,
+		in.NodeAPIEngine,
+		in.BlockStore,
+	), nil
+}
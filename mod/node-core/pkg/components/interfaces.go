@@ -24,11 +24,16 @@ import (
 	stdbytes "bytes"
 	"context"
 	"encoding/json"
+	"net/http"
 
+	ctypes "github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/node-api/handlers"
 	"github.com/berachain/beacon-kit/mod/node-api/handlers/beacon/types"
+	buildertypes "github.com/berachain/beacon-kit/mod/node-api/handlers/builder/types"
+	nodetypes "github.com/berachain/beacon-kit/mod/node-api/handlers/node/types"
+	validatortypes "github.com/berachain/beacon-kit/mod/node-api/handlers/validator/types"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
@@ -67,6 +72,10 @@ type (
 			timestamp uint64,
 			prevHeadRoot [32]byte,
 		) (PayloadAttributesT, error)
+		// SuggestedFeeRecipient returns the suggested fee recipient.
+		SuggestedFeeRecipient() common.ExecutionAddress
+		// SetSuggestedFeeRecipient sets the suggested fee recipient.
+		SetSuggestedFeeRecipient(common.ExecutionAddress)
 	}
 
 	// AvailabilityStore is the interface for the availability store.
@@ -90,6 +99,10 @@ type (
 		// GetConsensusTime returns the timestamp of current consensus request.
 		// It is used to build next payload and to validate currentpayload.
 		GetConsensusTime() math.U64
+
+		// GetLastCommitVotes returns the votes of the validators in the
+		// commit of the previous block. It is only set for finalized blocks.
+		GetLastCommitVotes() []transition.CommitVote
 	}
 
 	// BeaconBlock represents a generic interface for a beacon block.
@@ -153,12 +166,33 @@ type (
 		GetDeposits() []DepositT
 		// GetBlobKzgCommitments returns the KZG commitments for the blobs.
 		GetBlobKzgCommitments() eip4844.KZGCommitments[common.ExecutionHash]
+		// GetProposerSlashings returns the list of proposer slashings.
+		GetProposerSlashings() []*ctypes.ProposerSlashing
+		// GetVoluntaryExits returns the list of voluntary exits.
+		GetVoluntaryExits() []*ctypes.SignedVoluntaryExit
+		// GetBlsToExecutionChanges returns the list of BLS to execution
+		// changes.
+		GetBlsToExecutionChanges() []*ctypes.SignedBLSToExecutionChange
+		// GetExecutionRequests returns the requests triggered from the
+		// execution layer.
+		GetExecutionRequests() *ctypes.ExecutionRequests
 		// SetRandaoReveal sets the Randao reveal of the beacon block body.
 		SetRandaoReveal(crypto.BLSSignature)
 		// SetEth1Data sets the Eth1 data of the beacon block body.
 		SetEth1Data(Eth1DataT)
 		// SetDeposits sets the deposits of the beacon block body.
 		SetDeposits([]DepositT)
+		// SetProposerSlashings sets the proposer slashings of the beacon
+		// block body.
+		SetProposerSlashings([]*ctypes.ProposerSlashing)
+		// SetVoluntaryExits sets the voluntary exits of the beacon block body.
+		SetVoluntaryExits([]*ctypes.SignedVoluntaryExit)
+		// SetBlsToExecutionChanges sets the BLS to execution changes of the
+		// beacon block body.
+		SetBlsToExecutionChanges([]*ctypes.SignedBLSToExecutionChange)
+		// SetEncodedExecutionRequests sets the execution requests of the
+		// beacon block body from their encoding in the engine API.
+		SetEncodedExecutionRequests([]bytes.Bytes) error
 		// SetExecutionPayload sets the execution data of the beacon block body.
 		SetExecutionPayload(ExecutionPayloadT)
 		// SetGraffiti sets the graffiti of the beacon block body.
@@ -265,6 +299,7 @@ type (
 		constraints.Nillable
 		constraints.SSZMarshallable
 		constraints.Empty[T]
+		NewFromSSZ([]byte, uint32) (T, error)
 		Len() int
 		Get(index int) BlobSidecarT
 		GetSidecars() []BlobSidecarT
@@ -443,6 +478,7 @@ type (
 	// 			payload ExecutionPayloadT,
 	// 			versionedHashes []common.ExecutionHash,
 	// 			parentBeaconBlockRoot *common.Root,
+	// 			executionRequests []bytes.Bytes,
 	// 		) (*common.ExecutionHash, error)
 	// 		ForkchoiceUpdated(
 	// 			ctx context.Context,
@@ -519,7 +555,7 @@ type (
 	// ExecutionPayloadHeader is the interface for the execution payload
 	// header.
 	ExecutionPayloadHeader[T any] interface {
-		constraints.SSZMarshallable
+		constraints.SSZMarshallableRootable
 		constraints.Versionable
 		NewFromSSZ([]byte, uint32) (T, error)
 		// GetNumber returns the block number of the ExecutionPayloadHeader.
@@ -561,6 +597,9 @@ type (
 		GetDeposits() []DepositT
 		// GetExecutionPayloadHeader returns the execution payload header.
 		GetExecutionPayloadHeader() ExecutionPayloadHeaderT
+		// GetState returns the JSON encoded full beacon state the chain is
+		// restarted from, if any.
+		GetState() []byte
 	}
 
 	// IndexDB is the interface for the range DB.
@@ -610,6 +649,18 @@ type (
 			headEth1BlockHash common.ExecutionHash,
 			finalEth1BlockHash common.ExecutionHash,
 		) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error)
+		// RequestPayloadDryRun requests a payload for the given slot and
+		// blocks until the payload is delivered, keeping its ID apart from
+		// the ones of proposed payloads.
+		RequestPayloadDryRun(
+			ctx context.Context,
+			st BeaconStateT,
+			slot math.Slot,
+			timestamp uint64,
+			parentBlockRoot common.Root,
+			headEth1BlockHash common.ExecutionHash,
+			finalEth1BlockHash common.ExecutionHash,
+		) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error)
 	}
 
 	// 	// PayloadAttributes is the interface for the payload attributes.
@@ -645,6 +696,32 @@ type (
 	// 		GetSlashingInfo() []SlashingInfoT
 	// 	}
 
+	// OperationPool is the interface for the pool of operations awaiting
+	// inclusion in a block.
+	OperationPool[BeaconStateT, BeaconBlockBodyT any] interface {
+		// AddProposerSlashing validates and adds a proposer slashing.
+		AddProposerSlashing(BeaconStateT, *ctypes.ProposerSlashing) error
+		// AddVoluntaryExit validates and adds a voluntary exit.
+		AddVoluntaryExit(BeaconStateT, *ctypes.SignedVoluntaryExit) error
+		// AddBLSToExecutionChange validates and adds a BLS to execution
+		// change.
+		AddBLSToExecutionChange(
+			BeaconStateT, *ctypes.SignedBLSToExecutionChange,
+		) error
+		// ProposerSlashings returns the pooled proposer slashings.
+		ProposerSlashings() []*ctypes.ProposerSlashing
+		// VoluntaryExits returns the pooled voluntary exits.
+		VoluntaryExits() []*ctypes.SignedVoluntaryExit
+		// BLSToExecutionChanges returns the pooled BLS to execution changes.
+		BLSToExecutionChanges() []*ctypes.SignedBLSToExecutionChange
+		// PackOperations sets the pooled operations that are valid against
+		// the given state on the block body.
+		PackOperations(BeaconStateT, BeaconBlockBodyT)
+		// PeekOperations sets the same operations as PackOperations without
+		// evicting the invalid ones from the pool.
+		PeekOperations(BeaconStateT, BeaconBlockBodyT)
+	}
+
 	// StateProcessor defines the interface for processing the state.
 	StateProcessor[
 		BeaconBlockT any,
@@ -662,6 +739,12 @@ type (
 			ExecutionPayloadHeaderT,
 			common.Version,
 		) (transition.ValidatorUpdates, error)
+		// InitializeBeaconStateFromGenesisState initializes the beacon state
+		// from the JSON encoded full beacon state of a previous chain.
+		InitializeBeaconStateFromGenesisState(
+			BeaconStateT,
+			[]byte,
+		) (transition.ValidatorUpdates, error)
 		// ProcessSlot processes the slot.
 		ProcessSlots(
 			st BeaconStateT, slot math.Slot,
@@ -850,6 +933,11 @@ type (
 		) T
 		// Copy returns a copy of the key-value store.
 		Copy() T
+		// CachedHashTreeRoot returns the hash tree root of the state, given
+		// the lengths of the historical roots and randao mixes vectors.
+		CachedHashTreeRoot(
+			historicalRoots, historicalMixes uint64,
+		) (common.Root, error)
 		// GetLatestExecutionPayloadHeader retrieves the latest execution
 		// payload
 		// header.
@@ -956,6 +1044,58 @@ type (
 		// GetValidatorsByEffectiveBalance retrieves validators by effective
 		// balance.
 		GetValidatorsByEffectiveBalance() ([]ValidatorT, error)
+		// GetValidatorSet retrieves the voting power of each validator in
+		// the set last sent to CometBFT.
+		GetValidatorSet() (map[math.ValidatorIndex]math.Gwei, error)
+		// SetValidatorSet replaces the validator set last sent to CometBFT.
+		SetValidatorSet(set map[math.ValidatorIndex]math.Gwei) error
+		// GetPendingConsolidations retrieves the target validator index of
+		// each validator whose balance is waiting to be consolidated.
+		GetPendingConsolidations() (
+			map[math.ValidatorIndex]math.ValidatorIndex, error,
+		)
+		// SetPendingConsolidations replaces the pending consolidations.
+		SetPendingConsolidations(
+			consolidations map[math.ValidatorIndex]math.ValidatorIndex,
+		) error
+		// GetProposerSet retrieves the set CometBFT selects the proposer of
+		// the next block from.
+		GetProposerSet() (*transition.ProposerSet, error)
+		// SetProposerSet replaces the set CometBFT selects the proposer of
+		// the next block from.
+		SetProposerSet(set *transition.ProposerSet) error
+		// GetNextProposerSet retrieves the set that replaces the proposer
+		// set after the next block.
+		GetNextProposerSet() (*transition.ProposerSet, error)
+		// SetNextProposerSet replaces the set that replaces the proposer set
+		// after the next block.
+		SetNextProposerSet(set *transition.ProposerSet) error
+		// GetEpochParticipation retrieves the number of commits the
+		// validator signed in the current epoch.
+		GetEpochParticipation(idx math.ValidatorIndex) (uint64, error)
+		// SetEpochParticipation sets the number of commits the validator
+		// signed in the current epoch.
+		SetEpochParticipation(idx math.ValidatorIndex, count uint64) error
+		// GetEpochProposals retrieves the number of blocks the validator
+		// proposed in the current epoch.
+		GetEpochProposals(idx math.ValidatorIndex) (uint64, error)
+		// SetEpochProposals sets the number of blocks the validator proposed
+		// in the current epoch.
+		SetEpochProposals(idx math.ValidatorIndex, count uint64) error
+		// GetEpochCommits retrieves the number of commits recorded in the
+		// current epoch.
+		GetEpochCommits() (uint64, error)
+		// SetEpochCommits sets the number of commits recorded in the current
+		// epoch.
+		SetEpochCommits(count uint64) error
+		// ResetEpochParticipation clears the participation recorded in the
+		// current epoch.
+		ResetEpochParticipation() error
+		// GetInactivityScore retrieves the inactivity score of the
+		// validator.
+		GetInactivityScore(idx math.ValidatorIndex) (uint64, error)
+		// SetInactivityScore sets the inactivity score of the validator.
+		SetInactivityScore(idx math.ValidatorIndex, score uint64) error
 	}
 
 	// ReadOnlyBeaconState is the interface for a read-only beacon state.
@@ -968,6 +1108,7 @@ type (
 		ReadOnlyStateRoots
 		ReadOnlyValidators[ValidatorT]
 		ReadOnlyWithdrawals[WithdrawalT]
+		ReadOnlyParticipation
 
 		// GetBalances retrieves all balances.
 		GetBalances() ([]uint64, error)
@@ -985,6 +1126,10 @@ type (
 		GetNextWithdrawalValidatorIndex() (math.ValidatorIndex, error)
 		GetTotalValidators() (uint64, error)
 		GetValidatorsByEffectiveBalance() ([]ValidatorT, error)
+		GetValidatorSet() (map[math.ValidatorIndex]math.Gwei, error)
+		GetPendingConsolidations() (
+			map[math.ValidatorIndex]math.ValidatorIndex, error,
+		)
 		ValidatorIndexByCometBFTAddress(
 			cometBFTAddress []byte,
 		) (math.ValidatorIndex, error)
@@ -999,6 +1144,7 @@ type (
 		WriteOnlyRandaoMixes
 		WriteOnlyStateRoots
 		WriteOnlyValidators[ValidatorT]
+		WriteOnlyParticipation
 
 		SetGenesisValidatorsRoot(root common.Root) error
 		SetFork(ForkT) error
@@ -1048,6 +1194,12 @@ type (
 
 		AddValidator(ValidatorT) error
 		AddValidatorBartio(ValidatorT) error
+		SetValidatorSet(map[math.ValidatorIndex]math.Gwei) error
+		SetPendingConsolidations(
+			map[math.ValidatorIndex]math.ValidatorIndex,
+		) error
+		SetProposerSet(*transition.ProposerSet) error
+		SetNextProposerSet(*transition.ProposerSet) error
 	}
 
 	// ReadOnlyValidators has read access to validator methods.
@@ -1059,6 +1211,9 @@ type (
 		ValidatorByIndex(
 			math.ValidatorIndex,
 		) (ValidatorT, error)
+
+		GetProposerSet() (*transition.ProposerSet, error)
+		GetNextProposerSet() (*transition.ProposerSet, error)
 	}
 
 	// WriteOnlyEth1Data has write access to eth1 data.
@@ -1083,6 +1238,27 @@ type (
 	ReadOnlyWithdrawals[WithdrawalT any] interface {
 		ExpectedWithdrawals() ([]WithdrawalT, error)
 	}
+
+	// WriteOnlyParticipation has write access to the participation recorded
+	// during the current epoch.
+	WriteOnlyParticipation interface {
+		SetEpochParticipation(math.ValidatorIndex, uint64) error
+		SetEpochProposals(math.ValidatorIndex, uint64) error
+		SetEpochCommits(uint64) error
+		ResetEpochParticipation() error
+		SetInactivityScore(math.ValidatorIndex, uint64) error
+	}
+
+	// ReadOnlyParticipation has read access to the participation recorded
+	// during the current epoch.
+	ReadOnlyParticipation interface {
+		GetEpochParticipation(math.ValidatorIndex) (uint64, error)
+		GetEpochProposals(math.ValidatorIndex) (uint64, error)
+		GetEpochCommits() (uint64, error)
+		GetInactivityScore(math.ValidatorIndex) (uint64, error)
+		BaseReward(math.ValidatorIndex) (math.Gwei, error)
+		EpochRewards() ([]*transition.Rewards, error)
+	}
 )
 
 // /* --------------------------------------------------------------------------
@@ -1094,6 +1270,7 @@ type (
 	NodeAPIContext interface {
 		Bind(any) error
 		Validate(any) error
+		Request() *http.Request
 	}
 
 	// Engine is a generic interface for an API engine.
@@ -1121,6 +1298,9 @@ type (
 		NodeAPIProofBackend[
 			BeaconBlockHeaderT, BeaconStateT, ForkT, ValidatorT,
 		]
+		DutiesBackend
+		WithdrawalsBackend
+		NetworkBackend
 	}
 
 	// NodeAPIBackend is the interface for backend of the beacon API.
@@ -1129,7 +1309,9 @@ type (
 	] interface {
 		GenesisBackend
 		BlockBackend[BeaconBlockHeaderT]
+		PoolBackend
 		RandaoBackend
+		RewardsBackend
 		StateBackend[BeaconStateT, ForkT]
 		ValidatorBackend[ValidatorT]
 		HistoricalBackend[ForkT]
@@ -1157,16 +1339,61 @@ type (
 		StateForkAtSlot(slot math.Slot) (ForkT, error)
 	}
 
+	PoolBackend interface {
+		SubmitProposerSlashing(slashing *ctypes.ProposerSlashing) error
+		SubmitVoluntaryExit(exit *ctypes.SignedVoluntaryExit) error
+		SubmitBLSToExecutionChanges(
+			changes []*ctypes.SignedBLSToExecutionChange,
+		) ([]error, error)
+		PoolProposerSlashings() []*ctypes.ProposerSlashing
+		PoolVoluntaryExits() []*ctypes.SignedVoluntaryExit
+		PoolBLSToExecutionChanges() []*ctypes.SignedBLSToExecutionChange
+	}
+
 	RandaoBackend interface {
 		RandaoAtEpoch(slot math.Slot, epoch math.Epoch) (common.Bytes32, error)
 	}
 
 	BlockBackend[BeaconBlockHeaderT any] interface {
 		BlockRootAtSlot(slot math.Slot) (common.Root, error)
-		BlockRewardsAtSlot(slot math.Slot) (*types.BlockRewardsData, error)
 		BlockHeaderAtSlot(slot math.Slot) (BeaconBlockHeaderT, error)
 	}
 
+	RewardsBackend interface {
+		BlockRewardsAtSlot(slot math.Slot) (*types.BlockRewardsData, error)
+		AttestationRewardsAtEpoch(
+			epoch math.Epoch, ids []string,
+		) (*types.AttestationRewardsData, error)
+	}
+
+	DutiesBackend interface {
+		ProposerDutiesAtEpoch(
+			epoch math.Epoch,
+		) (common.Root, []*validatortypes.ProposerDutyData, error)
+		ProduceBlock(
+			ctx context.Context,
+			slot math.Slot,
+			reveal *crypto.BLSSignature,
+		) (string, *validatortypes.BlockProposalData, error)
+	}
+
+	WithdrawalsBackend interface {
+		ExpectedWithdrawals(
+			slot, proposalSlot math.Slot,
+		) ([]*buildertypes.WithdrawalData, error)
+		WithdrawalSweep(
+			slot math.Slot, payloads uint64, ids []string,
+		) (*buildertypes.WithdrawalSweepData, error)
+	}
+
+	NetworkBackend interface {
+		NodeIdentity() (*nodetypes.IdentityData, error)
+		Peers(states, directions []string) ([]*nodetypes.PeerData, error)
+		PeerByID(id string) (*nodetypes.PeerData, error)
+		PeerCount() (*nodetypes.PeerCountData, error)
+		Syncing(ctx context.Context) (*nodetypes.SyncingData, error)
+	}
+
 	StateBackend[BeaconStateT, ForkT any] interface {
 		StateRootAtSlot(slot math.Slot) (common.Root, error)
 		StateForkAtSlot(slot math.Slot) (ForkT, error)
@@ -31,6 +31,8 @@ import (
 func ProvideNode(
 	registry *service.Registry,
 	logger *phuslu.Logger,
+	replayer types.BlockReplayer,
+	exporter types.StateExporter,
 ) types.Node {
-	return node.New[types.Node](registry, logger)
+	return node.New[types.Node](registry, logger, replayer, exporter)
 }
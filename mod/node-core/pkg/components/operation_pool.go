@@ -0,0 +1,80 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+import (
+	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/mod/beacon/pool"
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+)
+
+// OperationPoolInput is the input for the operation pool provider.
+type OperationPoolInput[
+	BeaconStateT any,
+	LoggerT any,
+] struct {
+	depinject.In
+	ChainSpec      common.ChainSpec
+	Dispatcher     Dispatcher
+	Logger         LoggerT
+	StateProcessor pool.OperationValidator[
+		BeaconStateT, *SignedBLSToExecutionChange,
+		*ProposerSlashing, *SignedVoluntaryExit,
+	]
+	StorageBackend pool.StorageBackend[BeaconStateT]
+}
+
+// ProvideOperationPool is a depinject provider for the operation pool.
+func ProvideOperationPool[
+	BeaconBlockT BeaconBlock[
+		BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
+	],
+	BeaconBlockBodyT BeaconBlockBody[
+		BeaconBlockBodyT, *AttestationData, DepositT,
+		*Eth1Data, ExecutionPayloadT, *SlashingInfo,
+	],
+	BeaconBlockHeaderT any,
+	BeaconStateT pool.BeaconState,
+	DepositT any,
+	ExecutionPayloadT any,
+	LoggerT log.AdvancedLogger[LoggerT],
+](
+	in OperationPoolInput[BeaconStateT, LoggerT],
+) *pool.OperationPool[
+	BeaconBlockT, BeaconBlockBodyT, BeaconStateT,
+	*SignedBLSToExecutionChange, *ProposerSlashing, *SignedVoluntaryExit,
+] {
+	return pool.NewOperationPool[
+		BeaconBlockT,
+		BeaconBlockBodyT,
+		BeaconStateT,
+		*SignedBLSToExecutionChange,
+		*ProposerSlashing,
+		*SignedVoluntaryExit,
+	](
+		in.Logger.With("service", "operation-pool"),
+		in.ChainSpec,
+		in.StateProcessor,
+		in.StorageBackend,
+		in.Dispatcher,
+	)
+}
@@ -55,7 +55,8 @@ type LocalBuilderInput[
 		PayloadID,
 		WithdrawalsT,
 	]
-	Logger LoggerT
+	Logger         LoggerT
+	PayloadIDCache *cache.PayloadIDCache[PayloadID, [32]byte, math.Slot]
 }
 
 // ProvideLocalBuilder provides a local payload builder for the
@@ -93,10 +94,15 @@ func ProvideLocalBuilder[
 		in.ChainSpec,
 		in.Logger.With("service", "payload-builder"),
 		in.ExecutionEngine,
-		cache.NewPayloadIDCache[
-			PayloadID,
-			[32]byte, math.Slot,
-		](),
+		in.PayloadIDCache,
 		in.AttributesFactory,
 	)
 }
+
+// ProvidePayloadIDCache provides the payload ID cache shared by the local
+// payload builder and the admin API.
+func ProvidePayloadIDCache() *cache.PayloadIDCache[
+	PayloadID, [32]byte, math.Slot,
+] {
+	return cache.NewPayloadIDCache[PayloadID, [32]byte, math.Slot]()
+}
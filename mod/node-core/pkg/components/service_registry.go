@@ -23,6 +23,7 @@ package components
 import (
 	"cosmossdk.io/depinject"
 	"github.com/berachain/beacon-kit/mod/beacon/blockchain"
+	"github.com/berachain/beacon-kit/mod/beacon/pool"
 	"github.com/berachain/beacon-kit/mod/beacon/validator"
 	cometbft "github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service"
 	"github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service/middleware"
@@ -32,6 +33,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/execution/pkg/deposit"
 	"github.com/berachain/beacon-kit/mod/log"
 	blockstore "github.com/berachain/beacon-kit/mod/node-api/block_store"
+	responsecache "github.com/berachain/beacon-kit/mod/node-api/response_cache"
 	"github.com/berachain/beacon-kit/mod/node-api/server"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/metrics"
 	service "github.com/berachain/beacon-kit/mod/node-core/pkg/services/registry"
@@ -85,7 +87,8 @@ type ServiceRegistryInput[
 		ExecutionPayloadHeaderT, GenesisT,
 		*engineprimitives.PayloadAttributes[WithdrawalT],
 	]
-	DAService *da.Service[
+	ConfigReloader *ConfigReloader
+	DAService      *da.Service[
 		AvailabilityStoreT,
 		ConsensusSidecarsT, BlobSidecarsT, BeaconBlockHeaderT,
 	]
@@ -99,8 +102,13 @@ type ServiceRegistryInput[
 		ExecutionPayloadT,
 		*engineprimitives.PayloadAttributes[WithdrawalT],
 	]
-	Logger           LoggerT
-	NodeAPIServer    *server.Server[NodeAPIContextT]
+	Logger        LoggerT
+	NodeAPIServer *server.Server[NodeAPIContextT]
+	OperationPool *pool.OperationPool[
+		BeaconBlockT, BeaconBlockBodyT, BeaconStateT,
+		*SignedBLSToExecutionChange, *ProposerSlashing, *SignedVoluntaryExit,
+	]
+	ResponseCache    *responsecache.Service[BeaconBlockT]
 	ReportingService *ReportingService
 	TelemetrySink    *metrics.TelemetrySink
 	TelemetryService *telemetry.Service
@@ -165,7 +173,10 @@ func ProvideServiceRegistry[
 		service.WithService(in.DAService),
 		service.WithService(in.DepositService),
 		service.WithService(in.NodeAPIServer),
+		service.WithService(in.OperationPool),
+		service.WithService(in.ResponseCache),
 		service.WithService(in.ReportingService),
+		service.WithService(in.ConfigReloader),
 		service.WithService(in.DBManager),
 		service.WithService(in.EngineClient),
 		service.WithService(in.TelemetryService),
@@ -0,0 +1,63 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+import (
+	"cosmossdk.io/depinject"
+	cometbft "github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service"
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/debug"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+)
+
+// StateExporterInput is the input for the state exporter provider.
+type StateExporterInput[
+	LoggerT log.AdvancedLogger[LoggerT],
+	StorageBackendT any,
+] struct {
+	depinject.In
+	ChainSpec       common.ChainSpec
+	CometBFTService *cometbft.Service[LoggerT]
+	StorageBackend  StorageBackendT
+}
+
+// ProvideStateExporter is a depinject provider for the state exporter.
+func ProvideStateExporter[
+	BeaconStateT debug.ExportedState[
+		BeaconStateMarshallableT, ExecutionPayloadHeaderT,
+	],
+	BeaconStateMarshallableT any,
+	ExecutionPayloadHeaderT debug.ExecutionPayloadHeader[ExecutionPayloadHeaderT],
+	LoggerT log.AdvancedLogger[LoggerT],
+	StorageBackendT debug.StorageBackend[BeaconStateT],
+](
+	in StateExporterInput[LoggerT, StorageBackendT],
+) *debug.StateExporter[
+	BeaconStateT, BeaconStateMarshallableT, ExecutionPayloadHeaderT,
+] {
+	return debug.NewStateExporter[
+		BeaconStateT, BeaconStateMarshallableT, ExecutionPayloadHeaderT,
+	](
+		in.ChainSpec,
+		in.CometBFTService,
+		in.StorageBackend,
+	)
+}
@@ -27,6 +27,7 @@ import (
 	consruntimetypes "github.com/berachain/beacon-kit/mod/consensus/pkg/types"
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/services/reload"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/services/version"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/async"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
@@ -38,6 +39,9 @@ import (
 /* -------------------------------------------------------------------------- */
 
 type (
+	// ConfigReloader is a type alias for the config reloader.
+	ConfigReloader = reload.Service
+
 	// DBManager is a type alias for the database manager.
 	DBManager = manager.DBManager
 
@@ -65,6 +69,16 @@ type (
 	// ForkData is a type alias for the fork data.
 	ForkData = types.ForkData
 
+	// ProposerSlashing is a type alias for the proposer slashing.
+	ProposerSlashing = types.ProposerSlashing
+
+	// SignedBLSToExecutionChange is a type alias for the signed BLS to
+	// execution change.
+	SignedBLSToExecutionChange = types.SignedBLSToExecutionChange
+
+	// SignedVoluntaryExit is a type alias for the signed voluntary exit.
+	SignedVoluntaryExit = types.SignedVoluntaryExit
+
 	// SlotData is a type alias for the incoming slot.
 	SlotData = consruntimetypes.SlotData[
 		*AttestationData,
@@ -34,6 +34,7 @@ import (
 type ValidatorServiceInput[
 	AvailabilityStoreT any,
 	BeaconBlockT any,
+	BeaconBlockBodyT any,
 	BeaconStateT any,
 	BlobSidecarsT any,
 	DepositT any,
@@ -52,6 +53,7 @@ type ValidatorServiceInput[
 	Dispatcher     Dispatcher
 	LocalBuilder   LocalBuilder[BeaconStateT, ExecutionPayloadT]
 	Logger         LoggerT
+	OperationPool  OperationPool[BeaconStateT, BeaconBlockBodyT]
 	StateProcessor StateProcessor[
 		BeaconBlockT, BeaconStateT, *Context, DepositT, ExecutionPayloadHeaderT,
 	]
@@ -95,7 +97,7 @@ func ProvideValidatorService[
 	WithdrawalsT Withdrawals[WithdrawalT],
 ](
 	in ValidatorServiceInput[
-		AvailabilityStoreT, BeaconBlockT, BeaconStateT,
+		AvailabilityStoreT, BeaconBlockT, BeaconBlockBodyT, BeaconStateT,
 		BlobSidecarsT, DepositT, ExecutionPayloadT, ExecutionPayloadHeaderT,
 		LoggerT, StorageBackendT, WithdrawalT, WithdrawalsT,
 	],
@@ -132,6 +134,7 @@ func ProvideValidatorService[
 		[]validator.PayloadBuilder[BeaconStateT, ExecutionPayloadT]{
 			in.LocalBuilder,
 		},
+		in.OperationPool,
 		in.TelemetrySink,
 		in.Dispatcher,
 	), nil
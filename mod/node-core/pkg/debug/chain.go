@@ -0,0 +1,106 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package debug
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/types"
+)
+
+// ReplayChain replays the blocks finalized after the From height up to the
+// To height on the state committed at the From height, and checks the state
+// root of every replayed block against the one it commits to. It stops at
+// the first mismatch or failed transition, which is returned.
+//
+// The replayed states are never committed, the committed state is only read.
+func (r *BlockReplayer[BeaconBlockT, _, _]) ReplayChain(
+	ctx context.Context,
+	req *types.ChainReplayRequest,
+	w io.Writer,
+) error {
+	if req.From <= 0 || req.To <= req.From {
+		return errors.Wrapf(ErrInvalidReplayRange,
+			"from %d to %d", req.From, req.To,
+		)
+	}
+
+	if req.VerifyPayloads {
+		if err := r.engineClient.Start(ctx); err != nil {
+			return errors.Wrap(err, "failed to connect to execution client")
+		}
+	}
+
+	queryCtx, err := r.queryCtx.CreateQueryContext(req.From, false)
+	if err != nil {
+		return err
+	}
+	st := r.sb.StateFromContext(queryCtx)
+
+	fmt.Fprintf(w, "replaying blocks %d to %d on state at height %d\n",
+		req.From+1, req.To, req.From)
+	var (
+		start    = time.Now()
+		replayed int64
+		blkReq   *types.ReplayRequest
+		blk      BeaconBlockT
+	)
+	defer func() {
+		elapsed := time.Since(start)
+		fmt.Fprintf(w, "replayed %d block(s) in %s (%.2f blocks/s)\n",
+			replayed, elapsed, float64(replayed)/elapsed.Seconds())
+	}()
+
+	for height := req.From + 1; height <= req.To; height++ {
+		if err = ctx.Err(); err != nil {
+			return err
+		}
+
+		if blkReq, err = req.LoadBlock(height); err != nil {
+			return err
+		}
+		if blk, err = r.decodeBlock(blkReq); err != nil {
+			return err
+		}
+
+		if _, err = r.sp.Transition(
+			newTransitionContext(ctx, blkReq, req.VerifyPayloads), st, blk,
+		); err != nil {
+			fmt.Fprintf(w, "first mismatch at height %d (slot %d): "+
+				"transition failed: %v\n", height, blk.GetSlot(), err)
+			return errors.Wrapf(err, "height %d", height)
+		}
+		if root := st.HashTreeRoot(); root != blk.GetStateRoot() {
+			fmt.Fprintf(w, "first mismatch at height %d (slot %d): "+
+				"replayed state root %s, block state root %s\n",
+				height, blk.GetSlot(), root, blk.GetStateRoot())
+			return errors.Wrapf(ErrStateRootMismatch, "height %d", height)
+		}
+		replayed++
+	}
+
+	fmt.Fprintln(w, "no mismatch found")
+	return nil
+}
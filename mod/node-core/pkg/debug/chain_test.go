@@ -0,0 +1,209 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package debug_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/config/pkg/spec"
+	consensustypes "github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/debug"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeBlock is a block encoded as its little endian slot followed by the
+// first byte of its state root.
+type fakeBlock struct {
+	slot math.Slot
+	root common.Root
+}
+
+func (*fakeBlock) NewFromSSZ(bz []byte, _ uint32) (*fakeBlock, error) {
+	return &fakeBlock{
+		slot: math.Slot(binary.LittleEndian.Uint64(bz)),
+		root: common.Root{bz[8]},
+	}, nil
+}
+
+func (b *fakeBlock) GetSlot() math.Slot { return b.slot }
+
+func (b *fakeBlock) GetStateRoot() common.Root { return b.root }
+
+type fakeMarshallable struct{}
+
+func (*fakeMarshallable) Diff(*fakeMarshallable) []consensustypes.FieldDiff {
+	return nil
+}
+
+// fakeState is a state whose root is its slot.
+type fakeState struct {
+	unmerkleized
+	slot math.Slot
+}
+
+// unmerkleized holds none of the fields kept out of the state root.
+type unmerkleized struct{}
+
+func (unmerkleized) GetTotalValidators() (uint64, error) { return 0, nil }
+
+func (unmerkleized) GetEpochParticipation(
+	math.ValidatorIndex,
+) (uint64, error) {
+	return 0, nil
+}
+
+func (unmerkleized) GetEpochProposals(math.ValidatorIndex) (uint64, error) {
+	return 0, nil
+}
+
+func (unmerkleized) GetEpochCommits() (uint64, error) { return 0, nil }
+
+func (unmerkleized) GetInactivityScore(math.ValidatorIndex) (uint64, error) {
+	return 0, nil
+}
+
+func (unmerkleized) GetValidatorSet() (
+	map[math.ValidatorIndex]math.Gwei, error,
+) {
+	return nil, nil
+}
+
+func (unmerkleized) GetPendingConsolidations() (
+	map[math.ValidatorIndex]math.ValidatorIndex, error,
+) {
+	return nil, nil
+}
+
+func (unmerkleized) GetProposerSet() (*transition.ProposerSet, error) {
+	return nil, nil
+}
+
+func (unmerkleized) GetNextProposerSet() (*transition.ProposerSet, error) {
+	return nil, nil
+}
+
+func (*fakeState) GetMarshallable() (*fakeMarshallable, error) {
+	return &fakeMarshallable{}, nil
+}
+
+func (s *fakeState) HashTreeRoot() common.Root {
+	return common.Root{byte(s.slot)}
+}
+
+// fakeProcessor moves the state to the slot of the block.
+type fakeProcessor struct{}
+
+func (fakeProcessor) SetTracer(core.Tracer[*fakeState]) {}
+
+func (fakeProcessor) Transition(
+	_ *transition.Context, st *fakeState, blk *fakeBlock,
+) (transition.ValidatorUpdates, error) {
+	st.slot = blk.slot
+	return nil, nil
+}
+
+// fakeNode provides the state and the engine client of the replayer.
+type fakeNode struct {
+	st            *fakeState
+	engineStarted bool
+}
+
+func (*fakeNode) CreateQueryContext(int64, bool) (sdk.Context, error) {
+	return sdk.Context{}, nil
+}
+
+func (n *fakeNode) StateFromContext(context.Context) *fakeState {
+	return n.st
+}
+
+func (n *fakeNode) Start(context.Context) error {
+	n.engineStarted = true
+	return nil
+}
+
+func TestReplayChain(t *testing.T) {
+	newReplayer := func(node *fakeNode) *debug.BlockReplayer[
+		*fakeBlock, *fakeState, *fakeMarshallable,
+	] {
+		return debug.NewBlockReplayer[
+			*fakeBlock, *fakeState, *fakeMarshallable,
+		](spec.DevnetChainSpec(), node, node, fakeProcessor{}, node)
+	}
+	// Blocks commit to the root of the state at their height, except the
+	// one at badHeight, if any.
+	loadBlock := func(badHeight int64) func(int64) (*types.ReplayRequest, error) {
+		return func(height int64) (*types.ReplayRequest, error) {
+			root := byte(height)
+			if height == badHeight {
+				root++
+			}
+			//#nosec:G115 // heights are positive.
+			blk := binary.LittleEndian.AppendUint64(nil, uint64(height))
+			return &types.ReplayRequest{
+				Height:        height,
+				Block:         append(blk, root),
+				ConsensusTime: time.Now(),
+			}, nil
+		}
+	}
+
+	node := &fakeNode{st: &fakeState{slot: 2}}
+	var out bytes.Buffer
+	require.NoError(t, newReplayer(node).ReplayChain(
+		context.Background(),
+		&types.ChainReplayRequest{From: 2, To: 6, LoadBlock: loadBlock(0)},
+		&out,
+	))
+	require.Equal(t, math.Slot(6), node.st.slot)
+	require.False(t, node.engineStarted)
+	require.Contains(t, out.String(), "replayed 4 block(s)")
+
+	node = &fakeNode{st: &fakeState{slot: 2}}
+	out.Reset()
+	err := newReplayer(node).ReplayChain(
+		context.Background(),
+		&types.ChainReplayRequest{
+			From: 2, To: 6, VerifyPayloads: true, LoadBlock: loadBlock(4),
+		},
+		&out,
+	)
+	require.ErrorIs(t, err, debug.ErrStateRootMismatch)
+	require.True(t, node.engineStarted)
+	require.Contains(t, out.String(), "first mismatch at height 4")
+	require.Contains(t, out.String(), "replayed 1 block(s)")
+
+	err = newReplayer(node).ReplayChain(
+		context.Background(),
+		&types.ChainReplayRequest{From: 6, To: 6, LoadBlock: loadBlock(0)},
+		&out,
+	)
+	require.ErrorIs(t, err, debug.ErrInvalidReplayRange)
+}
@@ -0,0 +1,45 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package debug
+
+import "github.com/berachain/beacon-kit/mod/errors"
+
+var (
+	// ErrInvalidPreStateHeight is returned when the block is replayed on a
+	// state that is not older than the block.
+	ErrInvalidPreStateHeight = errors.New("invalid pre-state height")
+
+	// ErrInvalidReplayRange is returned when the chain segment to replay
+	// holds no block.
+	ErrInvalidReplayRange = errors.New("invalid replay range")
+
+	// ErrStateRootMismatch is returned when the state root of a replayed
+	// block does not match the state root committed to by the block.
+	ErrStateRootMismatch = errors.New("state root mismatch")
+
+	// ErrInvalidExportHeight is returned when the state to export is not
+	// at a committed height.
+	ErrInvalidExportHeight = errors.New("invalid export height")
+
+	// ErrBlockTooShort is returned when the encoded block is too short to
+	// hold its slot.
+	ErrBlockTooShort = errors.New("encoded block too short")
+)
@@ -0,0 +1,137 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package debug
+
+import (
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/json"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+)
+
+// StateExporter exports the committed beacon state as the beacon genesis of
+// a chain restarted from it.
+type StateExporter[
+	BeaconStateT ExportedState[BeaconStateMarshallableT, ExecutionPayloadHeaderT],
+	BeaconStateMarshallableT any,
+	ExecutionPayloadHeaderT ExecutionPayloadHeader[ExecutionPayloadHeaderT],
+] struct {
+	// chainSpec is used to resolve the fork version of the state.
+	chainSpec common.ChainSpec
+	// queryCtx creates contexts over the committed states.
+	queryCtx QueryContextCreator
+	// sb provides the beacon state of the query contexts.
+	sb StorageBackend[BeaconStateT]
+}
+
+// NewStateExporter creates a new state exporter.
+func NewStateExporter[
+	BeaconStateT ExportedState[BeaconStateMarshallableT, ExecutionPayloadHeaderT],
+	BeaconStateMarshallableT any,
+	ExecutionPayloadHeaderT ExecutionPayloadHeader[ExecutionPayloadHeaderT],
+](
+	chainSpec common.ChainSpec,
+	queryCtx QueryContextCreator,
+	sb StorageBackend[BeaconStateT],
+) *StateExporter[
+	BeaconStateT, BeaconStateMarshallableT, ExecutionPayloadHeaderT,
+] {
+	return &StateExporter[
+		BeaconStateT, BeaconStateMarshallableT, ExecutionPayloadHeaderT,
+	]{
+		chainSpec: chainSpec,
+		queryCtx:  queryCtx,
+		sb:        sb,
+	}
+}
+
+// ExportGenesis returns the JSON encoded beacon genesis holding the full
+// beacon state committed at height and its pending consolidations, along
+// with its fork version and latest execution payload header. It has no
+// deposits, since the validators are part of the state.
+func (e *StateExporter[_, _, ExecutionPayloadHeaderT]) ExportGenesis(
+	height int64,
+) ([]byte, error) {
+	if height <= 0 {
+		return nil, errors.Wrapf(ErrInvalidExportHeight, "height %d", height)
+	}
+
+	queryCtx, err := e.queryCtx.CreateQueryContext(height, false)
+	if err != nil {
+		return nil, err
+	}
+	st := e.sb.StateFromContext(queryCtx)
+
+	slot, err := st.GetSlot()
+	if err != nil {
+		return nil, err
+	}
+	header, err := st.GetLatestExecutionPayloadHeader()
+	if err != nil {
+		return nil, err
+	}
+	marshallable, err := st.GetMarshallable()
+	if err != nil {
+		return nil, err
+	}
+	consolidations, err := st.GetPendingConsolidations()
+	if err != nil {
+		return nil, err
+	}
+	state, err := exportState(marshallable, consolidations)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&types.Genesis[*types.Deposit, ExecutionPayloadHeaderT]{
+		ForkVersion: version.FromUint32[common.Version](
+			e.chainSpec.ActiveForkVersionForSlot(slot),
+		),
+		Deposits:               make([]*types.Deposit, 0),
+		ExecutionPayloadHeader: header,
+		State:                  state,
+	})
+}
+
+// exportState returns the JSON encoding of the marshallable state with the
+// pending consolidations, which the marshallable state does not hold, added
+// as a field.
+func exportState(
+	marshallable any,
+	consolidations map[math.ValidatorIndex]math.ValidatorIndex,
+) ([]byte, error) {
+	bz, err := json.Marshal(marshallable)
+	if err != nil {
+		return nil, err
+	}
+	var fields map[string]json.RawMessage
+	if err = json.Unmarshal(bz, &fields); err != nil {
+		return nil, err
+	}
+	if fields["PendingConsolidations"], err = json.Marshal(
+		consolidations,
+	); err != nil {
+		return nil, err
+	}
+	return json.Marshal(fields)
+}
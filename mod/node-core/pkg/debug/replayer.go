@@ -0,0 +1,234 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package debug
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	"github.com/berachain/beacon-kit/mod/state-transition/pkg/trace"
+)
+
+// BlockReplayer replays finalized blocks on the committed state. Single
+// blocks are traced, and the state mutations of every sub-step of their
+// transition are diffed against the committed post-state, while chain
+// segments are replayed to check the state roots of their blocks.
+type BlockReplayer[
+	BeaconBlockT BeaconBlock[BeaconBlockT],
+	BeaconStateT BeaconState[BeaconStateMarshallableT],
+	BeaconStateMarshallableT StateDiffer[BeaconStateMarshallableT],
+] struct {
+	// chainSpec is used to resolve the fork version of the block.
+	chainSpec common.ChainSpec
+	// engineClient is started to verify the execution payloads of the
+	// replayed blocks, if requested.
+	engineClient EngineClient
+	// queryCtx creates contexts over the committed states.
+	queryCtx QueryContextCreator
+	// sp replays the block.
+	sp StateProcessor[BeaconBlockT, BeaconStateT]
+	// sb provides the beacon state of the query contexts.
+	sb StorageBackend[BeaconStateT]
+}
+
+// NewBlockReplayer creates a new block replayer.
+func NewBlockReplayer[
+	BeaconBlockT BeaconBlock[BeaconBlockT],
+	BeaconStateT BeaconState[BeaconStateMarshallableT],
+	BeaconStateMarshallableT StateDiffer[BeaconStateMarshallableT],
+](
+	chainSpec common.ChainSpec,
+	engineClient EngineClient,
+	queryCtx QueryContextCreator,
+	sp StateProcessor[BeaconBlockT, BeaconStateT],
+	sb StorageBackend[BeaconStateT],
+) *BlockReplayer[BeaconBlockT, BeaconStateT, BeaconStateMarshallableT] {
+	return &BlockReplayer[BeaconBlockT, BeaconStateT, BeaconStateMarshallableT]{
+		chainSpec:    chainSpec,
+		engineClient: engineClient,
+		queryCtx:     queryCtx,
+		sp:           sp,
+		sb:           sb,
+	}
+}
+
+// ReplayBlock replays the block of the request on the state at the requested
+// pre-state height, then writes the fields mutated by every sub-step of the
+// transition and the first field diverging from the state committed at the
+// height of the block to w.
+//
+// The transition does not call the execution client and does not fail on a
+// state root mismatch, so that the diverging fields can be reported.
+func (r *BlockReplayer[
+	BeaconBlockT, BeaconStateT, BeaconStateMarshallableT,
+]) ReplayBlock(
+	ctx context.Context,
+	req *types.ReplayRequest,
+	w io.Writer,
+) error {
+	if req.PreStateHeight <= 0 || req.PreStateHeight >= req.Height {
+		return errors.Wrapf(ErrInvalidPreStateHeight,
+			"pre-state height %d for block at height %d",
+			req.PreStateHeight, req.Height,
+		)
+	}
+
+	blk, err := r.decodeBlock(req)
+	if err != nil {
+		return err
+	}
+
+	preCtx, err := r.queryCtx.CreateQueryContext(req.PreStateHeight, false)
+	if err != nil {
+		return err
+	}
+	st := r.sb.StateFromContext(preCtx)
+
+	recorder := trace.NewRecorder[BeaconStateT, BeaconStateMarshallableT]()
+	r.sp.SetTracer(recorder)
+	defer r.sp.SetTracer(nil)
+
+	fmt.Fprintf(w, "replaying block at height %d (slot %d) on state at "+
+		"height %d\n\n", req.Height, blk.GetSlot(), req.PreStateHeight)
+	_, transitionErr := r.sp.Transition(
+		newTransitionContext(ctx, req, false), st, blk,
+	)
+	writeSteps(w, recorder.Steps())
+	if transitionErr != nil {
+		return errors.Wrap(transitionErr, "state transition failed")
+	}
+
+	return r.writeDiff(w, req.Height, blk, st)
+}
+
+// decodeBlock decodes the beacon block of the request with the fork version
+// of its slot, which is the first field of the encoded block.
+func (r *BlockReplayer[BeaconBlockT, _, _]) decodeBlock(
+	req *types.ReplayRequest,
+) (BeaconBlockT, error) {
+	var blk BeaconBlockT
+	if len(req.Block) < bytes.B8Size {
+		return blk, errors.Wrapf(ErrBlockTooShort, "height %d", req.Height)
+	}
+	slot := math.Slot(binary.LittleEndian.Uint64(req.Block))
+	blk, err := blk.NewFromSSZ(
+		req.Block, r.chainSpec.ActiveForkVersionForSlot(slot),
+	)
+	if err != nil {
+		return blk, errors.Wrapf(err,
+			"failed to decode beacon block at height %d", req.Height,
+		)
+	}
+	return blk, nil
+}
+
+// newTransitionContext returns the context replaying the block of the
+// request as it was finalized. The state root is not validated by the
+// transition, so that replays can report the mismatching fields.
+func newTransitionContext(
+	ctx context.Context,
+	req *types.ReplayRequest,
+	verifyPayload bool,
+) *transition.Context {
+	return &transition.Context{
+		Context:                 ctx,
+		OptimisticEngine:        true,
+		SkipPayloadVerification: !verifyPayload,
+		SkipValidateResult:      true,
+		ProposerAddress:         req.ProposerAddress,
+		ConsensusTime:           math.U64(req.ConsensusTime.Unix()),
+		LastCommitVotes:         req.LastCommitVotes,
+	}
+}
+
+// writeDiff writes the state root of the replayed state and its first field
+// diverging from the state committed at height, the fields kept out of the
+// state root included.
+func (r *BlockReplayer[BeaconBlockT, BeaconStateT, _]) writeDiff(
+	w io.Writer,
+	height int64,
+	blk BeaconBlockT,
+	st BeaconStateT,
+) error {
+	postCtx, err := r.queryCtx.CreateQueryContext(height, false)
+	if err != nil {
+		return err
+	}
+	storedSt := r.sb.StateFromContext(postCtx)
+	stored, err := storedSt.GetMarshallable()
+	if err != nil {
+		return err
+	}
+	storedUnmerkleized, err := trace.NewUnmerkleized(storedSt)
+	if err != nil {
+		return err
+	}
+	replayed, err := st.GetMarshallable()
+	if err != nil {
+		return err
+	}
+	replayedUnmerkleized, err := trace.NewUnmerkleized(st)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(w, "\nstate root: replayed %s, block %s\n",
+		st.HashTreeRoot(), blk.GetStateRoot())
+	// The fields kept out of the state root come last, since they only
+	// affect the state root of the following blocks.
+	diffs := append(
+		replayed.Diff(stored), replayedUnmerkleized.Diff(storedUnmerkleized)...,
+	)
+	if len(diffs) == 0 {
+		fmt.Fprintf(w, "replayed state matches the state at height %d\n",
+			height)
+		return nil
+	}
+	fmt.Fprintf(w, "%d field(s) diverge from the state at height %d, "+
+		"first diverging field (replayed -> stored):\n  %s\n",
+		len(diffs), height, diffs[0])
+	return nil
+}
+
+// writeSteps writes the fields mutated by every traced step.
+func writeSteps(w io.Writer, steps []trace.Step) {
+	for _, s := range steps {
+		switch {
+		case s.Err != nil:
+			fmt.Fprintf(w, "%s: failed: %v\n", s.Name, s.Err)
+		case len(s.Diffs) == 0:
+			fmt.Fprintf(w, "%s: no changes\n", s.Name)
+		default:
+			fmt.Fprintf(w, "%s: %d change(s)\n", s.Name, len(s.Diffs))
+		}
+		for _, diff := range s.Diffs {
+			fmt.Fprintf(w, "  %s\n", diff)
+		}
+	}
+}
@@ -0,0 +1,114 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package debug
+
+import (
+	"context"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core"
+	"github.com/berachain/beacon-kit/mod/state-transition/pkg/trace"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BeaconBlock is the interface of the replayed beacon block.
+type BeaconBlock[BeaconBlockT any] interface {
+	// NewFromSSZ decodes a beacon block of the given fork version.
+	NewFromSSZ([]byte, uint32) (BeaconBlockT, error)
+	// GetSlot returns the slot of the block.
+	GetSlot() math.Slot
+	// GetStateRoot returns the state root the block commits to.
+	GetStateRoot() common.Root
+}
+
+// BeaconState is the interface of the state the block is replayed on.
+type BeaconState[BeaconStateMarshallableT any] interface {
+	trace.UnmerkleizedState
+	// GetMarshallable returns the marshallable version of the state.
+	GetMarshallable() (BeaconStateMarshallableT, error)
+	// HashTreeRoot returns the state root.
+	HashTreeRoot() common.Root
+}
+
+// ExportedState is the interface of the exported beacon state.
+type ExportedState[
+	BeaconStateMarshallableT, ExecutionPayloadHeaderT any,
+] interface {
+	// GetMarshallable returns the marshallable version of the state.
+	GetMarshallable() (BeaconStateMarshallableT, error)
+	// GetSlot returns the slot of the state.
+	GetSlot() (math.Slot, error)
+	// GetLatestExecutionPayloadHeader returns the header of the execution
+	// payload of the latest block.
+	GetLatestExecutionPayloadHeader() (ExecutionPayloadHeaderT, error)
+	// GetPendingConsolidations returns the target validator index of each
+	// validator whose balance is waiting to be consolidated.
+	GetPendingConsolidations() (
+		map[math.ValidatorIndex]math.ValidatorIndex, error,
+	)
+}
+
+// ExecutionPayloadHeader is the interface of the execution payload header
+// of the exported beacon genesis.
+type ExecutionPayloadHeader[T any] interface {
+	// NewFromJSON decodes an execution payload header of the given fork
+	// version.
+	NewFromJSON([]byte, uint32) (T, error)
+}
+
+// StateDiffer is the interface of the marshallable beacon state diffed
+// against the committed post-state.
+type StateDiffer[T any] interface {
+	trace.BeaconStateMarshallable[T]
+}
+
+// EngineClient is the client of the execution client verifying the
+// execution payloads.
+type EngineClient interface {
+	// Start connects to the execution client.
+	Start(context.Context) error
+}
+
+// QueryContextCreator creates contexts reading the committed state at a
+// given height.
+type QueryContextCreator interface {
+	// CreateQueryContext returns a context over the state at height.
+	CreateQueryContext(height int64, prove bool) (sdk.Context, error)
+}
+
+// StateProcessor is the interface of the state processor replaying the
+// block.
+type StateProcessor[BeaconBlockT, BeaconStateT any] interface {
+	// SetTracer sets the tracer observing the transitions.
+	SetTracer(core.Tracer[BeaconStateT])
+	// Transition runs the state transition of the block on the state.
+	Transition(
+		*transition.Context, BeaconStateT, BeaconBlockT,
+	) (transition.ValidatorUpdates, error)
+}
+
+// StorageBackend provides the beacon state of a context.
+type StorageBackend[BeaconStateT any] interface {
+	// StateFromContext returns the beacon state of the context.
+	StateFromContext(context.Context) BeaconStateT
+}
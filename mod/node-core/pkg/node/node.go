@@ -22,6 +22,7 @@ package node
 
 import (
 	"context"
+	"io"
 	"os"
 	"os/signal"
 	"syscall"
@@ -32,8 +33,13 @@ import (
 	"golang.org/x/sync/errgroup"
 )
 
-// Compile-time assertion that node implements the NodeI interface.
-var _ types.Node = (*node)(nil)
+// Compile-time assertions that node implements the NodeI, BlockReplayer and
+// StateExporter interfaces.
+var (
+	_ types.Node          = (*node)(nil)
+	_ types.BlockReplayer = (*node)(nil)
+	_ types.StateExporter = (*node)(nil)
+)
 
 // node is the hard-type representation of the beacon-kit node.
 type node struct {
@@ -41,6 +47,10 @@ type node struct {
 	logger log.Logger
 	// registry is the node's service registry.
 	registry *service.Registry
+	// replayer replays finalized blocks for debugging.
+	replayer types.BlockReplayer
+	// exporter exports the committed beacon state.
+	exporter types.StateExporter
 
 	// TODO: FIX, HACK TO MAKE CLI HAPPY FOR NOW.
 	// THIS SHOULD BE REMOVED EVENTUALLY.
@@ -49,8 +59,17 @@ type node struct {
 
 // New returns a new node.
 func New[NodeT types.Node](
-	registry *service.Registry, logger log.Logger) NodeT {
-	return types.Node(&node{registry: registry, logger: logger}).(NodeT)
+	registry *service.Registry,
+	logger log.Logger,
+	replayer types.BlockReplayer,
+	exporter types.StateExporter,
+) NodeT {
+	return types.Node(&node{
+		registry: registry,
+		logger:   logger,
+		replayer: replayer,
+		exporter: exporter,
+	}).(NodeT)
 }
 
 // Start starts the node.
@@ -75,6 +94,32 @@ func (n *node) Start(
 	return g.Wait()
 }
 
+// ReplayBlock replays a finalized block on the committed state and writes
+// its trace to w.
+func (n *node) ReplayBlock(
+	ctx context.Context,
+	req *types.ReplayRequest,
+	w io.Writer,
+) error {
+	return n.replayer.ReplayBlock(ctx, req, w)
+}
+
+// ReplayChain replays a segment of the finalized chain on the committed
+// state and writes its outcome to w.
+func (n *node) ReplayChain(
+	ctx context.Context,
+	req *types.ChainReplayRequest,
+	w io.Writer,
+) error {
+	return n.replayer.ReplayChain(ctx, req, w)
+}
+
+// ExportGenesis returns the JSON encoded beacon genesis holding the beacon
+// state committed at height.
+func (n *node) ExportGenesis(height int64) ([]byte, error) {
+	return n.exporter.ExportGenesis(height)
+}
+
 // listenForQuitSignals listens for SIGINT and SIGTERM. When a signal is
 // received,
 // the cleanup function is called, indicating the caller can gracefully exit or
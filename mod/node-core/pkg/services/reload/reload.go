@@ -0,0 +1,224 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package reload
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"reflect"
+	"strings"
+	"syscall"
+
+	"github.com/berachain/beacon-kit/mod/config"
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/spf13/viper"
+)
+
+// Service reloads the configuration file when the process receives SIGHUP
+// and applies the settings that are safe to change at runtime:
+//
+//   - logger.log-level and logger.module-levels
+//   - validator.graffiti
+//   - payload-builder.suggested-fee-recipient
+//   - node-api.rate-limit
+//   - block-store-service.availability-window
+//
+// An invalid configuration is rejected as a whole, leaving the running
+// settings untouched. Changes to any other setting require a restart.
+type Service struct {
+	// logger is used to report the outcome of reloads.
+	logger log.Logger
+	// viper is the viper instance the configuration file was read into.
+	viper *viper.Viper
+	// cfg is the configuration currently in effect.
+	cfg config.Config
+	// logLevels changes the log levels of the node.
+	logLevels LogLevelController
+	// graffiti changes the graffiti of built blocks.
+	graffiti GraffitiSetter
+	// feeRecipient changes the suggested fee recipient of built payloads.
+	feeRecipient FeeRecipientSetter
+	// rateLimiter changes the node API rate limits.
+	rateLimiter RateLimiter
+	// blockStore changes the block store availability window.
+	blockStore BlockStore
+}
+
+// NewService creates a new configuration reload service.
+func NewService(
+	logger log.Logger,
+	v *viper.Viper,
+	cfg *config.Config,
+	logLevels LogLevelController,
+	graffiti GraffitiSetter,
+	feeRecipient FeeRecipientSetter,
+	rateLimiter RateLimiter,
+	blockStore BlockStore,
+) *Service {
+	return &Service{
+		logger:       logger,
+		viper:        v,
+		cfg:          *cfg,
+		logLevels:    logLevels,
+		graffiti:     graffiti,
+		feeRecipient: feeRecipient,
+		rateLimiter:  rateLimiter,
+		blockStore:   blockStore,
+	}
+}
+
+// Name returns the name of the service.
+func (*Service) Name() string {
+	return "config-reloader"
+}
+
+// Start listens for SIGHUP and reloads the configuration on each signal.
+func (s *Service) Start(ctx context.Context) error {
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	go func() {
+		defer signal.Stop(hup)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-hup:
+				s.Reload()
+			}
+		}
+	}()
+	return nil
+}
+
+// Reload re-reads the configuration file and applies the settings that are
+// safe to change at runtime.
+func (s *Service) Reload() {
+	if s.viper == nil || s.viper.ConfigFileUsed() == "" {
+		s.logger.Warn("No configuration file to reload")
+		return
+	}
+	if err := s.viper.MergeInConfig(); err != nil {
+		s.logger.Error("Failed to read configuration file", "error", err)
+		return
+	}
+	next, err := config.ReadConfigFromAppOpts(s.viper)
+	if err != nil {
+		s.logger.Error(
+			"Rejected configuration reload, keeping current settings",
+			"error", err,
+		)
+		return
+	}
+
+	if err = s.apply(next); err != nil {
+		s.logger.Error("Failed to apply configuration", "error", err)
+		return
+	}
+
+	applied := s.cfg
+	copyReloadable(&applied, next)
+	if sections := changedSections(&applied, next); len(sections) > 0 {
+		s.logger.Warn(
+			"Configuration contains changes that require a restart to "+
+				"take effect",
+			"sections", strings.Join(sections, ","),
+		)
+	}
+	s.cfg = applied
+	s.logger.Info(
+		"Configuration reloaded", "file", s.viper.ConfigFileUsed(),
+	)
+}
+
+// apply pushes the reloadable settings of next that differ from the current
+// configuration to the running services.
+func (s *Service) apply(next *config.Config) error {
+	cur := &s.cfg
+	if next.Logger.LogLevel != cur.Logger.LogLevel {
+		if err := s.logLevels.SetLevel(next.Logger.LogLevel); err != nil {
+			return err
+		}
+	}
+	if next.Logger.ModuleLevels != cur.Logger.ModuleLevels {
+		if err := s.logLevels.SetModuleLevels(
+			next.Logger.ModuleLevels,
+		); err != nil {
+			return err
+		}
+	}
+	if next.Logger.LogLevel != cur.Logger.LogLevel ||
+		next.Logger.ModuleLevels != cur.Logger.ModuleLevels {
+		s.logger.Info(
+			"Log levels updated",
+			"log_level", next.Logger.LogLevel,
+			"module_levels", next.Logger.ModuleLevels,
+		)
+	}
+	if next.Validator.Graffiti != cur.Validator.Graffiti {
+		s.graffiti.SetGraffiti(next.Validator.Graffiti)
+	}
+	if next.PayloadBuilder.SuggestedFeeRecipient !=
+		cur.PayloadBuilder.SuggestedFeeRecipient {
+		s.feeRecipient.SetSuggestedFeeRecipient(
+			next.PayloadBuilder.SuggestedFeeRecipient,
+		)
+	}
+	if next.NodeAPI.RateLimit != cur.NodeAPI.RateLimit {
+		s.rateLimiter.SetRateLimit(next.NodeAPI.RateLimit)
+	}
+	if next.BlockStoreService.AvailabilityWindow !=
+		cur.BlockStoreService.AvailabilityWindow {
+		s.blockStore.Resize(next.BlockStoreService.AvailabilityWindow)
+	}
+	return nil
+}
+
+// copyReloadable copies the settings that are safe to change at runtime from
+// src to dst.
+func copyReloadable(dst, src *config.Config) {
+	dst.Logger.LogLevel = src.Logger.LogLevel
+	dst.Logger.ModuleLevels = src.Logger.ModuleLevels
+	dst.Validator.Graffiti = src.Validator.Graffiti
+	dst.PayloadBuilder.SuggestedFeeRecipient =
+		src.PayloadBuilder.SuggestedFeeRecipient
+	dst.NodeAPI.RateLimit = src.NodeAPI.RateLimit
+	dst.BlockStoreService.AvailabilityWindow =
+		src.BlockStoreService.AvailabilityWindow
+}
+
+// changedSections returns the keys of the configuration sections that differ
+// between a and b, e.g. "beacon-kit.engine".
+func changedSections(a, b *config.Config) []string {
+	var (
+		sections []string
+		va       = reflect.ValueOf(a).Elem()
+		vb       = reflect.ValueOf(b).Elem()
+	)
+	for i := range va.NumField() {
+		if !reflect.DeepEqual(
+			va.Field(i).Interface(), vb.Field(i).Interface(),
+		) {
+			sections = append(sections, "beacon-kit."+
+				va.Type().Field(i).Tag.Get("mapstructure"))
+		}
+	}
+	return sections
+}
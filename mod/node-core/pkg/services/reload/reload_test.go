@@ -0,0 +1,112 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package reload_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"text/template"
+
+	"github.com/berachain/beacon-kit/mod/config"
+	tmpl "github.com/berachain/beacon-kit/mod/config/pkg/template"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/node-api/server"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/services/reload"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+type recorder struct {
+	level        string
+	graffiti     string
+	feeRecipient common.ExecutionAddress
+	rateLimit    server.RateLimitConfig
+	window       int
+}
+
+func (r *recorder) SetLevel(level string) error {
+	r.level = level
+	return nil
+}
+
+func (r *recorder) SetModuleLevels(string) error { return nil }
+
+func (r *recorder) SetGraffiti(graffiti string) { r.graffiti = graffiti }
+
+func (r *recorder) SetSuggestedFeeRecipient(addr common.ExecutionAddress) {
+	r.feeRecipient = addr
+}
+
+func (r *recorder) SetRateLimit(cfg server.RateLimitConfig) {
+	r.rateLimit = cfg
+}
+
+func (r *recorder) Resize(window int) { r.window = window }
+
+// writeConfig renders the beacon-kit configuration template to path.
+func writeConfig(t *testing.T, path string, cfg *config.Config) {
+	t.Helper()
+	var buf bytes.Buffer
+	require.NoError(t, template.Must(template.New("").Parse(tmpl.TomlTemplate)).
+		Execute(&buf, struct{ BeaconKit *config.Config }{cfg}))
+	require.NoError(t, os.WriteFile(path, buf.Bytes(), 0o600))
+}
+
+func TestService_Reload(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "app.toml")
+	cfg := config.DefaultConfig()
+	writeConfig(t, path, cfg)
+
+	v := viper.New()
+	v.SetConfigFile(path)
+	require.NoError(t, v.ReadInConfig())
+	rec := &recorder{}
+	svc := reload.NewService(
+		noop.NewLogger[any](), v, cfg, rec, rec, rec, rec, rec,
+	)
+
+	// Reloadable settings are pushed to the running services.
+	next := config.DefaultConfig()
+	next.Logger.LogLevel = "debug"
+	next.Validator.Graffiti = "reloaded"
+	next.PayloadBuilder.SuggestedFeeRecipient = common.ExecutionAddress{1}
+	next.NodeAPI.RateLimit.Enabled = true
+	next.BlockStoreService.AvailabilityWindow = 64
+	writeConfig(t, path, next)
+	svc.Reload()
+	require.Equal(t, "debug", rec.level)
+	require.Equal(t, "reloaded", rec.graffiti)
+	require.Equal(t, common.ExecutionAddress{1}, rec.feeRecipient)
+	require.True(t, rec.rateLimit.Enabled)
+	require.Equal(t, 64, rec.window)
+
+	// Invalid configurations are rejected as a whole.
+	invalid := *next
+	invalid.Logger.LogLevel = "info"
+	invalid.Validator.Graffiti = strings.Repeat("x", 33)
+	writeConfig(t, path, &invalid)
+	svc.Reload()
+	require.Equal(t, "debug", rec.level)
+	require.Equal(t, "reloaded", rec.graffiti)
+}
@@ -0,0 +1,59 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package reload
+
+import (
+	"github.com/berachain/beacon-kit/mod/node-api/server"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+)
+
+// LogLevelController changes the log levels of the node at runtime.
+type LogLevelController interface {
+	// SetLevel sets the global log level.
+	SetLevel(level string) error
+	// SetModuleLevels replaces all module level overrides with the given
+	// comma separated list of module=level pairs.
+	SetModuleLevels(moduleLevels string) error
+}
+
+// GraffitiSetter changes the graffiti of built blocks at runtime.
+type GraffitiSetter interface {
+	// SetGraffiti sets the graffiti included in built blocks.
+	SetGraffiti(graffiti string)
+}
+
+// FeeRecipientSetter changes the suggested fee recipient of built payloads
+// at runtime.
+type FeeRecipientSetter interface {
+	// SetSuggestedFeeRecipient sets the suggested fee recipient.
+	SetSuggestedFeeRecipient(suggestedFeeRecipient common.ExecutionAddress)
+}
+
+// RateLimiter changes the node API rate limits at runtime.
+type RateLimiter interface {
+	// SetRateLimit replaces the rate limiting configuration.
+	SetRateLimit(cfg server.RateLimitConfig)
+}
+
+// BlockStore changes the availability window of the block store at runtime.
+type BlockStore interface {
+	// Resize changes the availability window of the store.
+	Resize(availabilityWindow int)
+}
@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+)
+
+// ReplayRequest is a finalized block to replay, along with the consensus
+// data it was finalized with.
+type ReplayRequest struct {
+	// Height is the height the block was finalized at.
+	Height int64
+	// PreStateHeight is the height of the state to replay the block on,
+	// usually Height - 1.
+	PreStateHeight int64
+	// Block is the SSZ encoded beacon block.
+	Block []byte
+	// ProposerAddress is the consensus address of the block proposer.
+	ProposerAddress []byte
+	// ConsensusTime is the time of the block.
+	ConsensusTime time.Time
+	// LastCommitVotes are the votes in the commit of the previous block.
+	LastCommitVotes []transition.CommitVote
+}
+
+// ChainReplayRequest is a segment of the finalized chain to replay.
+type ChainReplayRequest struct {
+	// From is the height of the state the segment is replayed on.
+	From int64
+	// To is the height of the last block of the segment.
+	To int64
+	// VerifyPayloads enables the verification of the execution payloads by
+	// the execution client.
+	VerifyPayloads bool
+	// LoadBlock loads the block finalized at the given height.
+	LoadBlock func(height int64) (*ReplayRequest, error)
+}
+
+// BlockReplayer replays finalized blocks to debug state root mismatches
+// and to check the determinism of the state transition.
+type BlockReplayer interface {
+	// ReplayBlock replays the block on the requested pre-state and writes
+	// the trace of the transition and its diff against the stored
+	// post-state to w.
+	ReplayBlock(ctx context.Context, req *ReplayRequest, w io.Writer) error
+	// ReplayChain replays the blocks of the segment in order, checking the
+	// state root of every block, and writes the throughput and the first
+	// mismatch to w.
+	ReplayChain(
+		ctx context.Context, req *ChainReplayRequest, w io.Writer,
+	) error
+}
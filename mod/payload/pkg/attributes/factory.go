@@ -21,6 +21,8 @@
 package attributes
 
 import (
+	"sync/atomic"
+
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
@@ -38,7 +40,7 @@ type Factory[
 	logger log.Logger
 	// suggestedFeeRecipient is the suggested fee recipient sent to
 	// the execution client for the payload build.
-	suggestedFeeRecipient common.ExecutionAddress
+	suggestedFeeRecipient atomic.Pointer[common.ExecutionAddress]
 }
 
 // NewAttributesFactory creates a new instance of AttributesFactory.
@@ -51,11 +53,26 @@ func NewAttributesFactory[
 	logger log.Logger,
 	suggestedFeeRecipient common.ExecutionAddress,
 ) *Factory[BeaconStateT, PayloadAttributesT, WithdrawalT] {
-	return &Factory[BeaconStateT, PayloadAttributesT, WithdrawalT]{
-		chainSpec:             chainSpec,
-		logger:                logger,
-		suggestedFeeRecipient: suggestedFeeRecipient,
+	f := &Factory[BeaconStateT, PayloadAttributesT, WithdrawalT]{
+		chainSpec: chainSpec,
+		logger:    logger,
 	}
+	f.suggestedFeeRecipient.Store(&suggestedFeeRecipient)
+	return f
+}
+
+// SuggestedFeeRecipient returns the suggested fee recipient sent to the
+// execution client.
+func (f *Factory[_, _, _]) SuggestedFeeRecipient() common.ExecutionAddress {
+	return *f.suggestedFeeRecipient.Load()
+}
+
+// SetSuggestedFeeRecipient sets the suggested fee recipient sent to the
+// execution client for payloads built from now on.
+func (f *Factory[_, _, _]) SetSuggestedFeeRecipient(
+	suggestedFeeRecipient common.ExecutionAddress,
+) {
+	f.suggestedFeeRecipient.Store(&suggestedFeeRecipient)
 }
 
 // BuildPayloadAttributes creates a new instance of PayloadAttributes.
@@ -97,7 +114,7 @@ func (f *Factory[
 		f.chainSpec.ActiveForkVersionForEpoch(epoch),
 		timestamp,
 		prevRandao,
-		f.suggestedFeeRecipient,
+		f.SuggestedFeeRecipient(),
 		withdrawals,
 		prevHeadRoot,
 	)
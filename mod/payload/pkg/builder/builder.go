@@ -22,6 +22,7 @@ package builder
 
 import (
 	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/payload/pkg/cache"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
@@ -48,6 +49,9 @@ type PayloadBuilder[
 	// "in-flight" payloads that are being built on
 	// the execution client.
 	pc PayloadCache[PayloadIDT, [32]byte, math.Slot]
+	// dryRunPC is the payload ID cache of dry runs, kept apart from pc so
+	// that dry runs never hand out or replace the ID of a proposed payload.
+	dryRunPC PayloadCache[PayloadIDT, [32]byte, math.Slot]
 	// attributesFactory is used to create attributes for the
 	attributesFactory AttributesFactory[BeaconStateT, PayloadAttributesT]
 }
@@ -81,6 +85,9 @@ func New[
 		ee:                ee,
 		pc:                pc,
 		attributesFactory: af,
+		dryRunPC: cache.NewPayloadIDCache[
+			PayloadIDT, [32]byte, math.Slot,
+		](),
 	}
 }
 
@@ -91,3 +98,19 @@ func (pb *PayloadBuilder[
 ]) Enabled() bool {
 	return pb.cfg.Enabled
 }
+
+// SetSuggestedFeeRecipient sets the suggested fee recipient of payloads built
+// from now on.
+func (pb *PayloadBuilder[
+	BeaconStateT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+	PayloadAttributesT, PayloadIDT, WithdrawalT,
+]) SetSuggestedFeeRecipient(suggestedFeeRecipient common.ExecutionAddress) {
+	if pb.attributesFactory.SuggestedFeeRecipient() == suggestedFeeRecipient {
+		return
+	}
+	pb.attributesFactory.SetSuggestedFeeRecipient(suggestedFeeRecipient)
+	pb.logger.Info(
+		"Suggested fee recipient updated",
+		"suggested_fee_recipient", suggestedFeeRecipient,
+	)
+}
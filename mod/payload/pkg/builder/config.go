@@ -21,6 +21,7 @@
 package builder
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
@@ -56,3 +57,15 @@ func DefaultConfig() Config {
 		PayloadTimeout:        defaultPayloadTimeout,
 	}
 }
+
+// Validate returns an error describing every invalid field of the
+// configuration.
+func (c Config) Validate() error {
+	if c.Enabled && c.PayloadTimeout <= 0 {
+		return fmt.Errorf(
+			"payload-timeout must be greater than zero when the local "+
+				"builder is enabled, got %s", c.PayloadTimeout,
+		)
+	}
+	return nil
+}
@@ -58,6 +58,40 @@ func (pb *PayloadBuilder[
 		return &payloadID, nil
 	}
 
+	payloadID, err := pb.requestPayload(
+		ctx,
+		st,
+		slot,
+		timestamp,
+		parentBlockRoot,
+		headEth1BlockHash,
+		finalEth1BlockHash,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only add to cache if we received back a payload ID.
+	if payloadID != nil {
+		pb.pc.Set(slot, parentBlockRoot, *payloadID)
+	}
+
+	return payloadID, nil
+}
+
+// requestPayload submits a forkchoice update with the payload attributes
+// for the given slot to the execution client and returns the payload ID.
+func (pb *PayloadBuilder[
+	BeaconStateT, _, _, PayloadAttributesT, PayloadIDT, _,
+]) requestPayload(
+	ctx context.Context,
+	st BeaconStateT,
+	slot math.Slot,
+	timestamp uint64,
+	parentBlockRoot common.Root,
+	headEth1BlockHash common.ExecutionHash,
+	finalEth1BlockHash common.ExecutionHash,
+) (*PayloadIDT, error) {
 	// Assemble the payload attributes.
 	attrs, err := pb.attributesFactory.
 		BuildPayloadAttributes(st, slot, timestamp, parentBlockRoot)
@@ -66,8 +100,7 @@ func (pb *PayloadBuilder[
 	}
 
 	// Submit the forkchoice update to the execution client.
-	var payloadID *PayloadIDT
-	payloadID, _, err = pb.ee.NotifyForkchoiceUpdate(
+	payloadID, _, err := pb.ee.NotifyForkchoiceUpdate(
 		ctx, &engineprimitives.ForkchoiceUpdateRequest[PayloadAttributesT]{
 			State: &engineprimitives.ForkchoiceStateV1{
 				HeadBlockHash:      headEth1BlockHash,
@@ -78,16 +111,7 @@ func (pb *PayloadBuilder[
 			ForkVersion:       pb.chainSpec.ActiveForkVersionForSlot(slot),
 		},
 	)
-	if err != nil {
-		return nil, err
-	}
-
-	// Only add to cache if we received back a payload ID.
-	if payloadID != nil {
-		pb.pc.Set(slot, parentBlockRoot, *payloadID)
-	}
-
-	return payloadID, nil
+	return payloadID, err
 }
 
 // RequestPayloadSync request a payload for the given slot and
@@ -126,6 +150,64 @@ func (pb *PayloadBuilder[
 		return nil, ErrNilPayloadID
 	}
 
+	return pb.awaitPayload(ctx, *payloadID, slot)
+}
+
+// RequestPayloadDryRun requests a payload for the given slot and blocks
+// until the payload is delivered, like RequestPayloadSync, but keeps the
+// payload ID apart from the ones of proposed payloads. It is meant for
+// inspecting the payload the execution client would build rather than
+// proposing it. The execution client is only asked to build a payload once
+// per slot and parent block, later dry runs retrieve the payload built
+// since then.
+func (pb *PayloadBuilder[
+	BeaconStateT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+	PayloadAttributesT, PayloadIDT, WithdrawalT,
+]) RequestPayloadDryRun(
+	ctx context.Context,
+	st BeaconStateT,
+	slot math.Slot,
+	timestamp uint64,
+	parentBlockRoot common.Root,
+	parentEth1Hash common.ExecutionHash,
+	finalBlockHash common.ExecutionHash,
+) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error) {
+	if !pb.Enabled() {
+		return nil, ErrPayloadBuilderDisabled
+	}
+
+	if payloadID, found := pb.dryRunPC.Get(slot, parentBlockRoot); found {
+		return pb.getPayload(ctx, payloadID, slot)
+	}
+
+	payloadID, err := pb.requestPayload(
+		ctx,
+		st,
+		slot,
+		timestamp,
+		parentBlockRoot,
+		parentEth1Hash,
+		finalBlockHash,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if payloadID == nil {
+		return nil, ErrNilPayloadID
+	}
+	pb.dryRunPC.Set(slot, parentBlockRoot, *payloadID)
+	return pb.awaitPayload(ctx, *payloadID, slot)
+}
+
+// awaitPayload gives the execution client the payload timeout to build the
+// payload with the given ID and then retrieves it.
+func (pb *PayloadBuilder[
+	_, ExecutionPayloadT, _, _, PayloadIDT, _,
+]) awaitPayload(
+	ctx context.Context,
+	payloadID PayloadIDT,
+	slot math.Slot,
+) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error) {
 	// Wait for the payload to be delivered to the execution client.
 	pb.logger.Info(
 		"Waiting for local payload to be delivered to execution client",
@@ -141,7 +223,7 @@ func (pb *PayloadBuilder[
 	}
 
 	// Get the payload from the execution client.
-	return pb.getPayload(ctx, *payloadID, slot)
+	return pb.getPayload(ctx, payloadID, slot)
 }
 
 // RetrievePayload attempts to pull a previously built payload
@@ -196,12 +278,13 @@ func (pb *PayloadBuilder[
 
 	// If the payload was built by a different builder, something is
 	// wrong the EL<>CL setup.
-	if payload.GetFeeRecipient() != pb.cfg.SuggestedFeeRecipient {
+	suggestedFeeRecipient := pb.attributesFactory.SuggestedFeeRecipient()
+	if payload.GetFeeRecipient() != suggestedFeeRecipient {
 		pb.logger.Warn(
 			"Payload fee recipient does not match suggested fee recipient - "+
 				"please check both your CL and EL configuration",
 			"payload_fee_recipient", payload.GetFeeRecipient(),
-			"suggested_fee_recipient", pb.cfg.SuggestedFeeRecipient,
+			"suggested_fee_recipient", suggestedFeeRecipient,
 		)
 	}
 	return envelope, err
@@ -89,6 +89,10 @@ type AttributesFactory[
 		timestamp uint64,
 		prevHeadRoot [32]byte,
 	) (PayloadAttributesT, error)
+	// SuggestedFeeRecipient returns the suggested fee recipient.
+	SuggestedFeeRecipient() common.ExecutionAddress
+	// SetSuggestedFeeRecipient sets the suggested fee recipient.
+	SetSuggestedFeeRecipient(common.ExecutionAddress)
 }
 
 // PayloadAttributes is the interface for the payload attributes.
@@ -21,6 +21,7 @@
 package cache
 
 import (
+	"maps"
 	"sync"
 )
 
@@ -107,6 +108,22 @@ func (p *PayloadIDCache[PayloadIDT, RootT, SlotT]) Set(
 	innerMap[stateRoot] = pid
 }
 
+// Entries returns a copy of all payload IDs currently in the cache, keyed by
+// slot and state root.
+func (p *PayloadIDCache[
+	PayloadIDT, RootT, SlotT,
+]) Entries() map[SlotT]map[RootT]PayloadIDT {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	entries := make(
+		map[SlotT]map[RootT]PayloadIDT, len(p.slotToStateRootToPayloadID),
+	)
+	for slot, innerMap := range p.slotToStateRootToPayloadID {
+		entries[slot] = maps.Clone(innerMap)
+	}
+	return entries
+}
+
 // UnsafePrunePrior removes payload IDs from the cache for slots less than
 // the specified slot. Only used for testing.
 func (p *PayloadIDCache[_, _, SlotT]) UnsafePrunePrior(
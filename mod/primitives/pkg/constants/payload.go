@@ -38,6 +38,25 @@ const (
 	// execution payload.
 	MaxWithdrawalsPerPayload uint64 = 16
 
+	// MaxProposerSlashings is the maximum number of proposer slashings per
+	// block.
+	MaxProposerSlashings uint64 = 16
+
+	// MaxVoluntaryExits is the maximum number of voluntary exits per block.
+	MaxVoluntaryExits uint64 = 16
+
+	// MaxBlsToExecutionChanges is the maximum number of BLS to execution
+	// changes per block.
+	MaxBlsToExecutionChanges uint64 = 16
+
+	// MaxWithdrawalRequestsPerPayload is the maximum number of execution
+	// layer withdrawal requests per block.
+	MaxWithdrawalRequestsPerPayload uint64 = 16
+
+	// MaxConsolidationRequestsPerPayload is the maximum number of execution
+	// layer consolidation requests per block.
+	MaxConsolidationRequestsPerPayload uint64 = 2
+
 	// MaxBytesPerTx is the maximum number of bytes per transaction.
 	MaxBytesPerTx uint64 = 1073741824
 )
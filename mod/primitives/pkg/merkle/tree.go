@@ -170,6 +170,21 @@ func (m *Tree[RootT]) Insert(item [32]byte, index int) error {
 	return nil
 }
 
+// Copy returns a deep copy of the tree, which can be updated without
+// affecting the original.
+func (m *Tree[RootT]) Copy() *Tree[RootT] {
+	branches := make([][]RootT, len(m.branches))
+	for i, branch := range m.branches {
+		branches[i] = append(make([]RootT, 0, len(branch)), branch...)
+	}
+	return &Tree[RootT]{
+		depth:    m.depth,
+		branches: branches,
+		leaves:   append(make([]RootT, 0, len(m.leaves)), m.leaves...),
+		hasher:   NewHasher[[32]byte](sha256.Hash),
+	}
+}
+
 // Root returns the root of the Merkle tree.
 func (m *Tree[RootT]) Root() [32]byte {
 	return m.branches[len(m.branches)-1][0]
@@ -267,6 +267,25 @@ func TestMerkleTree_VerifyProof_TrieUpdated(t *testing.T) {
 	require.NoError(t, m.Insert(item, 15))
 }
 
+func TestMerkleTree_Copy(t *testing.T) {
+	items := [][32]byte{{1}, {2}, {3}, {4}}
+	m, err := merkle.NewTreeFromLeavesWithDepth(items, 4)
+	require.NoError(t, err)
+	root := m.Root()
+
+	cpy := m.Copy()
+	require.Equal(t, root, cpy.Root())
+	require.NoError(t, cpy.Insert([32]byte{5}, 1))
+	require.NoError(t, cpy.Insert([32]byte{6}, 4))
+	require.Equal(t, root, m.Root(), "original tree should not change")
+
+	expected, err := merkle.NewTreeFromLeavesWithDepth(
+		[][32]byte{{1}, {5}, {3}, {4}, {6}}, 4,
+	)
+	require.NoError(t, err)
+	require.Equal(t, expected.Root(), cpy.Root())
+}
+
 func BenchmarkNewTreeFromLeavesWithDepth(b *testing.B) {
 	treeDepth := uint8(32)
 	items := make([][32]byte, 0)
@@ -35,4 +35,7 @@ var (
 
 	// ErrCreateJWT is returned when a JWT token fails to be created.
 	ErrCreateJWT = errors.New("failed to create JWT token")
+
+	// ErrInvalidToken is returned when a JWT token fails verification.
+	ErrInvalidToken = errors.New("invalid JWT token")
 )
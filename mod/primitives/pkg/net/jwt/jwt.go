@@ -87,6 +87,20 @@ func (s *Secret) BuildSignedToken() (string, error) {
 	return str, nil
 }
 
+// VerifyToken checks that the given token is a valid HS256 JWT signed with
+// the secret. Expiry and issued-at claims are validated if present.
+func (s *Secret) VerifyToken(token string) error {
+	if _, err := gjwt.Parse(
+		token,
+		func(*gjwt.Token) (any, error) { return s[:], nil },
+		gjwt.WithValidMethods([]string{gjwt.SigningMethodHS256.Alg()}),
+		gjwt.WithIssuedAt(),
+	); err != nil {
+		return errors.Wrapf(ErrInvalidToken, "%w", err)
+	}
+	return nil
+}
+
 // String returns the JWT secret as a string with the first 8 characters
 // visible and the rest masked out for security.
 func (s *Secret) String() string {
@@ -291,3 +291,17 @@ func TestSecretComparison(t *testing.T) {
 		"Copied secret should be equal to original",
 	)
 }
+
+func TestVerifyToken(t *testing.T) {
+	secret, err := jwt.NewRandom()
+	require.NoError(t, err)
+	other, err := jwt.NewRandom()
+	require.NoError(t, err)
+
+	token, err := secret.BuildSignedToken()
+	require.NoError(t, err)
+
+	require.NoError(t, secret.VerifyToken(token))
+	require.ErrorIs(t, other.VerifyToken(token), jwt.ErrInvalidToken)
+	require.ErrorIs(t, secret.VerifyToken("not-a-token"), jwt.ErrInvalidToken)
+}
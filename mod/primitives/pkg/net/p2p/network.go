@@ -0,0 +1,50 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package p2p
+
+// NetworkInfo is the state of the node on the p2p network.
+type NetworkInfo struct {
+	// NodeID is the ID of the node.
+	NodeID string
+	// ListenAddress is the address the node accepts peers on.
+	ListenAddress string
+	// Discovery is true if the node takes part in peer discovery.
+	Discovery bool
+	// CatchingUp is true if the node is catching up with the chain.
+	CatchingUp bool
+	// Peers are the peers the node is connected to.
+	Peers []*Peer
+	// Dialing is the number of peers the node is dialing.
+	Dialing int
+}
+
+// Peer is a peer the node is connected to.
+type Peer struct {
+	// ID is the ID of the peer.
+	ID string
+	// Address is the address of the peer.
+	Address string
+	// Outbound is true if the node dialed the peer.
+	Outbound bool
+	// Height is the latest height the peer is known to be at, or 0 if it is
+	// unknown.
+	Height int64
+}
@@ -47,6 +47,13 @@ type Context struct {
 	// ConsensusTime returns the timestamp of current consensus request.
 	// It is used to build next payload and to validate currentpayload.
 	ConsensusTime math.U64
+	// LastCommitVotes are the votes of the validators in the commit of the
+	// previous block. They are only set once the block is finalized.
+	LastCommitVotes []CommitVote
+	// Finalizing indicates whether the block is being finalized, so that
+	// observations about the block are reported once rather than on every
+	// proposal it is built or verified in.
+	Finalizing bool
 }
 
 // GetOptimisticEngine returns whether to optimistically assume the execution
@@ -86,6 +93,17 @@ func (c *Context) GetConsensusTime() math.U64 {
 	return c.ConsensusTime
 }
 
+// GetLastCommitVotes returns the votes of the validators in the commit of
+// the previous block.
+func (c *Context) GetLastCommitVotes() []CommitVote {
+	return c.LastCommitVotes
+}
+
+// GetFinalizing returns whether the block is being finalized.
+func (c *Context) GetFinalizing() bool {
+	return c.Finalizing
+}
+
 // Unwrap returns the underlying standard context.
 func (c *Context) Unwrap() context.Context {
 	return c.Context
@@ -0,0 +1,58 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package transition
+
+import (
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// Proposal is a block built without being proposed, along with the value of
+// its payload and the time taken to build it.
+type Proposal[BeaconBlockT, BlobSidecarsT any] struct {
+	// Block is the built block.
+	Block BeaconBlockT
+	// Sidecars are the blob sidecars of the built block.
+	Sidecars BlobSidecarsT
+	// PayloadValue is the value of the payload to the fee recipient, in Wei.
+	PayloadValue *math.U256
+	// Timings is the time taken by each step of building the block.
+	Timings ProposalTimings
+}
+
+// ProposalTimings is the time taken by each step of building a block. The
+// sidecars and the state root are computed concurrently.
+type ProposalTimings struct {
+	// ProcessSlots is the time taken to process the state up to the slot of
+	// the block.
+	ProcessSlots time.Duration
+	// Payload is the time taken to retrieve the execution payload.
+	Payload time.Duration
+	// Body is the time taken to assemble the block body.
+	Body time.Duration
+	// Sidecars is the time taken to build the blob sidecars.
+	Sidecars time.Duration
+	// StateRoot is the time taken to compute the state root.
+	StateRoot time.Duration
+	// Total is the time taken to build the block.
+	Total time.Duration
+}
@@ -0,0 +1,64 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package transition
+
+import "github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+
+// CommitVote is the vote of a validator in the commit of the previous block,
+// as reported by consensus.
+type CommitVote struct {
+	// Address is the consensus address of the validator.
+	Address []byte
+	// Signed is true if the validator signed the commit.
+	Signed bool
+}
+
+// Rewards is the breakdown of the rewards and penalties of a validator for
+// an epoch.
+type Rewards struct {
+	// ValidatorIndex is the index of the validator.
+	ValidatorIndex math.ValidatorIndex
+	// EffectiveBalance is the effective balance of the validator.
+	EffectiveBalance math.Gwei
+	// Participation is the reward for the commits the validator signed.
+	Participation math.Gwei
+	// MissedParticipation is the penalty for the commits the validator
+	// missed.
+	MissedParticipation math.Gwei
+	// Proposer is the reward for the blocks the validator proposed.
+	Proposer math.Gwei
+	// Inactivity is the penalty for the validator being inactive for more
+	// than the allowed number of epochs.
+	Inactivity math.Gwei
+	// InactivityScore is the number of consecutive epochs, including this
+	// one, in which the validator signed none of the commits.
+	InactivityScore uint64
+}
+
+// Reward returns the total reward of the validator.
+func (r *Rewards) Reward() math.Gwei {
+	return r.Participation + r.Proposer
+}
+
+// Penalty returns the total penalty of the validator.
+func (r *Rewards) Penalty() math.Gwei {
+	return r.MissedParticipation + r.Inactivity
+}
@@ -34,6 +34,28 @@ const (
 	Electra
 )
 
+// Name returns the name of the fork with the given version, as used by the
+// beacon node API. Forks unknown to the API are reported as the fork they
+// extend.
+func Name(version uint32) string {
+	switch version {
+	case Phase0:
+		return "phase0"
+	case Altair:
+		return "altair"
+	case Bellatrix:
+		return "bellatrix"
+	case Capella:
+		return "capella"
+	case Deneb, DenebPlus:
+		return "deneb"
+	case Electra:
+		return "electra"
+	default:
+		return "unknown"
+	}
+}
+
 // FromUint32 returns a Version from a uint32.
 func FromUint32[VersionT ~[4]byte](version uint32) VersionT {
 	versionBz := VersionT{}
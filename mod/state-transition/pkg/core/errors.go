@@ -32,6 +32,10 @@ var (
 
 	ErrProposerMismatch = errors.New("proposer key mismatch")
 
+	// ErrProposerNotInSet is returned when the proposer of a proposer set is
+	// not part of the set.
+	ErrProposerNotInSet = errors.New("proposer not in proposer set")
+
 	// ErrParentRootMismatch is returned when the parent root in an execution
 	// payload does not match the expected value.
 	ErrParentRootMismatch = errors.New("parent root mismatch")
@@ -52,10 +56,6 @@ var (
 	// in a block does not match the expected value.
 	ErrRewardsLengthMismatch = errors.New("rewards length mismatch")
 
-	// ErrPenaltiesLengthMismatch is returned when the length of the penalties
-	// in a block does not match the expected value.
-	ErrPenaltiesLengthMismatch = errors.New("penalties length mismatch")
-
 	// ErrExceedsBlockBlobLimit is returned when the block exceeds the blob
 	// limit.
 	ErrExceedsBlockBlobLimit = errors.New("block exceeds blob limit")
@@ -76,4 +76,56 @@ var (
 	// ErrNumWithdrawalsMismatch is returned when the number of withdrawals
 	// in a block does not match the expected value.
 	ErrNumWithdrawalsMismatch = errors.New("number of withdrawals mismatch")
+
+	// ErrNotBLSCredentials is returned when a BLS to execution change
+	// targets a validator without BLS withdrawal credentials.
+	ErrNotBLSCredentials = errors.New(
+		"validator does not have bls withdrawal credentials")
+
+	// ErrBLSPubkeyMismatch is returned when the BLS public key of a BLS to
+	// execution change does not match the withdrawal credentials.
+	ErrBLSPubkeyMismatch = errors.New(
+		"bls pubkey does not match withdrawal credentials")
+
+	// ErrExceedsBlockOperationLimit is returned when the block exceeds the
+	// limit of an operation signed by validators.
+	ErrExceedsBlockOperationLimit = errors.New(
+		"block exceeds operation limit")
+
+	// ErrMalformedOperation is returned when an operation is missing one of
+	// its messages.
+	ErrMalformedOperation = errors.New("malformed operation")
+
+	// ErrValidatorNotExitable is returned when a voluntary exit targets a
+	// validator that is not active or already exiting.
+	ErrValidatorNotExitable = errors.New("validator cannot exit")
+
+	// ErrVoluntaryExitTooEarly is returned when a voluntary exit is processed
+	// before its epoch.
+	ErrVoluntaryExitTooEarly = errors.New("voluntary exit is not valid yet")
+
+	// ErrValidatorNotActiveLongEnough is returned when a voluntary exit
+	// targets a validator that has been active for less than the shard
+	// committee period.
+	ErrValidatorNotActiveLongEnough = errors.New(
+		"validator has not been active long enough to exit",
+	)
+
+	// ErrValidatorNotSlashable is returned when a proposer slashing targets
+	// a validator that cannot be slashed.
+	ErrValidatorNotSlashable = errors.New("validator is not slashable")
+
+	// ErrSlashingHeadersMismatch is returned when the headers of a proposer
+	// slashing are not for the same slot and proposer.
+	ErrSlashingHeadersMismatch = errors.New(
+		"proposer slashing headers are not for the same slot and proposer")
+
+	// ErrSlashingHeadersEqual is returned when the headers of a proposer
+	// slashing do not conflict.
+	ErrSlashingHeadersEqual = errors.New(
+		"proposer slashing headers are equal")
+
+	// ErrInvalidGenesisState is returned when the full beacon state a chain
+	// is started from does not have the lengths of the chain spec.
+	ErrInvalidGenesisState = errors.New("invalid genesis state")
 )
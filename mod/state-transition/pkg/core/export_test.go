@@ -0,0 +1,71 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package core
+
+import "github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+
+// The sub-steps below are exported to the tests only, so that the
+// consensus-spec-tests conformance runner can run them one at a time.
+
+// ProcessProposerSlashing runs processProposerSlashing.
+func (sp *StateProcessor[
+	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) ProcessProposerSlashing(
+	st BeaconStateT, slashing *types.ProposerSlashing,
+) error {
+	return sp.processProposerSlashing(st, slashing)
+}
+
+// ProcessBLSToExecutionChange runs processBLSToExecutionChange.
+func (sp *StateProcessor[
+	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) ProcessBLSToExecutionChange(
+	st BeaconStateT, change *types.SignedBLSToExecutionChange,
+) error {
+	return sp.processBLSToExecutionChange(st, change)
+}
+
+// ProcessWithdrawals runs processWithdrawals.
+func (sp *StateProcessor[
+	_, BeaconBlockBodyT, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) ProcessWithdrawals(st BeaconStateT, body BeaconBlockBodyT) error {
+	return sp.processWithdrawals(st, body)
+}
+
+// ProcessEffectiveBalanceUpdates runs processEffectiveBalanceUpdates.
+func (sp *StateProcessor[
+	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) ProcessEffectiveBalanceUpdates(st BeaconStateT) error {
+	return sp.processEffectiveBalanceUpdates(st)
+}
+
+// ProcessSlashingsReset runs processSlashingsReset.
+func (sp *StateProcessor[
+	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) ProcessSlashingsReset(st BeaconStateT) error {
+	return sp.processSlashingsReset(st)
+}
+
+// ProcessRandaoMixesReset runs processRandaoMixesReset.
+func (sp *StateProcessor[
+	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) ProcessRandaoMixesReset(st BeaconStateT) error {
+	return sp.processRandaoMixesReset(st)
+}
@@ -111,6 +111,40 @@ func createStateProcessor(
 	*engineprimitives.Withdrawal,
 	engineprimitives.Withdrawals,
 	types.WithdrawalCredentials,
+] {
+	return createStateProcessorWithSink(
+		cs, execEngine, signer, fGetAddressFromPubKey, &testTelemetrySink{},
+	)
+}
+
+func createStateProcessorWithSink(
+	cs common.ChainSpec,
+	execEngine core.ExecutionEngine[
+		*types.ExecutionPayload,
+		*types.ExecutionPayloadHeader,
+		engineprimitives.Withdrawals,
+	],
+	signer crypto.BLSSigner,
+	fGetAddressFromPubKey func(crypto.BLSPubkey) ([]byte, error),
+	telemetrySink core.TelemetrySink,
+) *core.StateProcessor[
+	*types.BeaconBlock,
+	*types.BeaconBlockBody,
+	*types.BeaconBlockHeader,
+	*TestBeaconStateT,
+	*transition.Context,
+	*types.Deposit,
+	*types.Eth1Data,
+	*types.ExecutionPayload,
+	*types.ExecutionPayloadHeader,
+	*types.Fork,
+	*types.ForkData,
+	*TestKVStoreT,
+	*types.Validator,
+	types.Validators,
+	*engineprimitives.Withdrawal,
+	engineprimitives.Withdrawals,
+	types.WithdrawalCredentials,
 ] {
 	return core.NewStateProcessor[
 		*types.BeaconBlock,
@@ -136,17 +170,27 @@ func createStateProcessor(
 		execEngine,
 		signer,
 		fGetAddressFromPubKey,
+		telemetrySink,
 	)
 }
 
-type testKVStoreService struct {
-	ctx sdk.Context
+// testTelemetrySink records the counters incremented by the state
+// processor.
+type testTelemetrySink struct {
+	counters []string
+}
+
+func (s *testTelemetrySink) IncrementCounter(key string, _ ...string) {
+	s.counters = append(s.counters, key)
 }
 
-func (kvs *testKVStoreService) OpenKVStore(context.Context) corestore.KVStore {
-	//nolint:contextcheck // fine with tests
+type testKVStoreService struct{}
+
+func (kvs *testKVStoreService) OpenKVStore(
+	ctx context.Context,
+) corestore.KVStore {
 	return components.NewKVStore(
-		sdk.UnwrapSDKContext(kvs.ctx).KVStore(testStoreKey),
+		sdk.UnwrapSDKContext(ctx).KVStore(testStoreKey),
 	)
 }
 
@@ -164,7 +208,7 @@ func initStore() (
 		*types.Validator,
 		types.Validators,
 	], error) {
-	db, err := db.OpenDB("", dbm.MemDBBackend)
+	db, err := db.OpenDB("", db.Config{Backend: dbm.MemDBBackend})
 	if err != nil {
 		return nil, fmt.Errorf("failed opening mem db: %w", err)
 	}
@@ -184,7 +228,7 @@ func initStore() (
 	if err = cms.LoadLatestVersion(); err != nil {
 		return nil, fmt.Errorf("failed to load latest version: %w", err)
 	}
-	testStoreService := &testKVStoreService{ctx: ctx}
+	testStoreService := &testKVStoreService{}
 
 	return beacondb.New[
 		*types.BeaconBlockHeader,
@@ -196,7 +240,7 @@ func initStore() (
 	](
 		testStoreService,
 		testCodec,
-	), nil
+	).WithContext(ctx), nil
 }
 
 func buildNextBlock(
@@ -26,6 +26,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
 )
 
 // BeaconState is the interface for the beacon state. It
@@ -68,6 +69,7 @@ type ReadOnlyBeaconState[
 	ReadOnlyStateRoots
 	ReadOnlyValidators[ValidatorT]
 	ReadOnlyWithdrawals[WithdrawalT]
+	ReadOnlyParticipation
 
 	GetBalance(math.ValidatorIndex) (math.Gwei, error)
 	GetSlot() (math.Slot, error)
@@ -83,6 +85,10 @@ type ReadOnlyBeaconState[
 	GetNextWithdrawalValidatorIndex() (math.ValidatorIndex, error)
 	GetTotalValidators() (uint64, error)
 	GetValidatorsByEffectiveBalance() ([]ValidatorT, error)
+	GetValidatorSet() (map[math.ValidatorIndex]math.Gwei, error)
+	GetPendingConsolidations() (
+		map[math.ValidatorIndex]math.ValidatorIndex, error,
+	)
 	ValidatorIndexByCometBFTAddress(
 		cometBFTAddress []byte,
 	) (math.ValidatorIndex, error)
@@ -97,6 +103,7 @@ type WriteOnlyBeaconState[
 	WriteOnlyRandaoMixes
 	WriteOnlyStateRoots
 	WriteOnlyValidators[ValidatorT]
+	WriteOnlyParticipation
 
 	SetGenesisValidatorsRoot(root common.Root) error
 	SetFork(ForkT) error
@@ -144,6 +151,12 @@ type WriteOnlyValidators[ValidatorT any] interface {
 
 	AddValidator(ValidatorT) error
 	AddValidatorBartio(ValidatorT) error
+	SetValidatorSet(map[math.ValidatorIndex]math.Gwei) error
+	SetPendingConsolidations(
+		map[math.ValidatorIndex]math.ValidatorIndex,
+	) error
+	SetProposerSet(*transition.ProposerSet) error
+	SetNextProposerSet(*transition.ProposerSet) error
 }
 
 // ReadOnlyValidators has read access to validator methods.
@@ -155,6 +168,9 @@ type ReadOnlyValidators[ValidatorT any] interface {
 	ValidatorByIndex(
 		math.ValidatorIndex,
 	) (ValidatorT, error)
+
+	GetProposerSet() (*transition.ProposerSet, error)
+	GetNextProposerSet() (*transition.ProposerSet, error)
 }
 
 // WriteOnlyEth1Data has write access to eth1 data.
@@ -179,3 +195,24 @@ type ReadOnlyEth1Data[Eth1DataT, ExecutionPayloadHeaderT any] interface {
 type ReadOnlyWithdrawals[WithdrawalT any] interface {
 	ExpectedWithdrawals() ([]WithdrawalT, error)
 }
+
+// WriteOnlyParticipation has write access to the participation recorded
+// during the current epoch.
+type WriteOnlyParticipation interface {
+	SetEpochParticipation(math.ValidatorIndex, uint64) error
+	SetEpochProposals(math.ValidatorIndex, uint64) error
+	SetEpochCommits(uint64) error
+	ResetEpochParticipation() error
+	SetInactivityScore(math.ValidatorIndex, uint64) error
+}
+
+// ReadOnlyParticipation has read access to the participation recorded during
+// the current epoch.
+type ReadOnlyParticipation interface {
+	GetEpochParticipation(math.ValidatorIndex) (uint64, error)
+	GetEpochProposals(math.ValidatorIndex) (uint64, error)
+	GetEpochCommits() (uint64, error)
+	GetInactivityScore(math.ValidatorIndex) (uint64, error)
+	BaseReward(math.ValidatorIndex) (math.Gwei, error)
+	EpochRewards() ([]*transition.Rewards, error)
+}
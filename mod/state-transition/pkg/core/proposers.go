@@ -0,0 +1,252 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"sort"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	cmtcrypto "github.com/cometbft/cometbft/crypto"
+	cmttypes "github.com/cometbft/cometbft/types"
+)
+
+// ExpectedProposers returns the proposers CometBFT selects for the next n
+// blocks from the proposer set and the set that replaces it, assuming every
+// block is committed in its first round and no further validator updates
+// are sent. pubkeyOf returns the public key of a validator, whose CometBFT
+// address breaks ties between equal proposer priorities.
+func ExpectedProposers(
+	current, next *transition.ProposerSet,
+	n uint64,
+	pubkeyOf func(math.ValidatorIndex) (crypto.BLSPubkey, error),
+) ([]math.ValidatorIndex, error) {
+	if current == nil || next == nil || n == 0 {
+		return nil, nil
+	}
+	proposers := make([]math.ValidatorIndex, 0, n)
+	proposers = append(proposers, current.Proposer)
+	if n == 1 {
+		return proposers, nil
+	}
+
+	indices := make(map[string]math.ValidatorIndex)
+	vals, err := toCometBFTSet(next, indices, pubkeyOf)
+	if err != nil {
+		return nil, err
+	}
+	proposers = append(proposers, next.Proposer)
+	for uint64(len(proposers)) < n {
+		vals.IncrementProposerPriority(1)
+		proposers = append(
+			proposers, indices[string(vals.GetProposer().Address)],
+		)
+	}
+	return proposers, nil
+}
+
+// newProposerSets returns the sets CometBFT selects the proposers of the
+// first and second blocks from when the chain starts with the given
+// validator set.
+func newProposerSets(
+	powers map[math.ValidatorIndex]math.Gwei,
+	pubkeyOf func(math.ValidatorIndex) (crypto.BLSPubkey, error),
+) (*transition.ProposerSet, *transition.ProposerSet, error) {
+	if len(powers) == 0 {
+		return nil, nil, nil
+	}
+
+	indices := make(map[string]math.ValidatorIndex)
+	changes, err := toCometBFTChanges(nil, powers, indices, pubkeyOf)
+	if err != nil {
+		return nil, nil, err
+	}
+	vals := &cmttypes.ValidatorSet{}
+	if err = vals.UpdateWithChangeSet(changes); err != nil {
+		return nil, nil, err
+	}
+	vals.IncrementProposerPriority(1)
+
+	return fromCometBFTSet(vals, indices),
+		fromCometBFTSet(vals.CopyIncrementProposerPriority(1), indices),
+		nil
+}
+
+// rotateProposerSets returns the sets CometBFT selects the proposers of the
+// next two blocks from once a block is committed. The set that replaced the
+// proposer set becomes the proposer set, and the validator set the block
+// sent to CometBFT is applied on top of it to form the set after.
+func rotateProposerSets(
+	next *transition.ProposerSet,
+	powers map[math.ValidatorIndex]math.Gwei,
+	pubkeyOf func(math.ValidatorIndex) (crypto.BLSPubkey, error),
+) (*transition.ProposerSet, *transition.ProposerSet, error) {
+	indices := make(map[string]math.ValidatorIndex)
+	vals, err := toCometBFTSet(next, indices, pubkeyOf)
+	if err != nil {
+		return nil, nil, err
+	}
+	changes, err := toCometBFTChanges(
+		next.Powers, powers, indices, pubkeyOf,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(changes) > 0 {
+		if err = vals.UpdateWithChangeSet(changes); err != nil {
+			return nil, nil, err
+		}
+	}
+	vals.IncrementProposerPriority(1)
+	return next, fromCometBFTSet(vals, indices), nil
+}
+
+// toCometBFTSet converts the proposer set to a CometBFT validator set,
+// recording the index of every address in indices.
+func toCometBFTSet(
+	set *transition.ProposerSet,
+	indices map[string]math.ValidatorIndex,
+	pubkeyOf func(math.ValidatorIndex) (crypto.BLSPubkey, error),
+) (*cmttypes.ValidatorSet, error) {
+	vals := &cmttypes.ValidatorSet{
+		Validators: make([]*cmttypes.Validator, 0, len(set.Powers)),
+	}
+	for idx, power := range set.Powers {
+		address, err := cometBFTAddress(pubkeyOf, idx)
+		if err != nil {
+			return nil, err
+		}
+		indices[string(address)] = idx
+		val := &cmttypes.Validator{
+			Address: address,
+			//#nosec:G701 // voting power is capped well below the limit.
+			VotingPower:      int64(power.Unwrap()),
+			ProposerPriority: set.Priorities[idx],
+		}
+		if idx == set.Proposer {
+			vals.Proposer = val
+		}
+		vals.Validators = append(vals.Validators, val)
+	}
+	// Keep the order CometBFT keeps its validators in.
+	sort.Sort(cmttypes.ValidatorsByVotingPower(vals.Validators))
+	if vals.Proposer == nil {
+		return nil, errors.Wrapf(
+			ErrProposerNotInSet, "index: %d", set.Proposer,
+		)
+	}
+	return vals, nil
+}
+
+// toCometBFTChanges returns the CometBFT validator updates turning the
+// powers of prev into powers, recording the index of every address in
+// indices. Validators missing from powers are removed with zero power.
+func toCometBFTChanges(
+	prev, powers map[math.ValidatorIndex]math.Gwei,
+	indices map[string]math.ValidatorIndex,
+	pubkeyOf func(math.ValidatorIndex) (crypto.BLSPubkey, error),
+) ([]*cmttypes.Validator, error) {
+	changes := make([]*cmttypes.Validator, 0)
+	add := func(idx math.ValidatorIndex, power math.Gwei) error {
+		address, err := cometBFTAddress(pubkeyOf, idx)
+		if err != nil {
+			return err
+		}
+		indices[string(address)] = idx
+		changes = append(changes, &cmttypes.Validator{
+			Address: address,
+			//#nosec:G701 // voting power is capped well below the limit.
+			VotingPower: int64(power.Unwrap()),
+		})
+		return nil
+	}
+
+	for idx, power := range powers {
+		if old, ok := prev[idx]; ok && old == power {
+			continue
+		}
+		if err := add(idx, power); err != nil {
+			return nil, err
+		}
+	}
+	for idx := range prev {
+		if _, ok := powers[idx]; ok {
+			continue
+		}
+		if err := add(idx, 0); err != nil {
+			return nil, err
+		}
+	}
+	return changes, nil
+}
+
+// fromCometBFTSet converts the CometBFT validator set back to a proposer
+// set using the indices of the addresses.
+func fromCometBFTSet(
+	vals *cmttypes.ValidatorSet,
+	indices map[string]math.ValidatorIndex,
+) *transition.ProposerSet {
+	set := &transition.ProposerSet{
+		Powers: make(
+			map[math.ValidatorIndex]math.Gwei, len(vals.Validators),
+		),
+		Priorities: make(
+			map[math.ValidatorIndex]int64, len(vals.Validators),
+		),
+		Proposer: indices[string(vals.GetProposer().Address)],
+	}
+	for _, val := range vals.Validators {
+		idx := indices[string(val.Address)]
+		//#nosec:G701 // voting power is never negative.
+		set.Powers[idx] = math.Gwei(val.VotingPower)
+		set.Priorities[idx] = val.ProposerPriority
+	}
+	return set
+}
+
+// PubkeyOf returns a lookup of the public key of a validator from the given
+// lookup of validators, e.g. the ValidatorByIndex method of a beacon state.
+func PubkeyOf[ValidatorT interface{ GetPubkey() crypto.BLSPubkey }](
+	validatorByIndex func(math.ValidatorIndex) (ValidatorT, error),
+) func(math.ValidatorIndex) (crypto.BLSPubkey, error) {
+	return func(idx math.ValidatorIndex) (crypto.BLSPubkey, error) {
+		val, err := validatorByIndex(idx)
+		if err != nil {
+			return crypto.BLSPubkey{}, err
+		}
+		return val.GetPubkey(), nil
+	}
+}
+
+// cometBFTAddress returns the CometBFT address of the validator at the given
+// index.
+func cometBFTAddress(
+	pubkeyOf func(math.ValidatorIndex) (crypto.BLSPubkey, error),
+	idx math.ValidatorIndex,
+) ([]byte, error) {
+	pubkey, err := pubkeyOf(idx)
+	if err != nil {
+		return nil, err
+	}
+	return cmtcrypto.AddressHash(pubkey[:]).Bytes(), nil
+}
@@ -0,0 +1,205 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package core_test
+
+import (
+	"bytes"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/errors"
+	cryptomocks "github.com/berachain/beacon-kit/mod/primitives/pkg/crypto/mocks"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core/mocks"
+	"github.com/berachain/beacon-kit/mod/state-transition/pkg/spectest"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// specTestsDirEnv is the environment variable pointing to an extracted
+// release of https://github.com/ethereum/consensus-spec-tests.
+const specTestsDirEnv = "CONSENSUS_SPEC_TESTS_DIR"
+
+// whistleblowerRewardQuotient is the WHISTLEBLOWER_REWARD_QUOTIENT of the
+// spec.
+const whistleblowerRewardQuotient = 512
+
+// TestSpecConformance runs the mainnet Deneb consensus-spec-tests against
+// the state transition, reporting pass/skip/fail per handler. The parts of
+// the spec BeaconKit intentionally diverges from are skipped as allowlisted
+// in spectest.Divergences, so that any failure is a regression in logic
+// shared with Ethereum.
+func TestSpecConformance(t *testing.T) {
+	dir := os.Getenv(specTestsDirEnv)
+	if dir == "" {
+		t.Skipf("%s not set", specTestsDirEnv)
+	}
+	cases, err := spectest.LoadCases(dir, "mainnet", "deneb")
+	require.NoError(t, err)
+
+	// BeaconKit signs over its own fork versions, so signatures are not
+	// verified and the cases about them are skipped.
+	cs := spectest.ChainSpec()
+	signer := &cryptomocks.BLSSigner{}
+	signer.On(
+		"VerifySignature",
+		mock.Anything, mock.Anything, mock.Anything,
+	).Return(nil)
+	sp := createStateProcessor(
+		cs,
+		mocks.NewExecutionEngine[
+			*types.ExecutionPayload,
+			*types.ExecutionPayloadHeader,
+			engineprimitives.Withdrawals,
+		](t),
+		signer,
+		dummyProposerAddressVerifier,
+	)
+
+	// stateHandler returns a Handler running process on the pre state of a
+	// case and checking the post state, up to the differences the returned
+	// Ignore accepts.
+	stateHandler := func(
+		process func(
+			c spectest.Case, st *TestBeaconStateT,
+		) (spectest.Ignore, error),
+	) spectest.Handler {
+		return func(c spectest.Case) error {
+			if strings.Contains(c.Name, "_sig") ||
+				strings.Contains(c.Name, "fork_version") {
+				return spectest.ErrSkip
+			}
+			pre, err := spectest.ReadState(c, "pre")
+			if err != nil {
+				return err
+			}
+			kvStore, err := initStore()
+			if err != nil {
+				return err
+			}
+			st := new(TestBeaconStateT).NewFromDB(kvStore, cs)
+			if err = pre.Load(st); err != nil {
+				return err
+			}
+			ignore, err := process(c, st)
+			if errors.Is(err, spectest.ErrSkip) {
+				return err
+			}
+			return spectest.CheckPost(c, st, err, ignore)
+		}
+	}
+
+	r := spectest.NewRunner()
+	spectest.RegisterSSZStatic(r)
+	spectest.AllowDivergences(r)
+
+	// Operations
+	r.Register("operations", "proposer_slashing", stateHandler(
+		func(c spectest.Case, st *TestBeaconStateT) (spectest.Ignore, error) {
+			slashing := new(types.ProposerSlashing)
+			if err := c.Decode("proposer_slashing", slashing); err != nil {
+				return nil, err
+			}
+			// No whistleblower reward is paid, so the balance of the
+			// proposer is short of exactly that reward.
+			var reward uint64
+			if val, err := st.ValidatorByIndex(
+				slashing.GetValidatorIndex(),
+			); err == nil {
+				reward = val.GetEffectiveBalance().Unwrap() /
+					whistleblowerRewardQuotient
+			}
+			return func(diff types.FieldDiff) bool {
+				got, _ := diff.Old.(uint64)
+				want, _ := diff.New.(uint64)
+				return diff.Field == "Balances" && want == got+reward
+			}, sp.ProcessProposerSlashing(st, slashing)
+		},
+	))
+	r.Register("operations", "bls_to_execution_change", stateHandler(
+		func(c spectest.Case, st *TestBeaconStateT) (spectest.Ignore, error) {
+			change := new(types.SignedBLSToExecutionChange)
+			if err := c.Decode("address_change", change); err != nil {
+				return nil, err
+			}
+			return nil, sp.ProcessBLSToExecutionChange(st, change)
+		},
+	))
+	r.Register("operations", "withdrawals", stateHandler(
+		func(c spectest.Case, st *TestBeaconStateT) (spectest.Ignore, error) {
+			payload := new(types.ExecutionPayload)
+			if err := c.Decode("execution_payload", payload); err != nil {
+				return nil, err
+			}
+			return nil, sp.ProcessWithdrawals(
+				st, &types.BeaconBlockBody{ExecutionPayload: payload},
+			)
+		},
+	))
+
+	// Epoch processing
+	for handler, process := range map[string]func(*TestBeaconStateT) error{
+		"effective_balance_updates": sp.ProcessEffectiveBalanceUpdates,
+		"randao_mixes_reset":        sp.ProcessRandaoMixesReset,
+		"slashings_reset":           sp.ProcessSlashingsReset,
+	} {
+		r.Register("epoch_processing", handler, stateHandler(
+			func(
+				_ spectest.Case, st *TestBeaconStateT,
+			) (spectest.Ignore, error) {
+				return nil, process(st)
+			},
+		))
+	}
+
+	// Sanity
+	r.Register("sanity", "slots", stateHandler(
+		func(c spectest.Case, st *TestBeaconStateT) (spectest.Ignore, error) {
+			var slots uint64
+			if err := c.YAML("slots", &slots); err != nil {
+				return nil, err
+			}
+			slot, err := st.GetSlot()
+			if err != nil {
+				return nil, err
+			}
+			// Epoch processing diverges, and the roots cached every slot
+			// are the roots of the BeaconKit state.
+			if slot.Unwrap()%cs.SlotsPerEpoch()+slots >= cs.SlotsPerEpoch() {
+				return nil, spectest.ErrSkip
+			}
+			_, err = sp.ProcessSlots(st, slot+math.Slot(slots))
+			return spectest.IgnoreFields(
+				"LatestBlockHeader", "BlockRoots", "StateRoots",
+			), err
+		},
+	))
+
+	report := r.Run(cases)
+	var buf bytes.Buffer
+	require.NoError(t, report.Write(&buf))
+	t.Log("\n" + buf.String())
+	for _, failure := range report.Failures() {
+		t.Errorf("%s: %v", failure.Case, failure.Err)
+	}
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
 )
 
 // KVStore is the interface for the key-value store holding the beacon state.
@@ -44,6 +45,11 @@ type KVStore[
 	WithContext(ctx context.Context) T
 	// Copy returns a copy of the key-value store.
 	Copy() T
+	// CachedHashTreeRoot returns the hash tree root of the state, given the
+	// lengths of the historical roots and randao mixes vectors.
+	CachedHashTreeRoot(
+		historicalRoots, historicalMixes uint64,
+	) (common.Root, error)
 	// GetLatestExecutionPayloadHeader retrieves the latest execution payload
 	// header.
 	GetLatestExecutionPayloadHeader() (
@@ -143,4 +149,55 @@ type KVStore[
 	// GetValidatorsByEffectiveBalance retrieves validators by effective
 	// balance.
 	GetValidatorsByEffectiveBalance() ([]ValidatorT, error)
+	// GetValidatorSet retrieves the voting power of each validator in the
+	// set last sent to CometBFT.
+	GetValidatorSet() (map[math.ValidatorIndex]math.Gwei, error)
+	// SetValidatorSet replaces the validator set last sent to CometBFT.
+	SetValidatorSet(set map[math.ValidatorIndex]math.Gwei) error
+	// GetPendingConsolidations retrieves the target validator index of each
+	// validator whose balance is waiting to be consolidated.
+	GetPendingConsolidations() (
+		map[math.ValidatorIndex]math.ValidatorIndex, error,
+	)
+	// SetPendingConsolidations replaces the pending consolidations.
+	SetPendingConsolidations(
+		consolidations map[math.ValidatorIndex]math.ValidatorIndex,
+	) error
+	// GetProposerSet retrieves the set CometBFT selects the proposer of the
+	// next block from.
+	GetProposerSet() (*transition.ProposerSet, error)
+	// SetProposerSet replaces the set CometBFT selects the proposer of the
+	// next block from.
+	SetProposerSet(set *transition.ProposerSet) error
+	// GetNextProposerSet retrieves the set that replaces the proposer set
+	// after the next block.
+	GetNextProposerSet() (*transition.ProposerSet, error)
+	// SetNextProposerSet replaces the set that replaces the proposer set
+	// after the next block.
+	SetNextProposerSet(set *transition.ProposerSet) error
+	// GetEpochParticipation retrieves the number of commits the validator
+	// signed in the current epoch.
+	GetEpochParticipation(idx math.ValidatorIndex) (uint64, error)
+	// SetEpochParticipation sets the number of commits the validator signed
+	// in the current epoch.
+	SetEpochParticipation(idx math.ValidatorIndex, count uint64) error
+	// GetEpochProposals retrieves the number of blocks the validator
+	// proposed in the current epoch.
+	GetEpochProposals(idx math.ValidatorIndex) (uint64, error)
+	// SetEpochProposals sets the number of blocks the validator proposed in
+	// the current epoch.
+	SetEpochProposals(idx math.ValidatorIndex, count uint64) error
+	// GetEpochCommits retrieves the number of commits recorded in the
+	// current epoch.
+	GetEpochCommits() (uint64, error)
+	// SetEpochCommits sets the number of commits recorded in the current
+	// epoch.
+	SetEpochCommits(count uint64) error
+	// ResetEpochParticipation clears the participation recorded in the
+	// current epoch.
+	ResetEpochParticipation() error
+	// GetInactivityScore retrieves the inactivity score of the validator.
+	GetInactivityScore(idx math.ValidatorIndex) (uint64, error)
+	// SetInactivityScore sets the inactivity score of the validator.
+	SetInactivityScore(idx math.ValidatorIndex, score uint64) error
 }
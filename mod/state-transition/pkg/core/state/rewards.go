@@ -0,0 +1,196 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package state
+
+import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+)
+
+// EpochRewards computes the rewards and penalties of every validator for the
+// current epoch from the participation recorded during the epoch. A validator
+// earns a share of its base reward for each commit it signed and is
+// penalised the same share for each commit it missed. Proposers earn an
+// additional share of their base reward for every block they proposed.
+// Validators that signed none of the commits for more than
+// MinEpochsToInactivityPenalty epochs in a row are penalised in proportion to
+// their inactivity score.
+func (s *StateDB[
+	_, _, _, _, _, _, _, _, _, _,
+]) EpochRewards() ([]*transition.Rewards, error) {
+	validators, err := s.GetValidators()
+	if err != nil {
+		return nil, err
+	}
+
+	// The validator set stored at the end of the previous epoch is the one
+	// that signed the commits of this epoch.
+	set, err := s.GetValidatorSet()
+	if err != nil {
+		return nil, err
+	}
+
+	commits, err := s.GetEpochCommits()
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		sqrtTotalBalance = integerSquareRoot(totalPower(set))
+		rewards          = make([]*transition.Rewards, len(validators))
+		participation    uint64
+		proposals        uint64
+		score            uint64
+	)
+	for i, validator := range validators {
+		idx := math.ValidatorIndex(i)
+		rewards[i] = &transition.Rewards{
+			ValidatorIndex:   idx,
+			EffectiveBalance: validator.GetEffectiveBalance(),
+		}
+		// Only validators in the validator set take part in consensus.
+		if _, ok := set[idx]; !ok {
+			continue
+		}
+
+		if participation, err = s.GetEpochParticipation(idx); err != nil {
+			return nil, err
+		}
+		if proposals, err = s.GetEpochProposals(idx); err != nil {
+			return nil, err
+		}
+		if score, err = s.GetInactivityScore(idx); err != nil {
+			return nil, err
+		}
+
+		// The inactivity score is left untouched for epochs in which no
+		// commit was recorded.
+		participation = min(participation, commits)
+		switch {
+		case commits == 0:
+		case participation == 0:
+			score++
+		default:
+			score = 0
+		}
+
+		rewards[i].InactivityScore = score
+		s.applyRewards(
+			rewards[i], validator.IsSlashed(), sqrtTotalBalance,
+			commits, participation, proposals,
+		)
+	}
+	return rewards, nil
+}
+
+// applyRewards fills in the rewards and penalties of a single active
+// validator.
+func (s *StateDB[
+	_, _, _, _, _, _, _, _, _, _,
+]) applyRewards(
+	r *transition.Rewards,
+	slashed bool,
+	sqrtTotalBalance uint64,
+	commits, participation, proposals uint64,
+) {
+	baseReward := s.baseReward(r.EffectiveBalance, sqrtTotalBalance)
+	if commits > 0 {
+		missed := math.Gwei(commits - participation)
+		r.MissedParticipation = baseReward * missed / math.Gwei(commits)
+		// Slashed validators are still penalised but no longer rewarded.
+		if !slashed {
+			r.Participation = baseReward *
+				math.Gwei(participation) / math.Gwei(commits)
+		}
+	}
+
+	if quotient := s.cs.ProposerRewardQuotient(); quotient > 0 && !slashed {
+		r.Proposer = baseReward / math.Gwei(quotient) * math.Gwei(proposals)
+	}
+
+	if quotient := s.cs.InactivityPenaltyQuotient(); quotient > 0 &&
+		r.InactivityScore > s.cs.MinEpochsToInactivityPenalty() {
+		r.Inactivity = r.EffectiveBalance *
+			math.Gwei(r.InactivityScore) / math.Gwei(quotient)
+	}
+}
+
+// BaseReward returns the reward the validator at the given index earns in an
+// epoch in which it signs every commit.
+func (s *StateDB[
+	_, _, _, _, _, _, _, _, _, _,
+]) BaseReward(idx math.ValidatorIndex) (math.Gwei, error) {
+	validator, err := s.ValidatorByIndex(idx)
+	if err != nil {
+		return 0, err
+	}
+
+	set, err := s.GetValidatorSet()
+	if err != nil {
+		return 0, err
+	}
+
+	return s.baseReward(
+		validator.GetEffectiveBalance(),
+		integerSquareRoot(totalPower(set)),
+	), nil
+}
+
+// baseReward as defined in the Ethereum 2.0 specification, scaled by the
+// base reward factor of the chain spec. The total voting power of the
+// validator set is used as the total balance.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#helpers
+//
+//nolint:lll
+func (s *StateDB[
+	_, _, _, _, _, _, _, _, _, _,
+]) baseReward(
+	effectiveBalance math.Gwei, sqrtTotalBalance uint64,
+) math.Gwei {
+	if sqrtTotalBalance == 0 {
+		return 0
+	}
+	return effectiveBalance * math.Gwei(s.cs.BaseRewardFactor()) /
+		math.Gwei(sqrtTotalBalance)
+}
+
+// totalPower returns the total voting power of the validator set.
+func totalPower(set map[math.ValidatorIndex]math.Gwei) uint64 {
+	var total math.Gwei
+	for _, power := range set {
+		total += power
+	}
+	return total.Unwrap()
+}
+
+// integerSquareRoot as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#integer_squareroot
+//
+//nolint:lll
+func integerSquareRoot(n uint64) uint64 {
+	x := n
+	// (x + 1) / 2 without overflowing.
+	y := x/2 + x%2
+	for y < x {
+		x = y
+		y = (x + n/x) / 2
+	}
+	return x
+}
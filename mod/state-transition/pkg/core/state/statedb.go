@@ -239,7 +239,12 @@ func (s *StateDB[
 		withdrawalAddress, err = validator.
 			GetWithdrawalCredentials().ToExecutionAddress()
 		if err != nil {
-			return nil, err
+			// Validators with BLS credentials have nothing to withdraw to
+			// until they change them to an execution address.
+			validatorIndex = (validatorIndex + 1) % math.ValidatorIndex(
+				totalValidators,
+			)
+			continue
 		}
 
 		// Set the amount of the withdrawal depending on the balance of the
@@ -417,13 +422,16 @@ func (s *StateDB[
 	)
 }
 
-// HashTreeRoot is the interface for the beacon store.
+// HashTreeRoot is the interface for the beacon store. Only the parts of the
+// state written since the last call are rehashed.
 func (s *StateDB[
 	_, _, _, _, _, _, _, _, _, _,
 ]) HashTreeRoot() common.Root {
-	st, err := s.GetMarshallable()
+	root, err := s.CachedHashTreeRoot(
+		s.cs.SlotsPerHistoricalRoot(), s.cs.EpochsPerHistoricalVector(),
+	)
 	if err != nil {
 		panic(err)
 	}
-	return st.HashTreeRoot()
+	return root
 }
@@ -65,6 +65,10 @@ type Validator[WithdrawalCredentialsT WithdrawalCredentials] interface {
 	// GetWithdrawalCredentials returns the withdrawal credentials of the
 	// validator.
 	GetWithdrawalCredentials() WithdrawalCredentialsT
+	// GetEffectiveBalance returns the effective balance of the validator.
+	GetEffectiveBalance() math.Gwei
+	// IsSlashed returns whether the validator has been slashed.
+	IsSlashed() bool
 	// IsFullyWithdrawable checks if the validator is fully withdrawable given a
 	// certain Gwei amount and epoch.
 	IsFullyWithdrawable(amount math.Gwei, epoch math.Epoch) bool
@@ -96,6 +96,10 @@ type StateProcessor[
 	// processingGenesis allows initializing correctly
 	// eth1 deposit index upon genesis
 	processingGenesis bool
+	// tracer, if set, observes the sub-steps of every transition.
+	tracer Tracer[BeaconStateT]
+	// metrics is the metrics for the state processor.
+	metrics *stateProcessorMetrics
 }
 
 // NewStateProcessor creates a new state processor.
@@ -150,6 +154,7 @@ func NewStateProcessor[
 	],
 	signer crypto.BLSSigner,
 	fGetAddressFromPubKey func(crypto.BLSPubkey) ([]byte, error),
+	telemetrySink TelemetrySink,
 ) *StateProcessor[
 	BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
 	BeaconStateT, ContextT, DepositT, Eth1DataT, ExecutionPayloadT,
@@ -167,9 +172,18 @@ func NewStateProcessor[
 		executionEngine:       executionEngine,
 		signer:                signer,
 		fGetAddressFromPubKey: fGetAddressFromPubKey,
+		metrics:               newStateProcessorMetrics(telemetrySink),
 	}
 }
 
+// SetTracer sets the tracer observing the sub-steps of the transitions, nil
+// disables tracing. It must not be called while a transition is running.
+func (sp *StateProcessor[
+	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) SetTracer(tracer Tracer[BeaconStateT]) {
+	sp.tracer = tracer
+}
+
 // Transition is the main function for processing a state transition.
 func (sp *StateProcessor[
 	BeaconBlockT, _, _, BeaconStateT, ContextT,
@@ -194,6 +208,14 @@ func (sp *StateProcessor[
 		return nil, err
 	}
 
+	// Record the participation in the commit of the previous block.
+	if err = sp.runSteps(st, step{
+		"processParticipation",
+		func() error { return sp.processParticipation(ctx, st, blk) },
+	}); err != nil {
+		return nil, err
+	}
+
 	return validatorUpdates, nil
 }
 
@@ -215,7 +237,9 @@ func (sp *StateProcessor[
 	// Iterate until we are "caught up".
 	for ; stateSlot < slot; stateSlot++ {
 		// Process the slot
-		if err = sp.processSlot(st); err != nil {
+		if err = sp.runSteps(st, step{
+			"processSlot", func() error { return sp.processSlot(st) },
+		}); err != nil {
 			return nil, err
 		}
 
@@ -293,23 +317,29 @@ func (sp *StateProcessor[
 	st BeaconStateT,
 	blk BeaconBlockT,
 ) error {
-	if err := sp.processBlockHeader(ctx, st, blk); err != nil {
-		return err
-	}
-
-	if err := sp.processExecutionPayload(ctx, st, blk); err != nil {
-		return err
-	}
-
-	if err := sp.processWithdrawals(st, blk.GetBody()); err != nil {
-		return err
-	}
-
-	if err := sp.processRandaoReveal(ctx, st, blk); err != nil {
-		return err
-	}
-
-	if err := sp.processOperations(st, blk); err != nil {
+	if err := sp.runSteps(
+		st,
+		step{
+			"processBlockHeader",
+			func() error { return sp.processBlockHeader(ctx, st, blk) },
+		},
+		step{
+			"processExecutionPayload",
+			func() error { return sp.processExecutionPayload(ctx, st, blk) },
+		},
+		step{
+			"processWithdrawals",
+			func() error { return sp.processWithdrawals(st, blk.GetBody()) },
+		},
+		step{
+			"processRandaoReveal",
+			func() error { return sp.processRandaoReveal(ctx, st, blk) },
+		},
+		step{
+			"processOperations",
+			func() error { return sp.processOperations(st, blk) },
+		},
+	); err != nil {
 		return err
 	}
 
@@ -338,16 +368,74 @@ func (sp *StateProcessor[
 ]) processEpoch(
 	st BeaconStateT,
 ) (transition.ValidatorUpdates, error) {
-	if err := sp.processRewardsAndPenalties(st); err != nil {
-		return nil, err
-	}
-	if err := sp.processSlashingsReset(st); err != nil {
+	var validatorUpdates transition.ValidatorUpdates
+	if err := sp.runSteps(
+		st,
+		step{
+			"processRewardsAndPenalties",
+			func() error { return sp.processRewardsAndPenalties(st) },
+		},
+		step{
+			"processRegistryUpdates",
+			func() error { return sp.processRegistryUpdates(st) },
+		},
+		step{
+			"processPendingConsolidations",
+			func() error { return sp.processPendingConsolidations(st) },
+		},
+		step{
+			"processEffectiveBalanceUpdates",
+			func() error { return sp.processEffectiveBalanceUpdates(st) },
+		},
+		step{
+			"processSlashingsReset",
+			func() error { return sp.processSlashingsReset(st) },
+		},
+		step{
+			"processRandaoMixesReset",
+			func() error { return sp.processRandaoMixesReset(st) },
+		},
+		step{
+			"processSyncCommitteeUpdates",
+			func() error {
+				var err error
+				validatorUpdates, err = sp.processSyncCommitteeUpdates(st)
+				return err
+			},
+		},
+	); err != nil {
 		return nil, err
 	}
-	if err := sp.processRandaoMixesReset(st); err != nil {
-		return nil, err
+	return validatorUpdates, nil
+}
+
+// step is a named sub-step of a state transition.
+type step struct {
+	name string
+	fn   func() error
+}
+
+// runSteps runs the steps in order until one of them fails, reporting each
+// of them to the tracer if one is set.
+func (sp *StateProcessor[
+	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) runSteps(st BeaconStateT, steps ...step) error {
+	for _, s := range steps {
+		if sp.tracer == nil {
+			if err := s.fn(); err != nil {
+				return err
+			}
+			continue
+		}
+
+		sp.tracer.StartStep(s.name, st)
+		err := s.fn()
+		sp.tracer.EndStep(s.name, st, err)
+		if err != nil {
+			return err
+		}
 	}
-	return sp.processSyncCommitteeUpdates(st)
+	return nil
 }
 
 // processBlockHeader processes the header and ensures it matches the local
@@ -418,6 +506,13 @@ func (sp *StateProcessor[
 		)
 	}
 
+	// Cross-check the proposer against the proposer schedule.
+	if err = sp.processProposerSchedule(
+		st, blk.GetProposerIndex(), ctx.GetFinalizing(),
+	); err != nil {
+		return err
+	}
+
 	// Ensure the block is within the acceptable range.
 	// TODO: move this is in the wrong spot.
 	deposits := blk.GetBody().GetDeposits()
@@ -443,24 +538,6 @@ func (sp *StateProcessor[
 	return st.SetLatestBlockHeader(lbh)
 }
 
-// getAttestationDeltas as defined in the Ethereum 2.0 specification.
-// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#get_attestation_deltas
-//
-//nolint:lll
-func (sp *StateProcessor[
-	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
-]) getAttestationDeltas(
-	st BeaconStateT,
-) ([]math.Gwei, []math.Gwei, error) {
-	// TODO: implement this function forreal
-	validators, err := st.GetValidators()
-	if err != nil {
-		return nil, nil, err
-	}
-	placeholder := make([]math.Gwei, len(validators))
-	return placeholder, placeholder, nil
-}
-
 // processRewardsAndPenalties as defined in the Ethereum 2.0 specification.
 // https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#process_rewards_and_penalties
 //
@@ -475,11 +552,12 @@ func (sp *StateProcessor[
 		return err
 	}
 
+	// Participation recorded during the genesis epoch is discarded.
 	if sp.cs.SlotToEpoch(slot) == math.U64(constants.GenesisEpoch) {
-		return nil
+		return st.ResetEpochParticipation()
 	}
 
-	rewards, penalties, err := sp.getAttestationDeltas(st)
+	rewards, err := st.EpochRewards()
 	if err != nil {
 		return err
 	}
@@ -494,30 +572,80 @@ func (sp *StateProcessor[
 			ErrRewardsLengthMismatch, "expected: %d, got: %d",
 			len(validators), len(rewards),
 		)
-	} else if len(validators) != len(penalties) {
-		return errors.Wrapf(
-			ErrPenaltiesLengthMismatch, "expected: %d, got: %d",
-			len(validators), len(penalties),
-		)
 	}
 
-	for i := range validators {
+	for _, r := range rewards {
 		// Increase the balance of the validator.
-		if err = st.IncreaseBalance(
-			math.ValidatorIndex(i),
-			rewards[i],
-		); err != nil {
+		if err = st.IncreaseBalance(r.ValidatorIndex, r.Reward()); err != nil {
 			return err
 		}
 
 		// Decrease the balance of the validator.
 		if err = st.DecreaseBalance(
-			math.ValidatorIndex(i),
-			penalties[i],
+			r.ValidatorIndex, r.Penalty(),
+		); err != nil {
+			return err
+		}
+
+		if err = st.SetInactivityScore(
+			r.ValidatorIndex, r.InactivityScore,
 		); err != nil {
 			return err
 		}
 	}
 
+	return st.ResetEpochParticipation()
+}
+
+// processEffectiveBalanceUpdates as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#effective-balances-updates
+//
+//nolint:lll
+func (sp *StateProcessor[
+	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) processEffectiveBalanceUpdates(
+	st BeaconStateT,
+) error {
+	validators, err := st.GetValidators()
+	if err != nil {
+		return err
+	}
+
+	var (
+		increment           = math.Gwei(sp.cs.EffectiveBalanceIncrement())
+		hysteresisIncrement math.Gwei
+		balance             math.Gwei
+	)
+	// A zero quotient disables hysteresis.
+	if quotient := sp.cs.HysteresisQuotient(); quotient > 0 {
+		hysteresisIncrement = increment / math.Gwei(quotient)
+	}
+	downwardThreshold := hysteresisIncrement *
+		math.Gwei(sp.cs.HysteresisDownwardMultiplier())
+	upwardThreshold := hysteresisIncrement *
+		math.Gwei(sp.cs.HysteresisUpwardMultiplier())
+
+	// Update effective balances with hysteresis, so that small balance
+	// changes do not churn the validator set.
+	for i, val := range validators {
+		idx := math.ValidatorIndex(i)
+		if balance, err = st.GetBalance(idx); err != nil {
+			return err
+		}
+
+		effectiveBalance := val.GetEffectiveBalance()
+		if balance+downwardThreshold >= effectiveBalance &&
+			effectiveBalance+upwardThreshold >= balance {
+			continue
+		}
+
+		val.SetEffectiveBalance(min(
+			balance-balance%increment,
+			math.Gwei(sp.cs.MaxEffectiveBalance()),
+		))
+		if err = st.UpdateValidatorAtIndex(idx, val); err != nil {
+			return err
+		}
+	}
 	return nil
 }
@@ -21,29 +21,103 @@
 package core
 
 import (
+	"slices"
+	"sort"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
-	"github.com/sourcegraph/conc/iter"
 )
 
-// processSyncCommitteeUpdates processes the sync committee updates.
+// processSyncCommitteeUpdates computes the validator set to send to CometBFT
+// and returns only the updates relative to the previously sent set. The set
+// holds the active validators with the highest effective balance, ties
+// broken by index, up to ValidatorSetCap. Validators that drop out of the set
+// are removed with zero power.
 func (sp *StateProcessor[
-	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, ValidatorT, _, _, _, _,
+	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
 ]) processSyncCommitteeUpdates(
 	st BeaconStateT,
 ) (transition.ValidatorUpdates, error) {
-	vals, err := st.GetValidatorsByEffectiveBalance()
+	slot, err := st.GetSlot()
+	if err != nil {
+		return nil, err
+	}
+	// The set is applied from the next epoch onwards.
+	epoch := sp.cs.SlotToEpoch(slot) + 1
+
+	validators, err := st.GetValidators()
 	if err != nil {
 		return nil, err
 	}
 
-	return iter.MapErr(
-		vals,
-		func(val *ValidatorT) (*transition.ValidatorUpdate, error) {
-			v := (*val)
-			return &transition.ValidatorUpdate{
-				Pubkey:           v.GetPubkey(),
-				EffectiveBalance: v.GetEffectiveBalance(),
-			}, nil
-		},
+	prevSet, err := st.GetValidatorSet()
+	if err != nil {
+		return nil, err
+	}
+
+	// Only active validators with effective balance that have not been
+	// slashed are eligible for the set. Before the activation queue epoch,
+	// validators are only activated at that epoch, so those that have not
+	// exited are eligible as well.
+	var (
+		beforeQueue = epoch < sp.cs.ActivationQueueEpoch()
+		farFuture   = math.Epoch(constants.FarFutureEpoch)
+		candidates  = make([]math.ValidatorIndex, 0, len(validators))
 	)
+	for i, val := range validators {
+		active := val.IsActive(epoch) || (beforeQueue &&
+			val.GetActivationEpoch() == farFuture &&
+			epoch < val.GetExitEpoch())
+		if !active || val.GetEffectiveBalance() == 0 || val.IsSlashed() {
+			continue
+		}
+		candidates = append(candidates, math.ValidatorIndex(i))
+	}
+
+	// Candidates are already ordered by index, so a stable sort breaks ties
+	// deterministically.
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return validators[candidates[i]].GetEffectiveBalance() >
+			validators[candidates[j]].GetEffectiveBalance()
+	})
+	if limit := sp.cs.ValidatorSetCap(); limit > 0 &&
+		uint64(len(candidates)) > limit {
+		candidates = candidates[:limit]
+	}
+
+	var (
+		set     = make(map[math.ValidatorIndex]math.Gwei, len(candidates))
+		updates transition.ValidatorUpdates
+	)
+	for _, idx := range candidates {
+		power := validators[idx].GetEffectiveBalance()
+		set[idx] = power
+		if prev, ok := prevSet[idx]; ok && prev == power {
+			continue
+		}
+		updates = append(updates, &transition.ValidatorUpdate{
+			Pubkey:           validators[idx].GetPubkey(),
+			EffectiveBalance: power,
+		})
+	}
+
+	removed := make([]math.ValidatorIndex, 0)
+	for idx := range prevSet {
+		if _, ok := set[idx]; !ok {
+			removed = append(removed, idx)
+		}
+	}
+	slices.Sort(removed)
+	for _, idx := range removed {
+		updates = append(updates, &transition.ValidatorUpdate{
+			Pubkey:           validators[idx].GetPubkey(),
+			EffectiveBalance: 0,
+		})
+	}
+
+	if err = st.SetValidatorSet(set); err != nil {
+		return nil, err
+	}
+	return updates, nil
 }
@@ -0,0 +1,152 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/chain-spec/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/config/pkg/spec"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	cryptomocks "github.com/berachain/beacon-kit/mod/primitives/pkg/crypto/mocks"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core/mocks"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProcessValidatorSetUpdates(t *testing.T) {
+	data := spec.BaseSpec()
+	data.ValidatorSetCap = 3
+	cs := chain.NewChainSpec(data)
+	st := genesisState(t, cs, 4)
+	sp := createStateProcessor(
+		cs,
+		mocks.NewExecutionEngine[
+			*types.ExecutionPayload,
+			*types.ExecutionPayloadHeader,
+			engineprimitives.Withdrawals,
+		](t),
+		&cryptomocks.BLSSigner{},
+		dummyProposerAddressVerifier,
+	)
+
+	// All validators have the same effective balance, so the cap keeps the
+	// lowest indices.
+	maxBalance := math.Gwei(cs.MaxEffectiveBalance())
+	set, err := st.GetValidatorSet()
+	require.NoError(t, err)
+	require.Equal(t, map[math.ValidatorIndex]math.Gwei{
+		0: maxBalance, 1: maxBalance, 2: maxBalance,
+	}, set)
+
+	// Validator 1 loses less than the downward threshold and keeps its
+	// effective balance, while validator 2 loses a full increment and drops
+	// out of the set in favour of validator 3.
+	increment := math.Gwei(cs.EffectiveBalanceIncrement())
+	hysteresis := increment / math.Gwei(cs.HysteresisQuotient())
+	require.NoError(t, st.SetBalance(1, maxBalance-hysteresis))
+	require.NoError(t, st.SetBalance(2, maxBalance-increment))
+
+	slot := math.Slot(cs.SlotsPerEpoch() - 1)
+	require.NoError(t, st.SetSlot(slot))
+	updates, err := sp.ProcessSlots(st, slot+1)
+	require.NoError(t, err)
+
+	val1, err := st.ValidatorByIndex(1)
+	require.NoError(t, err)
+	require.Equal(t, maxBalance, val1.GetEffectiveBalance())
+	val2, err := st.ValidatorByIndex(2)
+	require.NoError(t, err)
+	require.Equal(t, maxBalance-increment, val2.GetEffectiveBalance())
+	val3, err := st.ValidatorByIndex(3)
+	require.NoError(t, err)
+
+	// Only the validators entering and leaving the set are updated.
+	require.Equal(t, transition.ValidatorUpdates{
+		{Pubkey: val3.GetPubkey(), EffectiveBalance: maxBalance},
+		{Pubkey: val2.GetPubkey(), EffectiveBalance: 0},
+	}, updates)
+	requireStateRoot(t, st)
+
+	// Nothing changes in the next epoch, so no updates are sent.
+	slot = math.Slot(2*cs.SlotsPerEpoch() - 1)
+	require.NoError(t, st.SetSlot(slot))
+	updates, err = sp.ProcessSlots(st, slot+1)
+	require.NoError(t, err)
+	require.Empty(t, updates)
+}
+
+func TestProcessValidatorSetUpdates_ActivationQueueEpoch(t *testing.T) {
+	data := spec.BaseSpec()
+	data.ActivationQueueEpoch = 2
+	cs := chain.NewChainSpec(data)
+	st := genesisState(t, cs, 1)
+	sp := createStateProcessor(
+		cs,
+		mocks.NewExecutionEngine[
+			*types.ExecutionPayload,
+			*types.ExecutionPayloadHeader,
+			engineprimitives.Withdrawals,
+		](t),
+		&cryptomocks.BLSSigner{},
+		dummyProposerAddressVerifier,
+	)
+	processEpoch := func(epoch uint64) transition.ValidatorUpdates {
+		slot := math.Slot((epoch+1)*cs.SlotsPerEpoch() - 1)
+		require.NoError(t, st.SetSlot(slot))
+		updates, err := sp.ProcessSlots(st, slot+1)
+		require.NoError(t, err)
+		return updates
+	}
+
+	// A validator joining before the activation queue epoch is not active
+	// until that epoch, but still joins the set right away.
+	maxBalance := math.Gwei(cs.MaxEffectiveBalance())
+	pubkey := [48]byte{0xff}
+	require.NoError(t, st.AddValidator(types.NewValidatorFromDeposit(
+		pubkey,
+		types.WithdrawalCredentials{},
+		maxBalance,
+		math.Gwei(cs.EffectiveBalanceIncrement()),
+		maxBalance,
+	)))
+	require.NoError(t, st.IncreaseBalance(1, maxBalance))
+	require.Equal(t, transition.ValidatorUpdates{
+		{Pubkey: pubkey, EffectiveBalance: maxBalance},
+	}, processEpoch(0))
+
+	// The set is unchanged when the validator is activated at the activation
+	// queue epoch.
+	require.Empty(t, processEpoch(1))
+	val, err := st.ValidatorByIndex(1)
+	require.NoError(t, err)
+	require.Equal(t, math.Epoch(2), val.GetActivationEpoch())
+	require.Empty(t, processEpoch(2))
+
+	set, err := st.GetValidatorSet()
+	require.NoError(t, err)
+	require.Equal(t, map[math.ValidatorIndex]math.Gwei{
+		0: maxBalance, 1: maxBalance,
+	}, set)
+	requireStateRoot(t, st)
+}
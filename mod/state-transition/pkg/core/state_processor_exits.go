@@ -0,0 +1,101 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+)
+
+// ValidateVoluntaryExit checks that a voluntary exit can be applied to the
+// given state, as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#voluntary-exits
+//
+//nolint:lll
+func (sp *StateProcessor[
+	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) ValidateVoluntaryExit(
+	st BeaconStateT,
+	signedExit *types.SignedVoluntaryExit,
+) error {
+	if signedExit == nil || signedExit.Message == nil {
+		return ErrMalformedOperation
+	}
+	exit := signedExit.Message
+	val, err := st.ValidatorByIndex(exit.ValidatorIndex)
+	if err != nil {
+		return err
+	}
+
+	epoch, err := sp.currentEpoch(st)
+	if err != nil {
+		return err
+	}
+	if !sp.isExitable(val, epoch) {
+		return ErrValidatorNotExitable
+	}
+	if epoch < exit.Epoch {
+		return ErrVoluntaryExitTooEarly
+	}
+	if epoch < val.GetActivationEpoch()+
+		math.Epoch(sp.cs.ShardCommitteePeriod()) {
+		return ErrValidatorNotActiveLongEnough
+	}
+
+	genesisValidatorsRoot, err := st.GetGenesisValidatorsRoot()
+	if err != nil {
+		return err
+	}
+	return signedExit.VerifySignature(
+		types.NewForkData(
+			version.FromUint32[common.Version](
+				sp.cs.ActiveForkVersionForEpoch(exit.Epoch),
+			), genesisValidatorsRoot,
+		),
+		sp.cs.DomainTypeVoluntaryExit(),
+		val.GetPubkey(),
+		sp.signer.VerifySignature,
+	)
+}
+
+// processVoluntaryExit as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#voluntary-exits
+//
+//nolint:lll
+func (sp *StateProcessor[
+	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) processVoluntaryExit(
+	st BeaconStateT,
+	signedExit *types.SignedVoluntaryExit,
+) error {
+	if err := sp.ValidateVoluntaryExit(st, signedExit); err != nil {
+		return err
+	}
+
+	idx := signedExit.GetValidatorIndex()
+	val, err := st.ValidatorByIndex(idx)
+	if err != nil {
+		return err
+	}
+	return sp.initiateValidatorExit(st, idx, val)
+}
@@ -0,0 +1,179 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/chain-spec/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/config/pkg/spec"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	cryptomocks "github.com/berachain/beacon-kit/mod/primitives/pkg/crypto/mocks"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core"
+	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransitionExitsAndSlashings(t *testing.T) {
+	cs := electraChainSpec(256)
+	st := genesisState(t, cs, 3)
+	signer := &cryptomocks.BLSSigner{}
+	signer.On(
+		"VerifySignature",
+		mock.Anything, mock.Anything, mock.Anything,
+	).Return(nil)
+	sp := createStateProcessor(
+		cs,
+		mocks.NewExecutionEngine[
+			*types.ExecutionPayload,
+			*types.ExecutionPayloadHeader,
+			engineprimitives.Withdrawals,
+		](t),
+		signer,
+		dummyProposerAddressVerifier,
+	)
+
+	signedHeader := func(bodyRoot common.Root) *types.SignedBeaconBlockHeader {
+		return &types.SignedBeaconBlockHeader{
+			Header: types.NewBeaconBlockHeader(
+				0, 1, common.Root{}, common.Root{}, bodyRoot,
+			),
+		}
+	}
+	body := new(types.BeaconBlockBody).Empty(version.Electra)
+	body.ExecutionPayload.Withdrawals = []*engineprimitives.Withdrawal{}
+	body.ExecutionPayload.BaseFeePerGas = math.NewU256(0)
+	body.SetVoluntaryExits([]*types.SignedVoluntaryExit{{
+		Message: &types.VoluntaryExit{ValidatorIndex: 0},
+	}})
+	body.SetProposerSlashings([]*types.ProposerSlashing{{
+		SignedHeader1: signedHeader(common.Root{0x01}),
+		SignedHeader2: signedHeader(common.Root{0x02}),
+	}})
+
+	ctx := &transition.Context{
+		SkipPayloadVerification: true,
+		SkipValidateResult:      true,
+	}
+	_, err := sp.Transition(ctx, st, buildNextBlock(t, st, body))
+	require.NoError(t, err)
+
+	exited, err := st.ValidatorByIndex(0)
+	require.NoError(t, err)
+	require.Equal(t, math.Epoch(1), exited.GetExitEpoch())
+	require.False(t, exited.IsSlashed())
+
+	// The slashed proposer exits, is penalized and cannot withdraw before
+	// the slashings vector has rotated.
+	slashed, err := st.ValidatorByIndex(1)
+	require.NoError(t, err)
+	require.True(t, slashed.IsSlashed())
+	require.Equal(t, math.Epoch(1), slashed.GetExitEpoch())
+	require.GreaterOrEqual(
+		t,
+		slashed.GetWithdrawableEpoch(),
+		math.Epoch(cs.EpochsPerSlashingsVector()),
+	)
+	balance, err := st.GetBalance(1)
+	require.NoError(t, err)
+	maxBalance := math.Gwei(cs.MaxEffectiveBalance())
+	require.Equal(
+		t,
+		maxBalance-maxBalance/math.Gwei(cs.MinSlashingPenaltyQuotient()),
+		balance,
+	)
+
+	// The same proposer cannot be slashed twice.
+	require.ErrorIs(
+		t,
+		sp.ValidateProposerSlashing(st, body.GetProposerSlashings()[0]),
+		core.ErrValidatorNotSlashable,
+	)
+}
+
+func TestValidateVoluntaryExitTooEarly(t *testing.T) {
+	cs := electraChainSpec(256)
+	st := genesisState(t, cs, 1)
+	sp := createStateProcessor(
+		cs,
+		mocks.NewExecutionEngine[
+			*types.ExecutionPayload,
+			*types.ExecutionPayloadHeader,
+			engineprimitives.Withdrawals,
+		](t),
+		&cryptomocks.BLSSigner{},
+		dummyProposerAddressVerifier,
+	)
+
+	err := sp.ValidateVoluntaryExit(st, &types.SignedVoluntaryExit{
+		Message: &types.VoluntaryExit{Epoch: 5, ValidatorIndex: 0},
+	})
+	require.ErrorIs(t, err, core.ErrVoluntaryExitTooEarly)
+	require.ErrorIs(
+		t,
+		sp.ValidateVoluntaryExit(st, nil),
+		core.ErrMalformedOperation,
+	)
+}
+
+func TestValidateVoluntaryExitNotActiveLongEnough(t *testing.T) {
+	data := spec.BaseSpec()
+	data.DenebPlusForkEpoch = 0
+	data.ElectraForkEpoch = 0
+	data.ShardCommitteePeriod = 2
+	cs := chain.NewChainSpec(data)
+	st := genesisState(t, cs, 1)
+	signer := &cryptomocks.BLSSigner{}
+	signer.On(
+		"VerifySignature",
+		mock.Anything, mock.Anything, mock.Anything,
+	).Return(nil)
+	sp := createStateProcessor(
+		cs,
+		mocks.NewExecutionEngine[
+			*types.ExecutionPayload,
+			*types.ExecutionPayloadHeader,
+			engineprimitives.Withdrawals,
+		](t),
+		signer,
+		dummyProposerAddressVerifier,
+	)
+
+	// The genesis validator is active since epoch 0, so it may only exit
+	// from epoch 2 on.
+	exit := &types.SignedVoluntaryExit{
+		Message: &types.VoluntaryExit{ValidatorIndex: 0},
+	}
+	require.NoError(t, st.SetSlot(math.Slot(2*cs.SlotsPerEpoch()-1)))
+	require.ErrorIs(
+		t,
+		sp.ValidateVoluntaryExit(st, exit),
+		core.ErrValidatorNotActiveLongEnough,
+	)
+	require.NoError(t, st.SetSlot(math.Slot(2*cs.SlotsPerEpoch())))
+	require.NoError(t, sp.ValidateVoluntaryExit(st, exit))
+}
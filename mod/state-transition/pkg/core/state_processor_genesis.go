@@ -107,11 +107,23 @@ func (sp *StateProcessor[
 		}
 	}
 
-	// TODO: process activations.
+	// Process activations.
 	validators, err := st.GetValidators()
 	if err != nil {
 		return nil, err
 	}
+	for i, val := range validators {
+		if val.GetEffectiveBalance() == 0 {
+			continue
+		}
+		val.SetActivationEligibilityEpoch(math.Epoch(constants.GenesisEpoch))
+		val.SetActivationEpoch(math.Epoch(constants.GenesisEpoch))
+		if err = st.UpdateValidatorAtIndex(
+			math.ValidatorIndex(i), val,
+		); err != nil {
+			return nil, err
+		}
+	}
 
 	// Handle special case bartio genesis.
 	if sp.cs.DepositEth1ChainID() == spec.BartioChainID {
@@ -160,5 +172,8 @@ func (sp *StateProcessor[
 	if err != nil {
 		return nil, err
 	}
+	if err = sp.initProposerSchedule(st); err != nil {
+		return nil, err
+	}
 	return updates, nil
 }
@@ -0,0 +1,207 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"fmt"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/json"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+)
+
+// genesisState is a full beacon state a chain is started from, decoded from
+// the JSON encoding of the marshallable beacon state along with the pending
+// consolidations, which the marshallable beacon state does not hold.
+type genesisState[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT, ForkT,
+	ValidatorT any,
+] struct {
+	GenesisValidatorsRoot        common.Root
+	Slot                         math.Slot
+	Fork                         ForkT
+	LatestBlockHeader            BeaconBlockHeaderT
+	BlockRoots                   []common.Root
+	StateRoots                   []common.Root
+	Eth1Data                     Eth1DataT
+	Eth1DepositIndex             uint64
+	LatestExecutionPayloadHeader ExecutionPayloadHeaderT
+	Validators                   []ValidatorT
+	Balances                     []uint64
+	RandaoMixes                  []common.Bytes32
+	NextWithdrawalIndex          uint64
+	NextWithdrawalValidatorIndex math.ValidatorIndex
+	Slashings                    []math.Gwei
+	TotalSlashing                math.Gwei
+	PendingConsolidations        map[math.ValidatorIndex]math.ValidatorIndex
+}
+
+// InitializeBeaconStateFromGenesisState initializes the beacon state from
+// the JSON encoded full beacon state of a previous chain, as exported by
+// the export command, rather than from premined deposits. The validator set
+// and the proposer schedule are derived from the validators of the state,
+// while the participation and inactivity of the validators start over.
+func (sp *StateProcessor[
+	_, _, BeaconBlockHeaderT, BeaconStateT, _, _,
+	Eth1DataT, _, ExecutionPayloadHeaderT, ForkT, _, _, ValidatorT, _, _, _, _,
+]) InitializeBeaconStateFromGenesisState(
+	st BeaconStateT,
+	bz []byte,
+) (transition.ValidatorUpdates, error) {
+	var gs genesisState[
+		BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT, ForkT,
+		ValidatorT,
+	]
+	if err := json.Unmarshal(bz, &gs); err != nil {
+		return nil, err
+	}
+	if err := sp.validateGenesisState(&gs); err != nil {
+		return nil, err
+	}
+
+	if err := st.SetGenesisValidatorsRoot(
+		gs.GenesisValidatorsRoot,
+	); err != nil {
+		return nil, err
+	}
+	if err := st.SetSlot(gs.Slot); err != nil {
+		return nil, err
+	}
+	if err := st.SetFork(gs.Fork); err != nil {
+		return nil, err
+	}
+	if err := st.SetLatestBlockHeader(gs.LatestBlockHeader); err != nil {
+		return nil, err
+	}
+	for i := range gs.BlockRoots {
+		if err := st.UpdateBlockRootAtIndex(
+			uint64(i), gs.BlockRoots[i],
+		); err != nil {
+			return nil, err
+		}
+		if err := st.UpdateStateRootAtIndex(
+			uint64(i), gs.StateRoots[i],
+		); err != nil {
+			return nil, err
+		}
+	}
+	if err := st.SetEth1Data(gs.Eth1Data); err != nil {
+		return nil, err
+	}
+	if err := st.SetEth1DepositIndex(gs.Eth1DepositIndex); err != nil {
+		return nil, err
+	}
+	if err := st.SetLatestExecutionPayloadHeader(
+		gs.LatestExecutionPayloadHeader,
+	); err != nil {
+		return nil, err
+	}
+	// Validators are added with a zero balance.
+	for i, val := range gs.Validators {
+		if err := st.AddValidator(val); err != nil {
+			return nil, err
+		}
+		if err := st.IncreaseBalance(
+			math.ValidatorIndex(i), math.Gwei(gs.Balances[i]),
+		); err != nil {
+			return nil, err
+		}
+	}
+	for i, mix := range gs.RandaoMixes {
+		if err := st.UpdateRandaoMixAtIndex(uint64(i), mix); err != nil {
+			return nil, err
+		}
+	}
+	if err := st.SetNextWithdrawalIndex(gs.NextWithdrawalIndex); err != nil {
+		return nil, err
+	}
+	if err := st.SetNextWithdrawalValidatorIndex(
+		gs.NextWithdrawalValidatorIndex,
+	); err != nil {
+		return nil, err
+	}
+	for i, amount := range gs.Slashings {
+		if err := st.UpdateSlashingAtIndex(uint64(i), amount); err != nil {
+			return nil, err
+		}
+	}
+	if err := st.SetTotalSlashing(gs.TotalSlashing); err != nil {
+		return nil, err
+	}
+	if err := st.SetPendingConsolidations(
+		gs.PendingConsolidations,
+	); err != nil {
+		return nil, err
+	}
+
+	updates, err := sp.processSyncCommitteeUpdates(st)
+	if err != nil {
+		return nil, err
+	}
+	if err = sp.initProposerSchedule(st); err != nil {
+		return nil, err
+	}
+	return updates, nil
+}
+
+// validateGenesisState checks that the vectors of the genesis state have the
+// lengths of the chain spec, that every validator has a balance and that the
+// pending consolidations are between validators of the state.
+func (sp *StateProcessor[
+	_, _, BeaconBlockHeaderT, _, _, _,
+	Eth1DataT, _, ExecutionPayloadHeaderT, ForkT, _, _, ValidatorT, _, _, _, _,
+]) validateGenesisState(
+	gs *genesisState[
+		BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT, ForkT,
+		ValidatorT,
+	],
+) error {
+	switch slotsPerHistoricalRoot := sp.cs.SlotsPerHistoricalRoot(); {
+	case uint64(len(gs.BlockRoots)) != slotsPerHistoricalRoot,
+		uint64(len(gs.StateRoots)) != slotsPerHistoricalRoot:
+		return fmt.Errorf(
+			"%w: %d block roots and %d state roots, expected %d",
+			ErrInvalidGenesisState, len(gs.BlockRoots), len(gs.StateRoots),
+			slotsPerHistoricalRoot,
+		)
+	case uint64(len(gs.RandaoMixes)) != sp.cs.EpochsPerHistoricalVector():
+		return fmt.Errorf("%w: %d randao mixes, expected %d",
+			ErrInvalidGenesisState, len(gs.RandaoMixes),
+			sp.cs.EpochsPerHistoricalVector(),
+		)
+	case len(gs.Balances) != len(gs.Validators):
+		return fmt.Errorf("%w: %d balances for %d validators",
+			ErrInvalidGenesisState, len(gs.Balances), len(gs.Validators),
+		)
+	}
+	for source, target := range gs.PendingConsolidations {
+		if source.Unwrap() >= uint64(len(gs.Validators)) ||
+			target.Unwrap() >= uint64(len(gs.Validators)) {
+			return fmt.Errorf(
+				"%w: consolidation of validator %d into %d out of %d "+
+					"validators",
+				ErrInvalidGenesisState, source, target, len(gs.Validators),
+			)
+		}
+	}
+	return nil
+}
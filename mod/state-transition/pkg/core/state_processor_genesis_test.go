@@ -21,6 +21,7 @@
 package core_test
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/berachain/beacon-kit/mod/chain-spec/pkg/chain"
@@ -33,6 +34,7 @@ import (
 	cryptomocks "github.com/berachain/beacon-kit/mod/primitives/pkg/crypto/mocks"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core"
 	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core/mocks"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -112,7 +114,9 @@ func TestInitialize(t *testing.T) {
 
 	// check outputs
 	require.NoError(t, err)
-	require.Len(t, vals, len(deposits))
+	// The last deposit is below the effective balance increment, so its
+	// validator has no voting power and is left out of the validator set.
+	require.Len(t, vals, len(deposits)-1)
 
 	// check beacon state changes
 	resSlot, err := beaconState.GetSlot()
@@ -233,7 +237,9 @@ func TestInitializeBartio(t *testing.T) {
 
 	// check outputs
 	require.NoError(t, err)
-	require.Len(t, vals, len(deposits))
+	// The last deposit is below the effective balance increment, so its
+	// validator has no voting power and is left out of the validator set.
+	require.Len(t, vals, len(deposits)-1)
 
 	// check beacon state changes
 	resSlot, err := beaconState.GetSlot()
@@ -326,3 +332,120 @@ func commonChecksValidators(
 func dummyProposerAddressVerifier(bytes.B48) ([]byte, error) {
 	return nil, nil
 }
+
+func TestInitializeFromGenesisState(t *testing.T) {
+	cs := spec.BetnetChainSpec()
+	execEngine := mocks.NewExecutionEngine[
+		*types.ExecutionPayload,
+		*types.ExecutionPayloadHeader,
+		engineprimitives.Withdrawals,
+	](t)
+	mocksSigner := &cryptomocks.BLSSigner{}
+	mocksSigner.On(
+		"VerifySignature",
+		mock.Anything, mock.Anything, mock.Anything,
+	).Return(nil)
+	sp := createStateProcessor(
+		cs,
+		execEngine,
+		mocksSigner,
+		dummyProposerAddressVerifier,
+	)
+
+	// Build the state of a previous chain from premined deposits.
+	kvStore, err := initStore()
+	require.NoError(t, err)
+	prev := new(TestBeaconStateT).NewFromDB(kvStore, cs)
+	deposits := []*types.Deposit{
+		{
+			Pubkey: [48]byte{0x01},
+			Amount: math.Gwei(cs.MaxEffectiveBalance()),
+			Index:  uint64(0),
+		},
+		{
+			Pubkey: [48]byte{0x02},
+			Amount: math.Gwei(cs.MaxEffectiveBalance() / 2),
+			Index:  uint64(1),
+		},
+	}
+	prevVals, err := sp.InitializePreminedBeaconStateFromEth1(
+		prev,
+		deposits,
+		new(types.ExecutionPayloadHeader).Empty(),
+		version.FromUint32[common.Version](version.Deneb),
+	)
+	require.NoError(t, err)
+	require.NoError(t, prev.SetSlot(42))
+	require.NoError(t, prev.SetNextWithdrawalIndex(7))
+	require.NoError(t, prev.IncreaseBalance(1, 1_000))
+	consolidations := map[math.ValidatorIndex]math.ValidatorIndex{1: 0}
+	require.NoError(t, prev.SetPendingConsolidations(consolidations))
+
+	want, err := prev.GetMarshallable()
+	require.NoError(t, err)
+	bz := marshalGenesisState(t, want, consolidations)
+
+	// Restart a chain from the exported state.
+	kvStore, err = initStore()
+	require.NoError(t, err)
+	beaconState := new(TestBeaconStateT).NewFromDB(kvStore, cs)
+	vals, err := sp.InitializeBeaconStateFromGenesisState(beaconState, bz)
+	require.NoError(t, err)
+	require.Len(t, vals, len(prevVals))
+
+	got, err := beaconState.GetMarshallable()
+	require.NoError(t, err)
+	require.Empty(t, got.Diff(want))
+	require.Equal(t, prev.HashTreeRoot(), beaconState.HashTreeRoot())
+
+	proposers, err := beaconState.GetProposerSet()
+	require.NoError(t, err)
+	prevProposers, err := prev.GetProposerSet()
+	require.NoError(t, err)
+	require.Equal(t, prevProposers, proposers)
+	pending, err := beaconState.GetPendingConsolidations()
+	require.NoError(t, err)
+	require.Equal(t, consolidations, pending)
+
+	// States with consolidations of validators they do not hold are
+	// rejected.
+	kvStore, err = initStore()
+	require.NoError(t, err)
+	_, err = sp.InitializeBeaconStateFromGenesisState(
+		new(TestBeaconStateT).NewFromDB(kvStore, cs),
+		marshalGenesisState(
+			t, want, map[math.ValidatorIndex]math.ValidatorIndex{2: 0},
+		),
+	)
+	require.ErrorIs(t, err, core.ErrInvalidGenesisState)
+
+	// States without the vectors of the chain spec are rejected.
+	want.RandaoMixes = want.RandaoMixes[1:]
+	bz, err = json.Marshal(want)
+	require.NoError(t, err)
+	kvStore, err = initStore()
+	require.NoError(t, err)
+	_, err = sp.InitializeBeaconStateFromGenesisState(
+		new(TestBeaconStateT).NewFromDB(kvStore, cs), bz,
+	)
+	require.ErrorIs(t, err, core.ErrInvalidGenesisState)
+}
+
+// marshalGenesisState returns the JSON encoding of the marshallable state
+// with the given pending consolidations, as exported by the export command.
+func marshalGenesisState(
+	t *testing.T,
+	st any,
+	consolidations map[math.ValidatorIndex]math.ValidatorIndex,
+) []byte {
+	t.Helper()
+	bz, err := json.Marshal(st)
+	require.NoError(t, err)
+	var fields map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(bz, &fields))
+	fields["PendingConsolidations"], err = json.Marshal(consolidations)
+	require.NoError(t, err)
+	bz, err = json.Marshal(fields)
+	require.NoError(t, err)
+	return bz
+}
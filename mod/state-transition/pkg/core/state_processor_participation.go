@@ -0,0 +1,78 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package core
+
+import (
+	"cosmossdk.io/collections"
+	"github.com/berachain/beacon-kit/mod/errors"
+)
+
+// processParticipation records the participation of the validators in the
+// commit of the previous block, along with the proposer of the block, so
+// that they can be rewarded at the end of the epoch. It is a no-op unless
+// the block is being finalized, since only then are the commit votes known.
+func (sp *StateProcessor[
+	BeaconBlockT, _, _, BeaconStateT, ContextT, _, _, _, _, _, _, _, _, _, _, _, _,
+]) processParticipation(
+	ctx ContextT,
+	st BeaconStateT,
+	blk BeaconBlockT,
+) error {
+	votes := ctx.GetLastCommitVotes()
+	if len(votes) == 0 {
+		return nil
+	}
+
+	commits, err := st.GetEpochCommits()
+	if err != nil {
+		return err
+	}
+	if err = st.SetEpochCommits(commits + 1); err != nil {
+		return err
+	}
+
+	for _, vote := range votes {
+		if !vote.Signed {
+			continue
+		}
+
+		// Votes of validators that have since left the set are ignored.
+		idx, err := st.ValidatorIndexByCometBFTAddress(vote.Address)
+		if errors.Is(err, collections.ErrNotFound) {
+			continue
+		} else if err != nil {
+			return err
+		}
+
+		participation, err := st.GetEpochParticipation(idx)
+		if err != nil {
+			return err
+		}
+		if err = st.SetEpochParticipation(idx, participation+1); err != nil {
+			return err
+		}
+	}
+
+	proposals, err := st.GetEpochProposals(blk.GetProposerIndex())
+	if err != nil {
+		return err
+	}
+	return st.SetEpochProposals(blk.GetProposerIndex(), proposals+1)
+}
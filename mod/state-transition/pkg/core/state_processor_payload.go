@@ -27,7 +27,9 @@ import (
 	"github.com/berachain/beacon-kit/mod/config/pkg/spec"
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 	"golang.org/x/sync/errgroup"
 )
 
@@ -184,12 +186,23 @@ func (sp *StateProcessor[
 		)
 	}
 
+	// As of Electra the execution requests are sent along with the payload,
+	// so that they are checked against the requests hash of the payload.
+	var executionRequests []bytes.Bytes
+	if sp.cs.ActiveForkVersionForSlot(blk.GetSlot()) >= version.Electra {
+		executionRequests, err = body.GetExecutionRequests().Encode()
+		if err != nil {
+			return err
+		}
+	}
+
 	parentBeaconBlockRoot := blk.GetParentBlockRoot()
 	if err = sp.executionEngine.VerifyAndNotifyNewPayload(
 		ctx, engineprimitives.BuildNewPayloadRequest(
 			payload,
 			body.GetBlobKzgCommitments().ToVersionedHashes(),
 			&parentBeaconBlockRoot,
+			executionRequests,
 			optimisticEngine,
 		),
 	); err != nil {
@@ -0,0 +1,109 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+)
+
+// processProposerSchedule checks the proposer of the block against the one
+// the proposer schedule expects CometBFT to select, then advances the
+// schedule past the block. A block committed in a later round has another
+// proposer than the expected one, so a mismatch is only reported in the
+// metrics, once the block is finalized. The schedule follows CometBFT
+// regardless, as CometBFT advances the proposer priorities once per block
+// whatever the round.
+func (sp *StateProcessor[
+	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) processProposerSchedule(
+	st BeaconStateT,
+	proposer math.ValidatorIndex,
+	finalizing bool,
+) error {
+	set, err := st.GetValidatorSet()
+	if err != nil {
+		return err
+	}
+	current, err := st.GetProposerSet()
+	if err != nil {
+		return err
+	}
+	next, err := st.GetNextProposerSet()
+	if err != nil {
+		return err
+	}
+
+	// Chains started before the schedule was tracked seed it from the
+	// current validator set. Such a schedule has other proposer priorities
+	// than CometBFT and can keep disagreeing with it.
+	if current == nil || next == nil {
+		current, next, err = newProposerSets(set, PubkeyOf(st.ValidatorByIndex))
+		if err != nil || current == nil {
+			return err
+		}
+	}
+
+	if finalizing && current.Proposer != proposer {
+		sp.logger.Warn(
+			"Block proposer differs from the proposer schedule",
+			"expected", current.Proposer, "actual", proposer,
+		)
+		sp.metrics.markProposerMismatch(current.Proposer, proposer)
+	}
+
+	current, next, err = rotateProposerSets(
+		next, set, PubkeyOf(st.ValidatorByIndex),
+	)
+	if err != nil {
+		return err
+	}
+	return sp.setProposerSets(st, current, next)
+}
+
+// initProposerSchedule initializes the proposer schedule from the genesis
+// validator set.
+func (sp *StateProcessor[
+	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) initProposerSchedule(st BeaconStateT) error {
+	set, err := st.GetValidatorSet()
+	if err != nil {
+		return err
+	}
+	current, next, err := newProposerSets(set, PubkeyOf(st.ValidatorByIndex))
+	if err != nil || current == nil {
+		return err
+	}
+	return sp.setProposerSets(st, current, next)
+}
+
+// setProposerSets stores the proposer set and the set that replaces it.
+func (sp *StateProcessor[
+	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) setProposerSets(
+	st BeaconStateT,
+	current, next *transition.ProposerSet,
+) error {
+	if err := st.SetProposerSet(current); err != nil {
+		return err
+	}
+	return st.SetNextProposerSet(next)
+}
@@ -0,0 +1,178 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/config/pkg/spec"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	cryptomocks "github.com/berachain/beacon-kit/mod/primitives/pkg/crypto/mocks"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core"
+	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core/mocks"
+	cmtcrypto "github.com/cometbft/cometbft/crypto"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProposerScheduleMatchesCometBFT(t *testing.T) {
+	const numValidators = 5
+	cs := spec.DevnetChainSpec()
+	st := genesisState(t, cs, numValidators)
+	signer := &cryptomocks.BLSSigner{}
+	signer.On(
+		"VerifySignature",
+		mock.Anything, mock.Anything, mock.Anything,
+	).Return(nil)
+	sink := &testTelemetrySink{}
+	sp := createStateProcessorWithSink(
+		cs,
+		mocks.NewExecutionEngine[
+			*types.ExecutionPayload,
+			*types.ExecutionPayloadHeader,
+			engineprimitives.Withdrawals,
+		](t),
+		signer,
+		func(pubkey crypto.BLSPubkey) ([]byte, error) {
+			return cmtcrypto.AddressHash(pubkey[:]).Bytes(), nil
+		},
+		sink,
+	)
+
+	pubkeyOf := core.PubkeyOf(st.ValidatorByIndex)
+	address := func(idx math.ValidatorIndex) []byte {
+		pubkey, err := pubkeyOf(idx)
+		require.NoError(t, err)
+		return cmtcrypto.AddressHash(pubkey[:]).Bytes()
+	}
+	indexOf := func(val *cmttypes.Validator) math.ValidatorIndex {
+		idx, err := st.ValidatorIndexByCometBFTAddress(val.Address)
+		require.NoError(t, err)
+		return idx
+	}
+
+	// Mirror the validator sets CometBFT keeps from genesis onwards.
+	set, err := st.GetValidatorSet()
+	require.NoError(t, err)
+	cmtVals := make([]*cmttypes.Validator, 0, len(set))
+	for idx, power := range set {
+		cmtVals = append(cmtVals, &cmttypes.Validator{
+			Address:     address(idx),
+			VotingPower: int64(power),
+		})
+	}
+	current := cmttypes.NewValidatorSet(cmtVals)
+	next := current.CopyIncrementProposerPriority(1)
+
+	body := &types.BeaconBlockBody{
+		ExecutionPayload: &types.ExecutionPayload{
+			Timestamp:     10,
+			ExtraData:     []byte("testing"),
+			Transactions:  [][]byte{},
+			Withdrawals:   []*engineprimitives.Withdrawal{},
+			BaseFeePerGas: math.NewU256(0),
+		},
+		Eth1Data: &types.Eth1Data{},
+		Deposits: []*types.Deposit{},
+	}
+	for height := range 8 {
+		var changes []*cmttypes.Validator
+		if height == 3 {
+			// Validator updates take effect two blocks later.
+			set[0] /= 2
+			delete(set, 4)
+			require.NoError(t, st.SetValidatorSet(set))
+			changes = []*cmttypes.Validator{
+				{Address: address(0), VotingPower: int64(set[0])},
+				{Address: address(4), VotingPower: 0},
+			}
+		}
+
+		// The schedule forecasts the proposers CometBFT selects.
+		stCurrent, err := st.GetProposerSet()
+		require.NoError(t, err)
+		stNext, err := st.GetNextProposerSet()
+		require.NoError(t, err)
+		forecast, err := core.ExpectedProposers(
+			stCurrent, stNext, 4, pubkeyOf,
+		)
+		require.NoError(t, err)
+		expected := []math.ValidatorIndex{
+			indexOf(current.GetProposer()), indexOf(next.GetProposer()),
+		}
+		vals := next.Copy()
+		for range 2 {
+			vals.IncrementProposerPriority(1)
+			expected = append(expected, indexOf(vals.GetProposer()))
+		}
+		if len(changes) == 0 {
+			require.Equal(t, expected, forecast)
+		} else {
+			require.Equal(t, expected[:2], forecast[:2])
+		}
+
+		blk := buildNextBlock(t, st, body)
+		blk.ProposerIndex = expected[0]
+		_, err = sp.Transition(&transition.Context{
+			SkipPayloadVerification: true,
+			SkipValidateResult:      true,
+			ProposerAddress:         address(expected[0]),
+		}, st, blk)
+		require.NoError(t, err)
+
+		current, next = next, next.Copy()
+		if len(changes) > 0 {
+			require.NoError(t, next.UpdateWithChangeSet(changes))
+		}
+		next.IncrementProposerPriority(1)
+	}
+	require.Empty(t, sink.counters)
+
+	// A block from another proposer, e.g. committed in a later round, is
+	// reported without failing the transition once it is finalized.
+	stCurrent, err := st.GetProposerSet()
+	require.NoError(t, err)
+	other := (stCurrent.Proposer + 1) % 4
+	blk := buildNextBlock(t, st, body)
+	blk.ProposerIndex = other
+	ctx := &transition.Context{
+		SkipPayloadVerification: true,
+		SkipValidateResult:      true,
+		ProposerAddress:         address(other),
+	}
+	_, err = sp.Transition(ctx, st.Copy(), blk)
+	require.NoError(t, err)
+	require.Empty(t, sink.counters)
+
+	ctx.Finalizing = true
+	_, err = sp.Transition(ctx, st, blk)
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		[]string{"beacon_kit.state_transition.proposer_mismatch"},
+		sink.counters,
+	)
+}
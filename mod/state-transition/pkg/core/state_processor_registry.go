@@ -0,0 +1,162 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"sort"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// processRegistryUpdates as defined in the Ethereum 2.0 specification, with
+// the activation churn limited by effective balance rather than by the
+// number of validators.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#registry-updates
+//
+//nolint:lll
+func (sp *StateProcessor[
+	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) processRegistryUpdates(
+	st BeaconStateT,
+) error {
+	slot, err := st.GetSlot()
+	if err != nil {
+		return err
+	}
+	epoch := sp.cs.SlotToEpoch(slot)
+
+	// Validators only go through the activation queue from the activation
+	// queue epoch, at which the validators that predate it are activated.
+	switch queueEpoch := sp.cs.ActivationQueueEpoch(); {
+	case epoch+1 < queueEpoch:
+		return nil
+	case epoch+1 == queueEpoch:
+		return sp.activateLegacyValidators(st, queueEpoch)
+	}
+
+	validators, err := st.GetValidators()
+	if err != nil {
+		return err
+	}
+
+	// Validators join the activation queue once they have an effective
+	// balance. Unlike in Ethereum, they do not need the maximum effective
+	// balance. CometBFT finalizes every block, so the current epoch is final.
+	queue := make([]math.ValidatorIndex, 0)
+	for i, val := range validators {
+		idx := math.ValidatorIndex(i)
+		if val.GetActivationEligibilityEpoch() ==
+			math.Epoch(constants.FarFutureEpoch) &&
+			val.GetEffectiveBalance() > 0 {
+			val.SetActivationEligibilityEpoch(epoch + 1)
+			if err = st.UpdateValidatorAtIndex(idx, val); err != nil {
+				return err
+			}
+			continue
+		}
+		if val.IsEligibleForActivation(epoch) {
+			queue = append(queue, idx)
+		}
+	}
+
+	// The queue is ordered by eligibility epoch, then by index.
+	sort.SliceStable(queue, func(i, j int) bool {
+		return validators[queue[i]].GetActivationEligibilityEpoch() <
+			validators[queue[j]].GetActivationEligibilityEpoch()
+	})
+
+	churn, err := sp.getActivationChurnLimit(st)
+	if err != nil {
+		return err
+	}
+
+	var activated math.Gwei
+	for i, idx := range queue {
+		val := validators[idx]
+		// The head of the queue is always activated, so that a validator
+		// above the churn limit cannot stall the queue.
+		if i > 0 && activated+val.GetEffectiveBalance() > churn {
+			break
+		}
+		activated += val.GetEffectiveBalance()
+		val.SetActivationEpoch(epoch + 1)
+		if err = st.UpdateValidatorAtIndex(idx, val); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// activateLegacyValidators activates the validators that joined before the
+// activation queue at the given epoch, bypassing the churn limit.
+func (sp *StateProcessor[
+	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) activateLegacyValidators(
+	st BeaconStateT,
+	epoch math.Epoch,
+) error {
+	validators, err := st.GetValidators()
+	if err != nil {
+		return err
+	}
+	farFuture := math.Epoch(constants.FarFutureEpoch)
+	for i, val := range validators {
+		if val.GetActivationEpoch() != farFuture ||
+			val.GetExitEpoch() != farFuture ||
+			val.GetEffectiveBalance() == 0 {
+			continue
+		}
+		val.SetActivationEligibilityEpoch(epoch)
+		val.SetActivationEpoch(epoch)
+		if err = st.UpdateValidatorAtIndex(
+			math.ValidatorIndex(i), val,
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getActivationChurnLimit returns the effective balance that may be
+// activated in the current epoch, derived from the total active balance.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/electra/beacon-chain.md#new-get_activation_exit_churn_limit
+//
+//nolint:lll
+func (sp *StateProcessor[
+	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) getActivationChurnLimit(
+	st BeaconStateT,
+) (math.Gwei, error) {
+	totalActiveBalance, err := st.GetTotalActiveBalances(
+		sp.cs.SlotsPerEpoch(),
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	churn := math.Gwei(sp.cs.MinPerEpochChurnLimit())
+	if quotient := sp.cs.ChurnLimitQuotient(); quotient > 0 {
+		churn = max(churn, totalActiveBalance/math.Gwei(quotient))
+	}
+	churn -= churn % math.Gwei(sp.cs.EffectiveBalanceIncrement())
+	return min(churn, math.Gwei(sp.cs.MaxPerEpochActivationChurnLimit())), nil
+}
@@ -0,0 +1,175 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/chain-spec/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/config/pkg/spec"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	cryptomocks "github.com/berachain/beacon-kit/mod/primitives/pkg/crypto/mocks"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core/mocks"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProcessRegistryUpdates(t *testing.T) {
+	data := spec.BaseSpec()
+	// Allow two validators to activate per epoch.
+	data.MinPerEpochChurnLimit = 2 * data.MaxEffectiveBalance
+	data.MaxPerEpochActivationChurnLimit = 2 * data.MaxEffectiveBalance
+	cs := chain.NewChainSpec(data)
+	st := genesisState(t, cs, 1)
+	sp := createStateProcessor(
+		cs,
+		mocks.NewExecutionEngine[
+			*types.ExecutionPayload,
+			*types.ExecutionPayloadHeader,
+			engineprimitives.Withdrawals,
+		](t),
+		&cryptomocks.BLSSigner{},
+		dummyProposerAddressVerifier,
+	)
+
+	// Genesis validators are active from the genesis epoch.
+	genesisVal, err := st.ValidatorByIndex(0)
+	require.NoError(t, err)
+	require.True(t, genesisVal.IsActive(math.Epoch(constants.GenesisEpoch)))
+
+	maxBalance := math.Gwei(cs.MaxEffectiveBalance())
+	for i := range 3 {
+		require.NoError(t, st.AddValidator(types.NewValidatorFromDeposit(
+			[48]byte{0xff, byte(i)},
+			types.WithdrawalCredentials{},
+			maxBalance,
+			math.Gwei(cs.EffectiveBalanceIncrement()),
+			maxBalance,
+		)))
+		require.NoError(t, st.IncreaseBalance(
+			math.ValidatorIndex(i+1), maxBalance,
+		))
+	}
+
+	processEpoch := func(epoch uint64) transition.ValidatorUpdates {
+		slot := math.Slot((epoch+1)*cs.SlotsPerEpoch() - 1)
+		require.NoError(t, st.SetSlot(slot))
+		updates, err := sp.ProcessSlots(st, slot+1)
+		require.NoError(t, err)
+		return updates
+	}
+	requireActivation := func(expected ...math.Epoch) {
+		for i, epoch := range expected {
+			val, err := st.ValidatorByIndex(math.ValidatorIndex(i + 1))
+			require.NoError(t, err)
+			require.Equal(t, epoch, val.GetActivationEpoch())
+		}
+	}
+	farFuture := math.Epoch(constants.FarFutureEpoch)
+
+	// The new validators first become eligible for activation.
+	require.Empty(t, processEpoch(0))
+	requireActivation(farFuture, farFuture, farFuture)
+
+	// Then they are activated in index order within the churn limit.
+	updates := processEpoch(1)
+	requireActivation(2, 2, farFuture)
+	require.Len(t, updates, 2)
+
+	// The rest of the queue is activated in the next epoch.
+	updates = processEpoch(2)
+	requireActivation(2, 2, 3)
+	require.Len(t, updates, 1)
+	require.Equal(t, [48]byte{0xff, 2}, [48]byte(updates[0].Pubkey))
+	requireStateRoot(t, st)
+}
+
+func TestProcessRegistryUpdates_ActivationQueueEpoch(t *testing.T) {
+	data := spec.BaseSpec()
+	// Allow one validator to activate per epoch through the queue.
+	data.MinPerEpochChurnLimit = data.MaxEffectiveBalance
+	data.MaxPerEpochActivationChurnLimit = data.MaxEffectiveBalance
+	data.ActivationQueueEpoch = 2
+	cs := chain.NewChainSpec(data)
+	st := genesisState(t, cs, 1)
+	sp := createStateProcessor(
+		cs,
+		mocks.NewExecutionEngine[
+			*types.ExecutionPayload,
+			*types.ExecutionPayloadHeader,
+			engineprimitives.Withdrawals,
+		](t),
+		&cryptomocks.BLSSigner{},
+		dummyProposerAddressVerifier,
+	)
+
+	maxBalance := math.Gwei(cs.MaxEffectiveBalance())
+	addValidator := func(i int) {
+		require.NoError(t, st.AddValidator(types.NewValidatorFromDeposit(
+			[48]byte{0xff, byte(i)},
+			types.WithdrawalCredentials{},
+			maxBalance,
+			math.Gwei(cs.EffectiveBalanceIncrement()),
+			maxBalance,
+		)))
+		require.NoError(t, st.IncreaseBalance(
+			math.ValidatorIndex(i+1), maxBalance,
+		))
+	}
+	processEpoch := func(epoch uint64) {
+		slot := math.Slot((epoch+1)*cs.SlotsPerEpoch() - 1)
+		require.NoError(t, st.SetSlot(slot))
+		_, err := sp.ProcessSlots(st, slot+1)
+		require.NoError(t, err)
+	}
+	requireActivation := func(expected ...math.Epoch) {
+		for i, epoch := range expected {
+			val, err := st.ValidatorByIndex(math.ValidatorIndex(i + 1))
+			require.NoError(t, err)
+			require.Equal(t, epoch, val.GetActivationEpoch())
+		}
+	}
+	farFuture := math.Epoch(constants.FarFutureEpoch)
+
+	// Validators that join before the activation queue epoch are not
+	// queued.
+	for i := range 3 {
+		addValidator(i)
+	}
+	processEpoch(0)
+	requireActivation(farFuture, farFuture, farFuture)
+
+	// They are all activated at the activation queue epoch, regardless of
+	// the churn limit.
+	processEpoch(1)
+	requireActivation(2, 2, 2)
+
+	// Validators joining afterwards go through the queue.
+	addValidator(3)
+	processEpoch(2)
+	requireActivation(2, 2, 2, farFuture)
+	processEpoch(3)
+	requireActivation(2, 2, 2, 4)
+	requireStateRoot(t, st)
+}
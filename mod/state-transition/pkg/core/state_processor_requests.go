@@ -0,0 +1,444 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"slices"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+)
+
+// ValidateBLSToExecutionChange checks that a BLS to execution change can be
+// applied to the given state, as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/capella/beacon-chain.md#new-process_bls_to_execution_change
+//
+//nolint:lll
+func (sp *StateProcessor[
+	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) ValidateBLSToExecutionChange(
+	st BeaconStateT,
+	signedChange *types.SignedBLSToExecutionChange,
+) error {
+	if signedChange == nil || signedChange.Message == nil {
+		return ErrMalformedOperation
+	}
+	change := signedChange.Message
+	val, err := st.ValidatorByIndex(change.ValidatorIndex)
+	if err != nil {
+		return err
+	}
+
+	credentials := val.GetWithdrawalCredentials()
+	if credentials[0] != types.BLSCredentialPrefix {
+		return ErrNotBLSCredentials
+	}
+	if [32]byte(credentials) != [32]byte(
+		types.NewCredentialsFromBLSPubkey(change.FromBLSPubkey),
+	) {
+		return ErrBLSPubkeyMismatch
+	}
+
+	// The change is signed over the genesis fork version, so that it stays
+	// valid across forks.
+	genesisValidatorsRoot, err := st.GetGenesisValidatorsRoot()
+	if err != nil {
+		return err
+	}
+	return signedChange.VerifySignature(
+		types.NewForkData(
+			version.FromUint32[common.Version](
+				sp.cs.ActiveForkVersionForEpoch(
+					math.Epoch(constants.GenesisEpoch),
+				),
+			), genesisValidatorsRoot,
+		),
+		sp.cs.DomainTypeBLSToExecutionChange(),
+		sp.signer.VerifySignature,
+	)
+}
+
+// processBLSToExecutionChange as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/capella/beacon-chain.md#new-process_bls_to_execution_change
+//
+//nolint:lll
+func (sp *StateProcessor[
+	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _,
+	WithdrawalCredentialsT,
+]) processBLSToExecutionChange(
+	st BeaconStateT,
+	signedChange *types.SignedBLSToExecutionChange,
+) error {
+	if err := sp.ValidateBLSToExecutionChange(st, signedChange); err != nil {
+		return err
+	}
+
+	change := signedChange.Message
+	val, err := st.ValidatorByIndex(change.ValidatorIndex)
+	if err != nil {
+		return err
+	}
+	val.SetWithdrawalCredentials(WithdrawalCredentialsT(
+		types.NewCredentialsFromExecutionAddress(change.ToExecutionAddress),
+	))
+	return st.UpdateValidatorAtIndex(change.ValidatorIndex, val)
+}
+
+// processWithdrawalRequest as defined in the Ethereum 2.0 specification.
+// Requests are sent from the execution layer, so invalid requests are
+// ignored rather than invalidating the block. Partial withdrawals are
+// ignored too, since any balance above the maximum effective balance is
+// already withdrawn by the sweep.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/electra/beacon-chain.md#new-process_withdrawal_request
+//
+//nolint:lll
+func (sp *StateProcessor[
+	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) processWithdrawalRequest(
+	st BeaconStateT,
+	request *types.WithdrawalRequest,
+) error {
+	if request.Amount != types.FullExitRequestAmount {
+		return nil
+	}
+
+	idx, err := st.ValidatorIndexByPubkey(request.ValidatorPubkey)
+	if err != nil {
+		//nolint:nilerr // unknown validators are ignored.
+		return nil
+	}
+	val, err := st.ValidatorByIndex(idx)
+	if err != nil {
+		return err
+	}
+
+	epoch, err := sp.currentEpoch(st)
+	if err != nil {
+		return err
+	}
+	if !sp.isRequestFrom(val, request.SourceAddress) ||
+		!sp.isExitable(val, epoch) {
+		return nil
+	}
+	return sp.initiateValidatorExit(st, idx, val)
+}
+
+// processConsolidationRequest as defined in the Ethereum 2.0 specification.
+// As for withdrawal requests, invalid requests are ignored.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/electra/beacon-chain.md#new-process_consolidation_request
+//
+//nolint:lll
+func (sp *StateProcessor[
+	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) processConsolidationRequest(
+	st BeaconStateT,
+	request *types.ConsolidationRequest,
+) error {
+	sourceIdx, err := st.ValidatorIndexByPubkey(request.SourcePubkey)
+	if err != nil {
+		//nolint:nilerr // unknown validators are ignored.
+		return nil
+	}
+	source, err := st.ValidatorByIndex(sourceIdx)
+	if err != nil {
+		return err
+	}
+
+	epoch, err := sp.currentEpoch(st)
+	if err != nil {
+		return err
+	}
+	if !sp.isRequestFrom(source, request.SourceAddress) ||
+		!sp.isExitable(source, epoch) {
+		return nil
+	}
+
+	// A consolidation into itself switches the validator to compounding
+	// credentials.
+	if request.SourcePubkey == request.TargetPubkey {
+		if !source.HasEth1WithdrawalCredentials() {
+			return nil
+		}
+		credentials := source.GetWithdrawalCredentials()
+		credentials[0] = types.CompoundingCredentialPrefix
+		source.SetWithdrawalCredentials(credentials)
+		return st.UpdateValidatorAtIndex(sourceIdx, source)
+	}
+
+	targetIdx, err := st.ValidatorIndexByPubkey(request.TargetPubkey)
+	if err != nil {
+		//nolint:nilerr // unknown validators are ignored.
+		return nil
+	}
+	target, err := st.ValidatorByIndex(targetIdx)
+	if err != nil {
+		return err
+	}
+	if !target.HasCompoundingWithdrawalCredential() ||
+		!sp.isExitable(target, epoch) {
+		return nil
+	}
+
+	consolidations, err := st.GetPendingConsolidations()
+	if err != nil {
+		return err
+	}
+	if _, ok := consolidations[sourceIdx]; ok {
+		return nil
+	}
+	if err = sp.initiateValidatorExit(st, sourceIdx, source); err != nil {
+		return err
+	}
+	consolidations[sourceIdx] = targetIdx
+	return st.SetPendingConsolidations(consolidations)
+}
+
+// processPendingConsolidations as defined in the Ethereum 2.0 specification,
+// moving the balance of each consolidated validator to its target once it
+// can be withdrawn.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/electra/beacon-chain.md#new-process_pending_consolidations
+//
+//nolint:lll
+func (sp *StateProcessor[
+	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) processPendingConsolidations(
+	st BeaconStateT,
+) error {
+	consolidations, err := st.GetPendingConsolidations()
+	if err != nil {
+		return err
+	}
+	if len(consolidations) == 0 {
+		return nil
+	}
+
+	epoch, err := sp.currentEpoch(st)
+	if err != nil {
+		return err
+	}
+
+	// Consolidations are applied in source index order, so that the result
+	// does not depend on map iteration.
+	sources := make([]math.ValidatorIndex, 0, len(consolidations))
+	for source := range consolidations {
+		sources = append(sources, source)
+	}
+	slices.Sort(sources)
+
+	for _, sourceIdx := range sources {
+		source, err := st.ValidatorByIndex(sourceIdx)
+		if err != nil {
+			return err
+		}
+		if source.IsSlashed() {
+			delete(consolidations, sourceIdx)
+			continue
+		}
+		if source.GetWithdrawableEpoch() > epoch+1 {
+			continue
+		}
+
+		balance, err := st.GetBalance(sourceIdx)
+		if err != nil {
+			return err
+		}
+		amount := min(balance, source.GetEffectiveBalance())
+		if err = st.DecreaseBalance(sourceIdx, amount); err != nil {
+			return err
+		}
+		if err = st.IncreaseBalance(
+			consolidations[sourceIdx], amount,
+		); err != nil {
+			return err
+		}
+		delete(consolidations, sourceIdx)
+	}
+	return st.SetPendingConsolidations(consolidations)
+}
+
+// initiateValidatorExit as defined in the Ethereum 2.0 specification. Exits
+// share the churn of activations, and their queue is derived from the exit
+// epochs already assigned rather than tracked in the state.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/electra/beacon-chain.md#modified-initiate_validator_exit
+//
+//nolint:lll
+func (sp *StateProcessor[
+	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, ValidatorT, _, _, _, _,
+]) initiateValidatorExit(
+	st BeaconStateT,
+	idx math.ValidatorIndex,
+	val ValidatorT,
+) error {
+	if val.GetExitEpoch() != math.Epoch(constants.FarFutureEpoch) {
+		return nil
+	}
+
+	epoch, err := sp.currentEpoch(st)
+	if err != nil {
+		return err
+	}
+	validators, err := st.GetValidators()
+	if err != nil {
+		return err
+	}
+
+	// Find the latest epoch with queued exits and the effective balance
+	// already exiting in it.
+	exitEpoch := epoch + 1
+	var exiting math.Gwei
+	for _, other := range validators {
+		otherExit := other.GetExitEpoch()
+		switch {
+		case otherExit == math.Epoch(constants.FarFutureEpoch):
+		case otherExit > exitEpoch:
+			exitEpoch, exiting = otherExit, other.GetEffectiveBalance()
+		case otherExit == exitEpoch:
+			exiting += other.GetEffectiveBalance()
+		}
+	}
+
+	churn, err := sp.getActivationChurnLimit(st)
+	if err != nil {
+		return err
+	}
+	if exiting > 0 && exiting+val.GetEffectiveBalance() > churn {
+		exitEpoch++
+	}
+
+	val.SetExitEpoch(exitEpoch)
+	val.SetWithdrawableEpoch(
+		exitEpoch + math.Epoch(sp.cs.MinValidatorWithdrawabilityDelay()),
+	)
+	return st.UpdateValidatorAtIndex(idx, val)
+}
+
+// isRequestFrom returns true if the validator withdraws to the address that
+// sent an execution layer request.
+func (sp *StateProcessor[
+	_, _, _, _, _, _, _, _, _, _, _, _, ValidatorT, _, _, _, _,
+]) isRequestFrom(
+	val ValidatorT,
+	address common.ExecutionAddress,
+) bool {
+	credentials := val.GetWithdrawalCredentials()
+	return val.HasExecutionWithdrawalCredential() &&
+		common.ExecutionAddress(credentials[12:]) == address
+}
+
+// isExitable returns true if the validator is active and has not initiated
+// its exit yet.
+func (sp *StateProcessor[
+	_, _, _, _, _, _, _, _, _, _, _, _, ValidatorT, _, _, _, _,
+]) isExitable(
+	val ValidatorT,
+	epoch math.Epoch,
+) bool {
+	return val.IsActive(epoch) &&
+		val.GetExitEpoch() == math.Epoch(constants.FarFutureEpoch)
+}
+
+// currentEpoch returns the epoch of the state.
+func (sp *StateProcessor[
+	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) currentEpoch(
+	st BeaconStateT,
+) (math.Epoch, error) {
+	slot, err := st.GetSlot()
+	if err != nil {
+		return 0, err
+	}
+	return sp.cs.SlotToEpoch(slot), nil
+}
+
+// processElectraOperations processes the operations added to the block body
+// in Electra.
+func (sp *StateProcessor[
+	_, BeaconBlockBodyT, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) processElectraOperations(
+	st BeaconStateT,
+	body BeaconBlockBodyT,
+) error {
+	var (
+		slashings = body.GetProposerSlashings()
+		exits     = body.GetVoluntaryExits()
+		changes   = body.GetBlsToExecutionChanges()
+	)
+	switch {
+	case uint64(len(slashings)) > sp.cs.MaxProposerSlashingsPerBlock():
+		return errors.Wrapf(
+			ErrExceedsBlockOperationLimit, "proposer slashings: %d",
+			len(slashings),
+		)
+	case uint64(len(exits)) > sp.cs.MaxVoluntaryExitsPerBlock():
+		return errors.Wrapf(
+			ErrExceedsBlockOperationLimit, "voluntary exits: %d", len(exits),
+		)
+	case uint64(len(changes)) > sp.cs.MaxBlsToExecutionChangesPerBlock():
+		return errors.Wrapf(
+			ErrExceedsBlockOperationLimit, "bls to execution changes: %d",
+			len(changes),
+		)
+	}
+
+	for i, slashing := range slashings {
+		if err := sp.processProposerSlashing(st, slashing); err != nil {
+			return errors.Wrapf(err, "proposer slashing %d", i)
+		}
+	}
+	for i, exit := range exits {
+		if err := sp.processVoluntaryExit(st, exit); err != nil {
+			return errors.Wrapf(err, "voluntary exit %d", i)
+		}
+	}
+	for i, change := range changes {
+		if err := sp.processBLSToExecutionChange(st, change); err != nil {
+			return errors.Wrapf(err, "bls to execution change %d", i)
+		}
+	}
+
+	return sp.processExecutionRequests(st, body.GetExecutionRequests())
+}
+
+// processExecutionRequests processes the requests triggered from the
+// execution layer. The requests are verified against the execution payload
+// when the payload is sent to the execution client.
+func (sp *StateProcessor[
+	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) processExecutionRequests(
+	st BeaconStateT,
+	requests *types.ExecutionRequests,
+) error {
+	for _, request := range requests.GetWithdrawals() {
+		if err := sp.processWithdrawalRequest(st, request); err != nil {
+			return err
+		}
+	}
+	for _, request := range requests.GetConsolidations() {
+		if err := sp.processConsolidationRequest(st, request); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,235 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/chain-spec/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/config/pkg/spec"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	cryptomocks "github.com/berachain/beacon-kit/mod/primitives/pkg/crypto/mocks"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core"
+	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// electraChainSpec returns a devnet chain spec with Electra active from
+// genesis.
+func electraChainSpec(withdrawabilityDelay uint64) common.ChainSpec {
+	data := spec.BaseSpec()
+	data.DepositEth1ChainID = spec.DevnetEth1ChainID
+	data.DenebPlusForkEpoch = 0
+	data.ElectraForkEpoch = 0
+	data.MinValidatorWithdrawabilityDelay = withdrawabilityDelay
+	// Let the genesis validators exit right away.
+	data.ShardCommitteePeriod = 0
+	return chain.NewChainSpec(data)
+}
+
+func TestTransitionElectraOperations(t *testing.T) {
+	cs := electraChainSpec(1)
+	st := genesisState(t, cs, 4)
+	signer := &cryptomocks.BLSSigner{}
+	signer.On(
+		"VerifySignature",
+		mock.Anything, mock.Anything, mock.Anything,
+	).Return(nil)
+	sp := createStateProcessor(
+		cs,
+		mocks.NewExecutionEngine[
+			*types.ExecutionPayload,
+			*types.ExecutionPayloadHeader,
+			engineprimitives.Withdrawals,
+		](t),
+		signer,
+		dummyProposerAddressVerifier,
+	)
+
+	pubkeys := make([]crypto.BLSPubkey, 4)
+	setCredentials := func(
+		idx math.ValidatorIndex, credentials types.WithdrawalCredentials,
+	) {
+		val, err := st.ValidatorByIndex(idx)
+		require.NoError(t, err)
+		val.SetWithdrawalCredentials(credentials)
+		require.NoError(t, st.UpdateValidatorAtIndex(idx, val))
+		pubkeys[idx] = val.Pubkey
+	}
+	compounding := types.NewCredentialsFromExecutionAddress(
+		common.ExecutionAddress{0x02},
+	)
+	compounding[0] = types.CompoundingCredentialPrefix
+	setCredentials(0, types.NewCredentialsFromBLSPubkey([48]byte{0}))
+	setCredentials(1, types.NewCredentialsFromExecutionAddress(
+		common.ExecutionAddress{0x01},
+	))
+	setCredentials(2, compounding)
+	setCredentials(3, types.NewCredentialsFromExecutionAddress(
+		common.ExecutionAddress{0x03},
+	))
+
+	body := new(types.BeaconBlockBody).Empty(version.Electra)
+	body.ExecutionPayload = &types.ExecutionPayload{
+		Timestamp:     10,
+		ExtraData:     []byte("testing"),
+		Transactions:  [][]byte{},
+		Withdrawals:   []*engineprimitives.Withdrawal{},
+		BaseFeePerGas: math.NewU256(0),
+	}
+	body.SetBlsToExecutionChanges([]*types.SignedBLSToExecutionChange{{
+		Message: &types.BLSToExecutionChange{
+			ValidatorIndex:     0,
+			FromBLSPubkey:      pubkeys[0],
+			ToExecutionAddress: common.ExecutionAddress{0x0a},
+		},
+	}})
+	requests := &types.ExecutionRequests{
+		Withdrawals: []*types.WithdrawalRequest{
+			{
+				SourceAddress:   common.ExecutionAddress{0x01},
+				ValidatorPubkey: pubkeys[1],
+				Amount:          types.FullExitRequestAmount,
+			},
+			// Requests from an address other than the withdrawal address of
+			// the validator are ignored.
+			{
+				SourceAddress:   common.ExecutionAddress{0x09},
+				ValidatorPubkey: pubkeys[2],
+				Amount:          types.FullExitRequestAmount,
+			},
+		},
+		Consolidations: []*types.ConsolidationRequest{{
+			SourceAddress: common.ExecutionAddress{0x03},
+			SourcePubkey:  pubkeys[3],
+			TargetPubkey:  pubkeys[2],
+		}},
+	}
+
+	ctx := &transition.Context{
+		SkipPayloadVerification: true,
+		SkipValidateResult:      true,
+	}
+	_, err := sp.Transition(ctx, st, buildNextBlock(t, st, body))
+	require.NoError(t, err)
+
+	// The execution requests are processed with the next block.
+	body.SetBlsToExecutionChanges(nil)
+	body.SetExecutionRequests(requests)
+	_, err = sp.Transition(ctx, st, buildNextBlock(t, st, body))
+	require.NoError(t, err)
+
+	// The BLS credentials are switched to the execution address.
+	val, err := st.ValidatorByIndex(0)
+	require.NoError(t, err)
+	require.True(t, val.HasEth1WithdrawalCredentials())
+	addr, err := val.GetWithdrawalCredentials().ToExecutionAddress()
+	require.NoError(t, err)
+	require.Equal(t, common.ExecutionAddress{0x0a}, addr)
+
+	// Both the exiting and the consolidated validators exit in the next
+	// epoch, while the target keeps validating.
+	farFuture := math.Epoch(constants.FarFutureEpoch)
+	for idx, exitEpoch := range []math.Epoch{farFuture, 1, farFuture, 1} {
+		val, err = st.ValidatorByIndex(math.ValidatorIndex(idx))
+		require.NoError(t, err)
+		require.Equal(t, exitEpoch, val.GetExitEpoch())
+	}
+	consolidations, err := st.GetPendingConsolidations()
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		map[math.ValidatorIndex]math.ValidatorIndex{3: 2},
+		consolidations,
+	)
+
+	processEpoch := func(epoch uint64) {
+		slot := math.Slot((epoch+1)*cs.SlotsPerEpoch() - 1)
+		require.NoError(t, st.SetSlot(slot))
+		_, err = sp.ProcessSlots(st, slot+1)
+		require.NoError(t, err)
+	}
+	requireBalances := func(source, target math.Gwei) {
+		balance, err := st.GetBalance(3)
+		require.NoError(t, err)
+		require.Equal(t, source, balance)
+		balance, err = st.GetBalance(2)
+		require.NoError(t, err)
+		require.Equal(t, target, balance)
+	}
+
+	// The balance is moved once the source becomes withdrawable.
+	maxBalance := math.Gwei(cs.MaxEffectiveBalance())
+	processEpoch(0)
+	requireBalances(maxBalance, maxBalance)
+	processEpoch(1)
+	requireBalances(0, 2*maxBalance)
+	consolidations, err = st.GetPendingConsolidations()
+	require.NoError(t, err)
+	require.Empty(t, consolidations)
+}
+
+func TestTransitionBLSToExecutionChangeMismatch(t *testing.T) {
+	cs := electraChainSpec(256)
+	st := genesisState(t, cs, 1)
+	signer := &cryptomocks.BLSSigner{}
+	signer.On(
+		"VerifySignature",
+		mock.Anything, mock.Anything, mock.Anything,
+	).Return(nil)
+	sp := createStateProcessor(
+		cs,
+		mocks.NewExecutionEngine[
+			*types.ExecutionPayload,
+			*types.ExecutionPayloadHeader,
+			engineprimitives.Withdrawals,
+		](t),
+		signer,
+		dummyProposerAddressVerifier,
+	)
+
+	// Genesis validators already withdraw to an execution address.
+	body := new(types.BeaconBlockBody).Empty(version.Electra)
+	body.ExecutionPayload.Withdrawals = []*engineprimitives.Withdrawal{}
+	body.ExecutionPayload.BaseFeePerGas = math.NewU256(0)
+	body.SetBlsToExecutionChanges([]*types.SignedBLSToExecutionChange{{
+		Message: &types.BLSToExecutionChange{
+			ValidatorIndex: 0,
+		},
+	}})
+	_, err := sp.Transition(
+		&transition.Context{
+			SkipPayloadVerification: true,
+			SkipValidateResult:      true,
+		},
+		st,
+		buildNextBlock(t, st, body),
+	)
+	require.ErrorIs(t, err, core.ErrNotBLSCredentials)
+}
@@ -0,0 +1,231 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package core_test
+
+import (
+	gomath "math"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/config/pkg/spec"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	cryptomocks "github.com/berachain/beacon-kit/mod/primitives/pkg/crypto/mocks"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core/mocks"
+	cmtcrypto "github.com/cometbft/cometbft/crypto"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransitionRecordsParticipation(t *testing.T) {
+	cs := spec.DevnetChainSpec()
+	st := genesisState(t, cs, 3)
+	dummyProposerAddr := []byte{0xff}
+	signer := &cryptomocks.BLSSigner{}
+	signer.On(
+		"VerifySignature",
+		mock.Anything, mock.Anything, mock.Anything,
+	).Return(nil)
+	sp := createStateProcessor(
+		cs,
+		mocks.NewExecutionEngine[
+			*types.ExecutionPayload,
+			*types.ExecutionPayloadHeader,
+			engineprimitives.Withdrawals,
+		](t),
+		signer,
+		func(bytes.B48) ([]byte, error) {
+			return dummyProposerAddr, nil
+		},
+	)
+
+	cometBFTAddress := func(idx math.ValidatorIndex) []byte {
+		val, err := st.ValidatorByIndex(idx)
+		require.NoError(t, err)
+		return cmtcrypto.AddressHash(val.Pubkey[:]).Bytes()
+	}
+	ctx := &transition.Context{
+		SkipPayloadVerification: true,
+		SkipValidateResult:      true,
+		ProposerAddress:         dummyProposerAddr,
+		LastCommitVotes: []transition.CommitVote{
+			{Address: cometBFTAddress(0), Signed: true},
+			{Address: cometBFTAddress(1), Signed: true},
+			{Address: cometBFTAddress(2), Signed: false},
+			// Votes of unknown validators are ignored.
+			{Address: []byte{0x01}, Signed: true},
+		},
+	}
+	blk := buildNextBlock(
+		t,
+		st,
+		&types.BeaconBlockBody{
+			ExecutionPayload: &types.ExecutionPayload{
+				Timestamp:     10,
+				ExtraData:     []byte("testing"),
+				Transactions:  [][]byte{},
+				Withdrawals:   []*engineprimitives.Withdrawal{},
+				BaseFeePerGas: math.NewU256(0),
+			},
+			Eth1Data: &types.Eth1Data{},
+			Deposits: []*types.Deposit{},
+		},
+	)
+
+	_, err := sp.Transition(ctx, st, blk)
+	require.NoError(t, err)
+
+	commits, err := st.GetEpochCommits()
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), commits)
+	for idx, expected := range []uint64{1, 1, 0} {
+		participation, err := st.GetEpochParticipation(
+			math.ValidatorIndex(idx),
+		)
+		require.NoError(t, err)
+		require.Equal(t, expected, participation)
+	}
+	proposals, err := st.GetEpochProposals(blk.GetProposerIndex())
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), proposals)
+
+	// Nothing is recorded for blocks that are not being finalized.
+	ctx.LastCommitVotes = nil
+	blk = buildNextBlock(t, st, blk.GetBody())
+	_, err = sp.Transition(ctx, st, blk)
+	require.NoError(t, err)
+	commits, err = st.GetEpochCommits()
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), commits)
+}
+
+func TestProcessRewardsAndPenalties(t *testing.T) {
+	const (
+		numValidators = 4
+		commits       = 8
+	)
+	cs := spec.DevnetChainSpec()
+	st := genesisState(t, cs, numValidators)
+	sp := createStateProcessor(
+		cs,
+		mocks.NewExecutionEngine[
+			*types.ExecutionPayload,
+			*types.ExecutionPayloadHeader,
+			engineprimitives.Withdrawals,
+		](t),
+		&cryptomocks.BLSSigner{},
+		dummyProposerAddressVerifier,
+	)
+
+	// Move to the last slot of the first epoch after genesis, since the
+	// genesis epoch is not rewarded.
+	slot := math.Slot(2*cs.SlotsPerEpoch() - 1)
+	require.NoError(t, st.SetSlot(slot))
+
+	// Validator 0 signs every commit and proposes two blocks, validator 1
+	// signs half of the commits, validator 2 signs none and validator 3 has
+	// been inactive long enough to be penalised.
+	minInactivity := cs.MinEpochsToInactivityPenalty()
+	require.NoError(t, st.SetEpochCommits(commits))
+	require.NoError(t, st.SetEpochParticipation(0, commits))
+	require.NoError(t, st.SetEpochParticipation(1, commits/2))
+	require.NoError(t, st.SetEpochProposals(0, 2))
+	require.NoError(t, st.SetInactivityScore(3, minInactivity))
+
+	effectiveBalance := math.Gwei(cs.MaxEffectiveBalance())
+	baseReward := effectiveBalance * math.Gwei(cs.BaseRewardFactor()) /
+		math.Gwei(gomath.Sqrt(float64(numValidators*effectiveBalance)))
+	actualBaseReward, err := st.BaseReward(0)
+	require.NoError(t, err)
+	require.Equal(t, baseReward, actualBaseReward)
+
+	expected := []*transition.Rewards{
+		{
+			Participation: baseReward,
+			Proposer: baseReward /
+				math.Gwei(cs.ProposerRewardQuotient()) * 2,
+		},
+		{
+			Participation:       baseReward / 2,
+			MissedParticipation: baseReward / 2,
+		},
+		{
+			MissedParticipation: baseReward,
+			InactivityScore:     1,
+		},
+		{
+			MissedParticipation: baseReward,
+			Inactivity: effectiveBalance *
+				math.Gwei(minInactivity+1) /
+				math.Gwei(cs.InactivityPenaltyQuotient()),
+			InactivityScore: minInactivity + 1,
+		},
+	}
+	for i, r := range expected {
+		r.ValidatorIndex = math.ValidatorIndex(i)
+		r.EffectiveBalance = effectiveBalance
+	}
+	rewards, err := st.EpochRewards()
+	require.NoError(t, err)
+	require.Equal(t, expected, rewards)
+
+	// Processing the epoch applies the rewards and resets the participation.
+	balances, err := st.GetBalances()
+	require.NoError(t, err)
+	_, err = sp.ProcessSlots(st, slot+1)
+	require.NoError(t, err)
+
+	for i, r := range expected {
+		idx := math.ValidatorIndex(i)
+		balance, err := st.GetBalance(idx)
+		require.NoError(t, err)
+		require.Equal(
+			t, math.Gwei(balances[i])+r.Reward()-r.Penalty(), balance,
+		)
+
+		score, err := st.GetInactivityScore(idx)
+		require.NoError(t, err)
+		require.Equal(t, r.InactivityScore, score)
+
+		participation, err := st.GetEpochParticipation(idx)
+		require.NoError(t, err)
+		require.Zero(t, participation)
+	}
+	commitsAfter, err := st.GetEpochCommits()
+	require.NoError(t, err)
+	require.Zero(t, commitsAfter)
+	requireStateRoot(t, st)
+}
+
+func TestEpochRewardsDisabled(t *testing.T) {
+	st := genesisState(t, spec.BetnetChainSpec(), 2)
+	require.NoError(t, st.SetEpochCommits(4))
+	require.NoError(t, st.SetEpochParticipation(0, 4))
+	require.NoError(t, st.SetEpochProposals(0, 4))
+
+	rewards, err := st.EpochRewards()
+	require.NoError(t, err)
+	for _, r := range rewards {
+		require.Zero(t, r.Reward())
+		require.Zero(t, r.Penalty())
+	}
+}
@@ -21,7 +21,10 @@
 package core
 
 import (
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 )
 
 // processSlashingsReset as defined in the Ethereum 2.0 specification.
@@ -43,17 +46,142 @@ func (sp *StateProcessor[
 	return st.UpdateSlashingAtIndex(index, 0)
 }
 
+// ValidateProposerSlashing checks that a proposer slashing can be applied to
+// the given state, as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#proposer-slashings
+//
+//nolint:lll
+func (sp *StateProcessor[
+	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) ValidateProposerSlashing(
+	st BeaconStateT,
+	slashing *types.ProposerSlashing,
+) error {
+	if slashing == nil ||
+		slashing.SignedHeader1 == nil || slashing.SignedHeader1.Header == nil ||
+		slashing.SignedHeader2 == nil || slashing.SignedHeader2.Header == nil {
+		return ErrMalformedOperation
+	}
+	header1 := slashing.SignedHeader1.Header
+	header2 := slashing.SignedHeader2.Header
+	if header1.GetSlot() != header2.GetSlot() ||
+		header1.GetProposerIndex() != header2.GetProposerIndex() {
+		return ErrSlashingHeadersMismatch
+	}
+	if header1.HashTreeRoot() == header2.HashTreeRoot() {
+		return ErrSlashingHeadersEqual
+	}
+
+	val, err := st.ValidatorByIndex(header1.GetProposerIndex())
+	if err != nil {
+		return err
+	}
+	epoch, err := sp.currentEpoch(st)
+	if err != nil {
+		return err
+	}
+	if !val.IsSlashable(epoch) {
+		return ErrValidatorNotSlashable
+	}
+
+	genesisValidatorsRoot, err := st.GetGenesisValidatorsRoot()
+	if err != nil {
+		return err
+	}
+	for _, signed := range []*types.SignedBeaconBlockHeader{
+		slashing.SignedHeader1, slashing.SignedHeader2,
+	} {
+		if err = signed.VerifySignature(
+			types.NewForkData(
+				version.FromUint32[common.Version](
+					sp.cs.ActiveForkVersionForSlot(signed.Header.GetSlot()),
+				), genesisValidatorsRoot,
+			),
+			sp.cs.DomainTypeProposer(),
+			val.GetPubkey(),
+			sp.signer.VerifySignature,
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // processProposerSlashing as defined in the Ethereum 2.0 specification.
 // https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#proposer-slashings
 //
-//nolint:lll,unused // will be used later
+//nolint:lll
 func (sp *StateProcessor[
 	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
 ]) processProposerSlashing(
-	_ BeaconStateT,
-	// ps ProposerSlashing,
+	st BeaconStateT,
+	slashing *types.ProposerSlashing,
 ) error {
-	return nil
+	if err := sp.ValidateProposerSlashing(st, slashing); err != nil {
+		return err
+	}
+	return sp.slashValidator(st, slashing.GetValidatorIndex())
+}
+
+// slashValidator as defined in the Ethereum 2.0 specification. No
+// whistleblower reward is paid, since the proposer of a block is not the one
+// who observed the slashable offense.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#slash_validator
+//
+//nolint:lll
+func (sp *StateProcessor[
+	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) slashValidator(
+	st BeaconStateT,
+	idx math.ValidatorIndex,
+) error {
+	val, err := st.ValidatorByIndex(idx)
+	if err != nil {
+		return err
+	}
+	if err = sp.initiateValidatorExit(st, idx, val); err != nil {
+		return err
+	}
+
+	epoch, err := sp.currentEpoch(st)
+	if err != nil {
+		return err
+	}
+	val.SetSlashed(true)
+	val.SetWithdrawableEpoch(max(
+		val.GetWithdrawableEpoch(),
+		epoch+math.Epoch(sp.cs.EpochsPerSlashingsVector()),
+	))
+	if err = st.UpdateValidatorAtIndex(idx, val); err != nil {
+		return err
+	}
+
+	// Record the slashed balance for the correlation penalty.
+	index := epoch.Unwrap() % sp.cs.EpochsPerSlashingsVector()
+	slashed, err := st.GetSlashingAtIndex(index)
+	if err != nil {
+		return err
+	}
+	if err = st.UpdateSlashingAtIndex(
+		index, slashed+val.GetEffectiveBalance(),
+	); err != nil {
+		return err
+	}
+	total, err := st.GetTotalSlashing()
+	if err != nil {
+		return err
+	}
+	if err = st.SetTotalSlashing(total + val.GetEffectiveBalance()); err != nil {
+		return err
+	}
+
+	quotient := sp.cs.MinSlashingPenaltyQuotient()
+	if quotient == 0 {
+		return nil
+	}
+	return st.DecreaseBalance(
+		idx, val.GetEffectiveBalance()/math.Gwei(quotient),
+	)
 }
 
 // processSlashings as defined in the Ethereum 2.0 specification.
@@ -60,7 +60,14 @@ func (sp *StateProcessor[
 	// if uint64(len(deposits)) != depositCount {
 	// 	return errors.New("deposit count mismatch")
 	// }
-	return sp.processDeposits(st, deposits)
+	if err = sp.processDeposits(st, deposits); err != nil {
+		return err
+	}
+
+	if sp.cs.ActiveForkVersionForSlot(blk.GetSlot()) < version.Electra {
+		return nil
+	}
+	return sp.processElectraOperations(st, blk.GetBody())
 }
 
 // processDeposits processes the deposits and ensures  they match the
@@ -234,7 +241,6 @@ func (sp *StateProcessor[
 ) error {
 	// Dequeue and verify the logs.
 	var (
-		nextValidatorIndex math.ValidatorIndex
 		payload            = body.GetExecutionPayload()
 		payloadWithdrawals = payload.GetWithdrawals()
 	)
@@ -244,10 +250,9 @@ func (sp *StateProcessor[
 	if err != nil {
 		return err
 	}
-	numWithdrawals := len(expectedWithdrawals)
 
 	// Ensure the withdrawals have the same length
-	if numWithdrawals != len(payloadWithdrawals) {
+	if len(expectedWithdrawals) != len(payloadWithdrawals) {
 		return errors.Wrapf(
 			ErrNumWithdrawalsMismatch,
 			"withdrawals do not match expected length %d, got %d",
@@ -255,9 +260,8 @@ func (sp *StateProcessor[
 		)
 	}
 
-	// Compare and process each withdrawal.
+	// Ensure the withdrawals match the local state.
 	for i, wd := range expectedWithdrawals {
-		// Ensure the withdrawals match the local state.
 		if !wd.Equals(payloadWithdrawals[i]) {
 			return errors.Wrapf(
 				ErrNumWithdrawalsMismatch,
@@ -265,48 +269,8 @@ func (sp *StateProcessor[
 				spew.Sdump(wd), spew.Sdump(payloadWithdrawals[i]),
 			)
 		}
-
-		// Then we process the withdrawal.
-		if err = st.DecreaseBalance(
-			wd.GetValidatorIndex(), wd.GetAmount(),
-		); err != nil {
-			return err
-		}
-	}
-
-	// Update the next withdrawal index if this block contained withdrawals
-	if numWithdrawals != 0 {
-		// Next sweep starts after the latest withdrawal's validator index
-		if err = st.SetNextWithdrawalIndex(
-			(expectedWithdrawals[numWithdrawals-1].GetIndex() + 1).Unwrap(),
-		); err != nil {
-			return err
-		}
-	}
-
-	totalValidators, err := st.GetTotalValidators()
-	if err != nil {
-		return err
-	}
-
-	// Update the next validator index to start the next withdrawal sweep
-	//#nosec:G701 // won't overflow in practice.
-	if numWithdrawals == int(sp.cs.MaxWithdrawalsPerPayload()) {
-		// Next sweep starts after the latest withdrawal's validator index
-		nextValidatorIndex =
-			(expectedWithdrawals[len(expectedWithdrawals)-1].GetIndex() + 1) %
-				math.U64(totalValidators)
-	} else {
-		// Advance sweep by the max length of the sweep if there was not
-		// a full set of withdrawals
-		nextValidatorIndex, err = st.GetNextWithdrawalValidatorIndex()
-		if err != nil {
-			return err
-		}
-		nextValidatorIndex += math.ValidatorIndex(
-			sp.cs.MaxValidatorsPerWithdrawalsSweep())
-		nextValidatorIndex %= math.ValidatorIndex(totalValidators)
 	}
 
-	return st.SetNextWithdrawalValidatorIndex(nextValidatorIndex)
+	// Then we process the withdrawals.
+	return ApplyWithdrawals(st, sp.cs, expectedWithdrawals)
 }
@@ -0,0 +1,119 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/config/pkg/spec"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	cryptomocks "github.com/berachain/beacon-kit/mod/primitives/pkg/crypto/mocks"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core/mocks"
+	"github.com/berachain/beacon-kit/mod/state-transition/pkg/trace"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransitionTracer(t *testing.T) {
+	cs := spec.DevnetChainSpec()
+	st := genesisState(t, cs, 3)
+	sp := createStateProcessor(
+		cs,
+		mocks.NewExecutionEngine[
+			*types.ExecutionPayload,
+			*types.ExecutionPayloadHeader,
+			engineprimitives.Withdrawals,
+		](t),
+		&cryptomocks.BLSSigner{},
+		dummyProposerAddressVerifier,
+	)
+	recorder := trace.NewRecorder[
+		*TestBeaconStateT, *TestBeaconStateMarshallableT,
+	]()
+	sp.SetTracer(recorder)
+
+	blk := buildNextBlock(
+		t,
+		st,
+		&types.BeaconBlockBody{
+			ExecutionPayload: &types.ExecutionPayload{
+				Timestamp:     10,
+				ExtraData:     []byte("testing"),
+				Transactions:  [][]byte{},
+				Withdrawals:   []*engineprimitives.Withdrawal{},
+				BaseFeePerGas: math.NewU256(0),
+			},
+			Eth1Data: &types.Eth1Data{},
+			Deposits: []*types.Deposit{},
+		},
+	)
+	_, err := sp.Transition(
+		&transition.Context{
+			SkipPayloadVerification: true,
+			SkipValidateResult:      true,
+			SkipValidateRandao:      true,
+		},
+		st, blk,
+	)
+	require.NoError(t, err)
+
+	steps := recorder.Steps()
+	names := make([]string, len(steps))
+	fields := make(map[string][]string, len(steps))
+	for i, s := range steps {
+		require.NoError(t, s.Err)
+		names[i] = s.Name
+		for _, diff := range s.Diffs {
+			fields[s.Name] = append(fields[s.Name], diff.Field)
+		}
+	}
+	require.Equal(t, []string{
+		"processSlot",
+		"processBlockHeader",
+		"processExecutionPayload",
+		"processWithdrawals",
+		"processRandaoReveal",
+		"processOperations",
+		"processParticipation",
+	}, names)
+	require.Contains(t, fields["processSlot"], "StateRoots")
+	require.Contains(t, fields["processSlot"], "BlockRoots")
+	require.Equal(t, "LatestBlockHeader", fields["processBlockHeader"][0])
+	// Fields kept out of the state root are traced too.
+	require.Contains(t, fields["processBlockHeader"], "ProposerSet.Proposer")
+	require.Contains(t, fields["processRandaoReveal"], "RandaoMixes")
+
+	// Transitions are not traced anymore once the tracer is unset.
+	sp.SetTracer(nil)
+	blk = buildNextBlock(t, st, blk.GetBody())
+	_, err = sp.Transition(
+		&transition.Context{
+			SkipPayloadVerification: true,
+			SkipValidateResult:      true,
+			SkipValidateRandao:      true,
+		},
+		st, blk,
+	)
+	require.NoError(t, err)
+	require.Len(t, recorder.Steps(), len(steps))
+}
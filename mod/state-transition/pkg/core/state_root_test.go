@@ -0,0 +1,209 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/config/pkg/spec"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	cryptomocks "github.com/berachain/beacon-kit/mod/primitives/pkg/crypto/mocks"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// genesisState returns a beacon state initialized from numValidators
+// genesis deposits.
+func genesisState(
+	tb testing.TB, cs common.ChainSpec, numValidators int,
+) *TestBeaconStateT {
+	tb.Helper()
+	signer := &cryptomocks.BLSSigner{}
+	signer.On(
+		"VerifySignature",
+		mock.Anything, mock.Anything, mock.Anything,
+	).Return(nil)
+	sp := createStateProcessor(
+		cs,
+		mocks.NewExecutionEngine[
+			*types.ExecutionPayload,
+			*types.ExecutionPayloadHeader,
+			engineprimitives.Withdrawals,
+		](tb),
+		signer,
+		dummyProposerAddressVerifier,
+	)
+
+	kvStore, err := initStore()
+	require.NoError(tb, err)
+	st := new(TestBeaconStateT).NewFromDB(kvStore, cs)
+
+	deposits := make([]*types.Deposit, numValidators)
+	for i := range deposits {
+		deposits[i] = &types.Deposit{
+			Pubkey: [48]byte{byte(i), byte(i >> 8), byte(i >> 16)},
+			Credentials: types.NewCredentialsFromExecutionAddress(
+				common.ExecutionAddress{},
+			),
+			Amount: math.Gwei(cs.MaxEffectiveBalance()),
+			Index:  uint64(i),
+		}
+	}
+	_, err = sp.InitializePreminedBeaconStateFromEth1(
+		st,
+		deposits,
+		new(types.ExecutionPayloadHeader).Empty(),
+		version.FromUint32[common.Version](version.Deneb),
+	)
+	require.NoError(tb, err)
+	return st
+}
+
+// requireStateRoot checks the cached state root against the root of the
+// fully materialized state.
+func requireStateRoot(t *testing.T, st *TestBeaconStateT) {
+	t.Helper()
+	marshallable, err := st.GetMarshallable()
+	require.NoError(t, err)
+	require.Equal(t, marshallable.HashTreeRoot(), st.HashTreeRoot())
+}
+
+func TestStateDB_HashTreeRoot(t *testing.T) {
+	st := genesisState(t, spec.BetnetChainSpec(), 5)
+	requireStateRoot(t, st)
+
+	// Update fields and list leaves.
+	require.NoError(t, st.SetSlot(7))
+	require.NoError(t, st.SetBalance(3, 12345))
+	require.NoError(t, st.UpdateBlockRootAtIndex(2, common.Root{0x01}))
+	require.NoError(t, st.UpdateStateRootAtIndex(5, common.Root{0x02}))
+	require.NoError(t, st.UpdateRandaoMixAtIndex(9, common.Bytes32{0x03}))
+	require.NoError(t, st.SetSlashingAtIndex(4, 1000))
+	val, err := st.ValidatorByIndex(1)
+	require.NoError(t, err)
+	val.SetEffectiveBalance(math.Gwei(1e9))
+	require.NoError(t, st.UpdateValidatorAtIndex(1, val))
+	requireStateRoot(t, st)
+
+	// Grow the validators and balances lists.
+	for i := range 6 {
+		require.NoError(t, st.AddValidator(&types.Validator{
+			Pubkey: [48]byte{0xff, byte(i)},
+		}))
+	}
+	require.NoError(t, st.SetBalance(9, 67890))
+	requireStateRoot(t, st)
+
+	// A copy carries the cached roots over, and updates to it do not leak
+	// into the original.
+	root := st.HashTreeRoot()
+	cpy := st.Copy()
+	requireStateRoot(t, cpy)
+	require.NoError(t, cpy.SetBalance(0, 1))
+	require.NoError(t, cpy.SetSlashingAtIndex(6, 2000))
+	requireStateRoot(t, cpy)
+	require.NotEqual(t, root, cpy.HashTreeRoot())
+	require.Equal(t, root, st.HashTreeRoot())
+	requireStateRoot(t, st)
+}
+
+// stateFromContext returns a new state over the context of the given one,
+// as the storage backend does for each block.
+func stateFromContext(
+	cs common.ChainSpec, st *TestBeaconStateT,
+) *TestBeaconStateT {
+	return new(TestBeaconStateT).NewFromDB(
+		st.KVStore.WithContext(st.Context()), cs,
+	)
+}
+
+func TestStateDB_HashTreeRootNewContext(t *testing.T) {
+	cs := spec.BetnetChainSpec()
+	st := genesisState(t, cs, 5)
+	root := st.HashTreeRoot()
+
+	// A state over the same context reuses the cached roots.
+	other := stateFromContext(cs, st)
+	require.Equal(t, root, other.HashTreeRoot())
+	requireStateRoot(t, other)
+
+	// Updates through a copy leave the cached roots in place.
+	cpy := other.Copy()
+	require.NoError(t, cpy.SetBalance(2, 1))
+	requireStateRoot(t, cpy)
+	requireStateRoot(t, stateFromContext(cs, st))
+
+	// Updates to the state itself drop them, without the slot or the
+	// latest block header changing.
+	require.NoError(t, other.SetBalance(2, 1))
+	requireStateRoot(t, stateFromContext(cs, st))
+	require.NoError(t, other.SetSlashingAtIndex(3, 1000))
+	requireStateRoot(t, other)
+	require.NoError(t, st.AddValidator(&types.Validator{
+		Pubkey: [48]byte{0xff},
+	}))
+	requireStateRoot(t, stateFromContext(cs, st))
+}
+
+func BenchmarkStateDB_HashTreeRoot(b *testing.B) {
+	const numValidators = 4096
+	b.Run("materialized", func(b *testing.B) {
+		st := genesisState(b, spec.BetnetChainSpec(), numValidators)
+		b.ResetTimer()
+		for i := range b.N {
+			require.NoError(b, st.SetBalance(
+				math.ValidatorIndex(i%numValidators), math.Gwei(i),
+			))
+			marshallable, err := st.GetMarshallable()
+			require.NoError(b, err)
+			_ = marshallable.HashTreeRoot()
+		}
+	})
+	b.Run("cached", func(b *testing.B) {
+		st := genesisState(b, spec.BetnetChainSpec(), numValidators)
+		_ = st.HashTreeRoot()
+		b.ResetTimer()
+		for i := range b.N {
+			require.NoError(b, st.SetBalance(
+				math.ValidatorIndex(i%numValidators), math.Gwei(i),
+			))
+			_ = st.HashTreeRoot()
+		}
+	})
+	b.Run("new context", func(b *testing.B) {
+		cs := spec.BetnetChainSpec()
+		st := genesisState(b, cs, numValidators)
+		_ = st.HashTreeRoot()
+		b.ResetTimer()
+		for i := range b.N {
+			st = stateFromContext(cs, st)
+			require.NoError(b, st.SetBalance(
+				math.ValidatorIndex(i%numValidators), math.Gwei(i),
+			))
+			_ = st.HashTreeRoot()
+		}
+	})
+}
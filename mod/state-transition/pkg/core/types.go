@@ -24,6 +24,7 @@ import (
 	stdbytes "bytes"
 	"context"
 
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
@@ -31,6 +32,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
 	"github.com/karalabe/ssz"
 )
 
@@ -82,6 +84,15 @@ type BeaconBlockBody[
 	HashTreeRoot() common.Root
 	// GetBlobKzgCommitments returns the KZG commitments for the blobs.
 	GetBlobKzgCommitments() eip4844.KZGCommitments[common.ExecutionHash]
+	// GetProposerSlashings returns the list of proposer slashings.
+	GetProposerSlashings() []*types.ProposerSlashing
+	// GetVoluntaryExits returns the list of voluntary exits.
+	GetVoluntaryExits() []*types.SignedVoluntaryExit
+	// GetBlsToExecutionChanges returns the list of BLS to execution changes.
+	GetBlsToExecutionChanges() []*types.SignedBLSToExecutionChange
+	// GetExecutionRequests returns the requests triggered from the execution
+	// layer.
+	GetExecutionRequests() *types.ExecutionRequests
 }
 
 // BeaconBlockHeader is the interface for a beacon block header.
@@ -125,6 +136,12 @@ type Context interface {
 	// GetConsensusTime returns the timestamp of current consensus request.
 	// It is used to build next payload and to validate currentpayload.
 	GetConsensusTime() math.U64
+	// GetLastCommitVotes returns the votes of the validators in the commit
+	// of the previous block. It is empty unless the block is being
+	// finalized.
+	GetLastCommitVotes() []transition.CommitVote
+	// GetFinalizing returns whether the block is being finalized.
+	GetFinalizing() bool
 }
 
 // Deposit is the interface for a deposit.
@@ -199,6 +216,16 @@ type ExecutionEngine[
 	) error
 }
 
+// Tracer observes the sub-steps of a state transition, e.g. to record the
+// state mutations of each of them when debugging a state root mismatch.
+type Tracer[BeaconStateT any] interface {
+	// StartStep is called before the named sub-step is run on the state.
+	StartStep(step string, st BeaconStateT)
+	// EndStep is called after the named sub-step has run on the state, with
+	// the error it returned.
+	EndStep(step string, st BeaconStateT, err error)
+}
+
 // ForkData is the interface for the fork data.
 type ForkData[ForkDataT any] interface {
 	// New creates a new fork data object.
@@ -210,6 +237,13 @@ type ForkData[ForkDataT any] interface {
 	) common.Root
 }
 
+// TelemetrySink is an interface for sending metrics to a telemetry backend.
+type TelemetrySink interface {
+	// IncrementCounter increments the counter identified by
+	// the provided key.
+	IncrementCounter(key string, args ...string)
+}
+
 // Validator represents an interface for a validator with generic type
 // ValidatorT.
 type Validator[
@@ -228,6 +262,11 @@ type Validator[
 	) ValidatorT
 	// IsSlashed returns true if the validator is slashed.
 	IsSlashed() bool
+	// SetSlashed sets whether the validator is slashed.
+	SetSlashed(bool)
+	// IsSlashable returns true if the validator can be slashed in the given
+	// epoch.
+	IsSlashable(math.Epoch) bool
 	// GetPubkey returns the public key of the validator.
 	GetPubkey() crypto.BLSPubkey
 	// GetEffectiveBalance returns the effective balance of the validator in
@@ -235,8 +274,44 @@ type Validator[
 	GetEffectiveBalance() math.Gwei
 	// SetEffectiveBalance sets the effective balance of the validator in Gwei.
 	SetEffectiveBalance(math.Gwei)
+	// GetActivationEligibilityEpoch returns the epoch in which the validator
+	// became eligible for activation.
+	GetActivationEligibilityEpoch() math.Epoch
+	// SetActivationEligibilityEpoch sets the epoch in which the validator
+	// became eligible for activation.
+	SetActivationEligibilityEpoch(math.Epoch)
+	// GetActivationEpoch returns the epoch in which the validator activates.
+	GetActivationEpoch() math.Epoch
+	// SetActivationEpoch sets the epoch in which the validator activates.
+	SetActivationEpoch(math.Epoch)
+	// IsActive returns true if the validator is active in the given epoch.
+	IsActive(math.Epoch) bool
+	// IsEligibleForActivation returns true if the validator may be activated
+	// once the given epoch is finalized.
+	IsEligibleForActivation(math.Epoch) bool
+	// GetExitEpoch returns the epoch in which the validator exits.
+	GetExitEpoch() math.Epoch
+	// SetExitEpoch sets the epoch in which the validator exits.
+	SetExitEpoch(math.Epoch)
 	// GetWithdrawableEpoch returns the epoch when the validator can withdraw.
 	GetWithdrawableEpoch() math.Epoch
+	// SetWithdrawableEpoch sets the epoch when the validator can withdraw.
+	SetWithdrawableEpoch(math.Epoch)
+	// GetWithdrawalCredentials returns the withdrawal credentials of the
+	// validator.
+	GetWithdrawalCredentials() WithdrawalCredentialsT
+	// SetWithdrawalCredentials sets the withdrawal credentials of the
+	// validator.
+	SetWithdrawalCredentials(WithdrawalCredentialsT)
+	// HasEth1WithdrawalCredentials returns true if the validator has eth1
+	// withdrawal credentials.
+	HasEth1WithdrawalCredentials() bool
+	// HasCompoundingWithdrawalCredential returns true if the validator has
+	// compounding withdrawal credentials.
+	HasCompoundingWithdrawalCredential() bool
+	// HasExecutionWithdrawalCredential returns true if the validator
+	// withdraws to an execution address.
+	HasExecutionWithdrawalCredential() bool
 }
 
 type Validators interface {
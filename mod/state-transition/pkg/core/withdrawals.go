@@ -0,0 +1,104 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// WithdrawalsState is the part of the beacon state the withdrawals of a
+// payload are applied to.
+type WithdrawalsState interface {
+	// DecreaseBalance decreases the balance of a validator.
+	DecreaseBalance(math.ValidatorIndex, math.Gwei) error
+	// GetTotalValidators returns the number of validators.
+	GetTotalValidators() (uint64, error)
+	// GetNextWithdrawalValidatorIndex returns the index of the validator the
+	// next withdrawal sweep starts at.
+	GetNextWithdrawalValidatorIndex() (math.ValidatorIndex, error)
+	// SetNextWithdrawalIndex sets the index of the next withdrawal.
+	SetNextWithdrawalIndex(uint64) error
+	// SetNextWithdrawalValidatorIndex sets the index of the validator the
+	// next withdrawal sweep starts at.
+	SetNextWithdrawalValidatorIndex(math.ValidatorIndex) error
+}
+
+// ApplyWithdrawals debits the withdrawals of a payload, which must be the
+// expected withdrawals of the state, from the balances of their validators
+// and advances the withdrawal sweep past them. It is the second half of
+// processWithdrawals, exported so that the sweep can be simulated over the
+// following payloads.
+func ApplyWithdrawals[WithdrawalT interface {
+	GetAmount() math.Gwei
+	GetIndex() math.U64
+	GetValidatorIndex() math.ValidatorIndex
+}](
+	st WithdrawalsState,
+	cs common.ChainSpec,
+	withdrawals []WithdrawalT,
+) error {
+	var nextValidatorIndex math.ValidatorIndex
+	for _, wd := range withdrawals {
+		if err := st.DecreaseBalance(
+			wd.GetValidatorIndex(), wd.GetAmount(),
+		); err != nil {
+			return err
+		}
+	}
+
+	// Update the next withdrawal index if this block contained withdrawals
+	numWithdrawals := len(withdrawals)
+	if numWithdrawals != 0 {
+		// Next sweep starts after the latest withdrawal's validator index
+		if err := st.SetNextWithdrawalIndex(
+			(withdrawals[numWithdrawals-1].GetIndex() + 1).Unwrap(),
+		); err != nil {
+			return err
+		}
+	}
+
+	totalValidators, err := st.GetTotalValidators()
+	if err != nil {
+		return err
+	}
+
+	// Update the next validator index to start the next withdrawal sweep
+	//#nosec:G701 // won't overflow in practice.
+	if numWithdrawals == int(cs.MaxWithdrawalsPerPayload()) {
+		// Next sweep starts after the latest withdrawal's validator index
+		nextValidatorIndex =
+			(withdrawals[numWithdrawals-1].GetIndex() + 1) %
+				math.U64(totalValidators)
+	} else {
+		// Advance sweep by the max length of the sweep if there was not
+		// a full set of withdrawals
+		nextValidatorIndex, err = st.GetNextWithdrawalValidatorIndex()
+		if err != nil {
+			return err
+		}
+		nextValidatorIndex += math.ValidatorIndex(
+			cs.MaxValidatorsPerWithdrawalsSweep())
+		nextValidatorIndex %= math.ValidatorIndex(totalValidators)
+	}
+
+	return st.SetNextWithdrawalValidatorIndex(nextValidatorIndex)
+}
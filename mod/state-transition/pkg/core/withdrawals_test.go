@@ -0,0 +1,110 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/config/pkg/spec"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	cryptomocks "github.com/berachain/beacon-kit/mod/primitives/pkg/crypto/mocks"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core"
+	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyWithdrawals(t *testing.T) {
+	cs := spec.BetnetChainSpec()
+	mocksSigner := &cryptomocks.BLSSigner{}
+	mocksSigner.On(
+		"VerifySignature",
+		mock.Anything, mock.Anything, mock.Anything,
+	).Return(nil)
+	sp := createStateProcessor(
+		cs,
+		mocks.NewExecutionEngine[
+			*types.ExecutionPayload,
+			*types.ExecutionPayloadHeader,
+			engineprimitives.Withdrawals,
+		](t),
+		mocksSigner,
+		func(bytes.B48) ([]byte, error) {
+			return []byte{0xff}, nil
+		},
+	)
+	kvStore, err := initStore()
+	require.NoError(t, err)
+	st := new(TestBeaconStateT).NewFromDB(kvStore, cs)
+
+	var (
+		maxBalance  = math.Gwei(cs.MaxEffectiveBalance())
+		increment   = math.Gwei(cs.EffectiveBalanceIncrement())
+		credentials = types.NewCredentialsFromExecutionAddress(
+			common.ExecutionAddress{0x01},
+		)
+	)
+	_, err = sp.InitializePreminedBeaconStateFromEth1(
+		st,
+		[]*types.Deposit{
+			{
+				Pubkey:      [48]byte{0x01},
+				Credentials: credentials,
+				Amount:      maxBalance + increment,
+				Index:       0,
+			},
+			{
+				Pubkey:      [48]byte{0x02},
+				Credentials: credentials,
+				Amount:      maxBalance,
+				Index:       1,
+			},
+		},
+		new(types.ExecutionPayloadHeader).Empty(),
+		version.FromUint32[common.Version](version.Deneb),
+	)
+	require.NoError(t, err)
+
+	// Only the excess balance of the first validator is withdrawn.
+	withdrawals, err := st.ExpectedWithdrawals()
+	require.NoError(t, err)
+	require.Len(t, withdrawals, 1)
+	require.Equal(t, math.ValidatorIndex(0), withdrawals[0].GetValidatorIndex())
+	require.Equal(t, increment, withdrawals[0].GetAmount())
+
+	require.NoError(t, core.ApplyWithdrawals(st, cs, withdrawals))
+
+	balance, err := st.GetBalance(0)
+	require.NoError(t, err)
+	require.Equal(t, maxBalance, balance)
+	nextIndex, err := st.GetNextWithdrawalIndex()
+	require.NoError(t, err)
+	require.Equal(t, withdrawals[0].GetIndex().Unwrap()+1, nextIndex)
+
+	withdrawals, err = st.ExpectedWithdrawals()
+	require.NoError(t, err)
+	require.Empty(t, withdrawals)
+}
@@ -0,0 +1,82 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package spectest
+
+// Divergences are the parts of the spec BeaconKit intentionally diverges
+// from, by runner/handler key, with the reason. CometBFT provides
+// single-slot finality and the validator set votes, so everything built on
+// attestations, sync committees and eth1 voting has no counterpart.
+//
+//nolint:gochecknoglobals // read-only allowlist.
+var Divergences = map[string]string{
+	// Operations
+	"operations/attestation":       "no attestations",
+	"operations/attester_slashing": "no attestations",
+	"operations/block_header": "proposer checked against the CometBFT " +
+		"proposer address",
+	"operations/deposit": "deposits dequeued from the execution layer " +
+		"without Merkle proofs",
+	"operations/execution_payload": "payloads verified against the " +
+		"consensus time by the execution client",
+	"operations/sync_aggregate": "no sync committees",
+	"operations/voluntary_exit": "exits queued by effective balance churn, " +
+		"without a minimum activity period",
+
+	// Epoch processing
+	"epoch_processing/eth1_data_reset": "no eth1 data voting",
+	"epoch_processing/historical_roots_update": "no historical " +
+		"accumulator",
+	"epoch_processing/historical_summaries_update": "no historical " +
+		"accumulator",
+	"epoch_processing/inactivity_updates": "inactivity tracked from " +
+		"CometBFT votes",
+	"epoch_processing/justification_and_finalization": "single-slot " +
+		"finality",
+	"epoch_processing/participation_flag_updates": "participation " +
+		"tracked from CometBFT votes",
+	"epoch_processing/participation_record_updates": "participation " +
+		"tracked from CometBFT votes",
+	"epoch_processing/registry_updates": "activation churn by effective " +
+		"balance, without waiting for finality",
+	"epoch_processing/rewards_and_penalties": "rewards derived from " +
+		"CometBFT votes",
+	"epoch_processing/slashings": "slashings tracked as a running total, " +
+		"without epoch penalties",
+	"epoch_processing/sync_committee_updates": "no sync committees",
+
+	// Sanity
+	"sanity/blocks": "blocks without attestations, sync aggregates or " +
+		"eth1 votes",
+
+	// SSZ
+	"ssz_static/AttestationData":   "no checkpoints in AttestationData",
+	"ssz_static/BeaconBlock":       "BeaconKit block body",
+	"ssz_static/BeaconBlockBody":   "BeaconKit block body",
+	"ssz_static/BeaconState":       "BeaconKit keeps a subset of the state",
+	"ssz_static/Deposit":           "deposits carry their index, not a proof",
+	"ssz_static/SignedBeaconBlock": "BeaconKit block body",
+}
+
+// AllowDivergences allowlists the Divergences on the Runner.
+func AllowDivergences(r *Runner) {
+	for key, reason := range Divergences {
+		r.Allow(key, reason)
+	}
+}
@@ -0,0 +1,237 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package spectest
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// BeaconState is the marshallable BeaconKit beacon state the states of the
+// cases are converted to.
+type BeaconState = types.BeaconState[
+	*types.BeaconBlockHeader,
+	*types.Eth1Data,
+	*types.ExecutionPayloadHeader,
+	*types.Fork,
+	*types.Validator,
+	types.BeaconBlockHeader,
+	types.Eth1Data,
+	types.ExecutionPayloadHeader,
+	types.Fork,
+	types.Validator,
+]
+
+// StateReader is the beacon state checked against the post state of a case.
+type StateReader interface {
+	// GetMarshallable returns the marshallable version of the state.
+	GetMarshallable() (*BeaconState, error)
+}
+
+// StateWriter is the beacon state the pre state of a case is loaded into.
+type StateWriter interface {
+	SetGenesisValidatorsRoot(root common.Root) error
+	SetSlot(slot math.Slot) error
+	SetFork(fork *types.Fork) error
+	SetLatestBlockHeader(header *types.BeaconBlockHeader) error
+	UpdateBlockRootAtIndex(index uint64, root common.Root) error
+	UpdateStateRootAtIndex(index uint64, root common.Root) error
+	SetEth1Data(data *types.Eth1Data) error
+	SetEth1DepositIndex(index uint64) error
+	SetLatestExecutionPayloadHeader(
+		payloadHeader *types.ExecutionPayloadHeader,
+	) error
+	AddValidator(val *types.Validator) error
+	SetBalance(idx math.ValidatorIndex, balance math.Gwei) error
+	UpdateRandaoMixAtIndex(index uint64, mix common.Bytes32) error
+	SetNextWithdrawalIndex(index uint64) error
+	SetNextWithdrawalValidatorIndex(index math.ValidatorIndex) error
+	SetSlashingAtIndex(index uint64, amount math.Gwei) error
+	SetTotalSlashing(total math.Gwei) error
+}
+
+// ReadState reads the state of the <name>.ssz_snappy file of the case.
+func ReadState(c Case, name string) (*State, error) {
+	st := new(State)
+	if err := c.Decode(name, st); err != nil {
+		return nil, err
+	}
+	return st, nil
+}
+
+// Marshallable converts the State to the fields BeaconKit keeps. The total
+// slashing BeaconKit tracks is the sum of the slashings vector.
+func (s *State) Marshallable() (*BeaconState, error) {
+	var (
+		slashings = make([]math.Gwei, len(s.Slashings))
+		total     math.Gwei
+	)
+	for i, amount := range s.Slashings {
+		slashings[i] = math.Gwei(amount)
+		total += slashings[i]
+	}
+	return new(BeaconState).New(
+		0,
+		s.GenesisValidatorsRoot,
+		s.Slot,
+		s.Fork,
+		s.LatestBlockHeader,
+		s.BlockRoots,
+		s.StateRoots,
+		s.Eth1Data,
+		s.Eth1DepositIndex,
+		s.LatestExecutionPayloadHeader,
+		s.Validators,
+		s.Balances,
+		s.RandaoMixes,
+		s.NextWithdrawalIndex,
+		s.NextWithdrawalValidatorIndex,
+		slashings,
+		total,
+	)
+}
+
+// Load writes the fields BeaconKit keeps into st, which must be empty.
+func (s *State) Load(st StateWriter) error {
+	if err := st.SetGenesisValidatorsRoot(s.GenesisValidatorsRoot); err != nil {
+		return err
+	}
+	if err := st.SetSlot(s.Slot); err != nil {
+		return err
+	}
+	if err := st.SetFork(s.Fork); err != nil {
+		return err
+	}
+	if err := st.SetLatestBlockHeader(s.LatestBlockHeader); err != nil {
+		return err
+	}
+	for i := range s.BlockRoots {
+		if err := st.UpdateBlockRootAtIndex(
+			uint64(i), s.BlockRoots[i],
+		); err != nil {
+			return err
+		}
+		if err := st.UpdateStateRootAtIndex(
+			uint64(i), s.StateRoots[i],
+		); err != nil {
+			return err
+		}
+	}
+	if err := st.SetEth1Data(s.Eth1Data); err != nil {
+		return err
+	}
+	if err := st.SetEth1DepositIndex(s.Eth1DepositIndex); err != nil {
+		return err
+	}
+	if err := st.SetLatestExecutionPayloadHeader(
+		s.LatestExecutionPayloadHeader,
+	); err != nil {
+		return err
+	}
+	for i, val := range s.Validators {
+		if err := st.AddValidator(val); err != nil {
+			return err
+		}
+		if err := st.SetBalance(
+			math.ValidatorIndex(i), math.Gwei(s.Balances[i]),
+		); err != nil {
+			return err
+		}
+	}
+	for i, mix := range s.RandaoMixes {
+		if err := st.UpdateRandaoMixAtIndex(uint64(i), mix); err != nil {
+			return err
+		}
+	}
+	if err := st.SetNextWithdrawalIndex(s.NextWithdrawalIndex); err != nil {
+		return err
+	}
+	if err := st.SetNextWithdrawalValidatorIndex(
+		s.NextWithdrawalValidatorIndex,
+	); err != nil {
+		return err
+	}
+	var total math.Gwei
+	for i, amount := range s.Slashings {
+		total += math.Gwei(amount)
+		if err := st.SetSlashingAtIndex(
+			uint64(i), math.Gwei(amount),
+		); err != nil {
+			return err
+		}
+	}
+	return st.SetTotalSlashing(total)
+}
+
+// Ignore returns true for a difference from the post state that is an
+// expected consequence of an intentional divergence.
+type Ignore func(diff types.FieldDiff) bool
+
+// IgnoreFields returns an Ignore ignoring any difference in the fields.
+func IgnoreFields(fields ...string) Ignore {
+	return func(diff types.FieldDiff) bool {
+		return slices.Contains(fields, diff.Field)
+	}
+}
+
+// CheckPost checks the outcome of processing the case, where err is the
+// error processing returned and st the resulting state. Cases without a post
+// state must be rejected, while the others must result in their post state
+// up to the differences ignore accepts, which may be nil.
+func CheckPost(c Case, st StateReader, err error, ignore Ignore) error {
+	if !c.Has("post.ssz_snappy") {
+		if err == nil {
+			return ErrUnexpectedSuccess
+		}
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	post, err := ReadState(c, "post")
+	if err != nil {
+		return err
+	}
+	want, err := post.Marshallable()
+	if err != nil {
+		return err
+	}
+	got, err := st.GetMarshallable()
+	if err != nil {
+		return err
+	}
+
+	diffs := got.Diff(want)
+	if ignore != nil {
+		diffs = slices.DeleteFunc(diffs, ignore)
+	}
+	if len(diffs) > 0 {
+		return fmt.Errorf(
+			"%w: %d difference(s), first %s",
+			ErrStateMismatch, len(diffs), diffs[0],
+		)
+	}
+	return nil
+}
@@ -0,0 +1,144 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package spectest
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/golang/snappy"
+	"gopkg.in/yaml.v3"
+)
+
+// Case is a single test case of the consensus-spec-tests, laid out as
+// tests/<preset>/<fork>/<runner>/<handler>/<suite>/<name>.
+type Case struct {
+	// Runner is the kind of test, e.g. operations.
+	Runner string
+	// Handler is the part of the spec under test, e.g. voluntary_exit.
+	Handler string
+	// Suite is the suite the case belongs to, e.g. pyspec_tests.
+	Suite string
+	// Name is the name of the case.
+	Name string
+	// Dir is the directory holding the files of the case.
+	Dir string
+}
+
+// LoadCases returns the cases of the given preset and fork found in dir,
+// the root of an extracted consensus-spec-tests release.
+func LoadCases(dir, preset, fork string) ([]Case, error) {
+	root := filepath.Join(dir, "tests", preset, fork)
+	runners, err := subdirs(root)
+	if err != nil {
+		return nil, err
+	}
+
+	var cases []Case
+	for _, runner := range runners {
+		var handlers []string
+		if handlers, err = subdirs(filepath.Join(root, runner)); err != nil {
+			return nil, err
+		}
+		for _, handler := range handlers {
+			var suites []string
+			if suites, err = subdirs(
+				filepath.Join(root, runner, handler),
+			); err != nil {
+				return nil, err
+			}
+			for _, suite := range suites {
+				suiteDir := filepath.Join(root, runner, handler, suite)
+				var names []string
+				if names, err = subdirs(suiteDir); err != nil {
+					return nil, err
+				}
+				for _, name := range names {
+					cases = append(cases, Case{
+						Runner:  runner,
+						Handler: handler,
+						Suite:   suite,
+						Name:    name,
+						Dir:     filepath.Join(suiteDir, name),
+					})
+				}
+			}
+		}
+	}
+	return cases, nil
+}
+
+// Key returns the runner/handler key the case is reported under.
+func (c Case) Key() string {
+	return c.Runner + "/" + c.Handler
+}
+
+// String returns the path of the case relative to its fork.
+func (c Case) String() string {
+	return c.Key() + "/" + c.Suite + "/" + c.Name
+}
+
+// Has returns true if the case holds the file with the given name.
+func (c Case) Has(file string) bool {
+	_, err := os.Stat(filepath.Join(c.Dir, file))
+	return err == nil
+}
+
+// SSZ returns the decompressed contents of the <name>.ssz_snappy file.
+func (c Case) SSZ(name string) ([]byte, error) {
+	bz, err := os.ReadFile(filepath.Join(c.Dir, name+".ssz_snappy"))
+	if err != nil {
+		return nil, err
+	}
+	return snappy.Decode(nil, bz)
+}
+
+// Decode unmarshals the <name>.ssz_snappy file into obj.
+func (c Case) Decode(name string, obj Unmarshaler) error {
+	bz, err := c.SSZ(name)
+	if err != nil {
+		return err
+	}
+	return obj.UnmarshalSSZ(bz)
+}
+
+// YAML unmarshals the <name>.yaml file into v.
+func (c Case) YAML(name string, v any) error {
+	bz, err := os.ReadFile(filepath.Join(c.Dir, name+".yaml"))
+	if err != nil {
+		return err
+	}
+	return yaml.Unmarshal(bz, v)
+}
+
+// subdirs returns the names of the directories in dir, in lexical order.
+func subdirs(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() {
+			names = append(names, entry.Name())
+		}
+	}
+	return names, nil
+}
@@ -0,0 +1,44 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package spectest
+
+import "github.com/berachain/beacon-kit/mod/errors"
+
+var (
+	// ErrSkip is returned by a Handler for a case it deliberately does not
+	// run. The case is reported as skipped rather than failed.
+	ErrSkip = errors.New("case skipped")
+
+	// ErrUnexpectedSuccess is returned when a case without a post state,
+	// which must be rejected, is processed without error.
+	ErrUnexpectedSuccess = errors.New("invalid case processed without error")
+
+	// ErrStateMismatch is returned when the post state differs from the
+	// expected one.
+	ErrStateMismatch = errors.New("post state mismatch")
+
+	// ErrRootMismatch is returned when the hash tree root of an ssz_static
+	// object differs from the expected one.
+	ErrRootMismatch = errors.New("hash tree root mismatch")
+
+	// ErrEncodingMismatch is returned when an ssz_static object does not
+	// serialize back to the bytes it was decoded from.
+	ErrEncodingMismatch = errors.New("serialization mismatch")
+)
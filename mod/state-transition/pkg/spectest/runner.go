@@ -0,0 +1,185 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package spectest
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"text/tabwriter"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+)
+
+// Handler runs a single case, returning nil if BeaconKit conforms to it.
+type Handler func(c Case) error
+
+// Runner dispatches the cases of the consensus-spec-tests to the Handlers
+// adapting them to BeaconKit. Handlers that BeaconKit intentionally diverges
+// from are allowlisted, so that they are reported as skipped with the
+// reason rather than failing.
+type Runner struct {
+	// handlers are the Handlers by runner/handler key.
+	handlers map[string]Handler
+	// allowlist are the reasons of the intentional divergences, by
+	// runner/handler key or by case path.
+	allowlist map[string]string
+}
+
+// NewRunner returns a new Runner with no Handler registered.
+func NewRunner() *Runner {
+	return &Runner{
+		handlers:  make(map[string]Handler),
+		allowlist: make(map[string]string),
+	}
+}
+
+// Register registers the Handler of the cases under runner/handler.
+func (r *Runner) Register(runner, handler string, h Handler) {
+	r.handlers[runner+"/"+handler] = h
+}
+
+// Allow allowlists the cases under key, either a runner/handler key or the
+// path of a single case, as an intentional divergence for the given reason.
+func (r *Runner) Allow(key, reason string) {
+	r.allowlist[key] = reason
+}
+
+// Run runs the cases and reports the results per runner/handler. Cases
+// without a Handler are skipped as unsupported.
+func (r *Runner) Run(cases []Case) *Report {
+	report := &Report{results: make(map[string]*Result)}
+	for _, c := range cases {
+		res := report.result(c.Key())
+		if reason, ok := r.allowed(c); ok {
+			res.Skipped++
+			res.Reason = reason
+			continue
+		}
+
+		h, ok := r.handlers[c.Key()]
+		if !ok {
+			res.Skipped++
+			res.Reason = "unsupported"
+			continue
+		}
+
+		switch err := h(c); {
+		case err == nil:
+			res.Passed++
+		case errors.Is(err, ErrSkip):
+			res.Skipped++
+		default:
+			res.Failed++
+			res.Failures = append(res.Failures, Failure{Case: c, Err: err})
+		}
+	}
+	return report
+}
+
+// allowed returns the reason the case is allowlisted for, if it is.
+func (r *Runner) allowed(c Case) (string, bool) {
+	if reason, ok := r.allowlist[c.String()]; ok {
+		return reason, true
+	}
+	reason, ok := r.allowlist[c.Key()]
+	return reason, ok
+}
+
+// Failure is a case BeaconKit does not conform to.
+type Failure struct {
+	// Case is the failing case.
+	Case Case
+	// Err is the error the case failed with.
+	Err error
+}
+
+// Result is the outcome of the cases of a runner/handler.
+type Result struct {
+	// Passed is the number of cases BeaconKit conforms to.
+	Passed int
+	// Skipped is the number of cases not run.
+	Skipped int
+	// Failed is the number of cases BeaconKit does not conform to.
+	Failed int
+	// Reason is why the cases were skipped, if the runner/handler is
+	// allowlisted or unsupported.
+	Reason string
+	// Failures are the failing cases.
+	Failures []Failure
+}
+
+// Report is the outcome of a Run, by runner/handler.
+type Report struct {
+	results map[string]*Result
+}
+
+// Keys returns the runner/handler keys of the report, in lexical order.
+func (r *Report) Keys() []string {
+	keys := make([]string, 0, len(r.results))
+	for key := range r.results {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Result returns the result of the given runner/handler key, or nil if no
+// case ran under it.
+func (r *Report) Result(key string) *Result {
+	return r.results[key]
+}
+
+// Failures returns all the failing cases of the report.
+func (r *Report) Failures() []Failure {
+	var failures []Failure
+	for _, key := range r.Keys() {
+		failures = append(failures, r.results[key].Failures...)
+	}
+	return failures
+}
+
+// Write writes the pass/skip/fail counts of every runner/handler to w.
+func (r *Report) Write(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	if _, err := fmt.Fprintln(tw, "HANDLER\tPASS\tSKIP\tFAIL\tNOTE"); err != nil {
+		return err
+	}
+	for _, key := range r.Keys() {
+		res := r.results[key]
+		if _, err := fmt.Fprintf(
+			tw, "%s\t%d\t%d\t%d\t%s\n",
+			key, res.Passed, res.Skipped, res.Failed, res.Reason,
+		); err != nil {
+			return err
+		}
+	}
+	return tw.Flush()
+}
+
+// result returns the Result of key, creating it if needed.
+func (r *Report) result(key string) *Result {
+	res, ok := r.results[key]
+	if !ok {
+		res = new(Result)
+		r.results[key] = res
+	}
+	return res
+}
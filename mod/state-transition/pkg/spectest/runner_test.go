@@ -0,0 +1,120 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package spectest_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/state-transition/pkg/spectest"
+	"github.com/golang/snappy"
+	"github.com/stretchr/testify/require"
+)
+
+// writeCase writes the files of a case under the mainnet deneb tests of dir.
+func writeCase(
+	t *testing.T, dir, runner, handler, name string, files map[string][]byte,
+) {
+	t.Helper()
+	caseDir := filepath.Join(
+		dir, "tests", "mainnet", "deneb", runner, handler, "pyspec_tests", name,
+	)
+	require.NoError(t, os.MkdirAll(caseDir, 0o755))
+	for file, bz := range files {
+		if filepath.Ext(file) == ".ssz_snappy" {
+			bz = snappy.Encode(nil, bz)
+		}
+		require.NoError(t, os.WriteFile(filepath.Join(caseDir, file), bz, 0o600))
+	}
+}
+
+// writeSSZStatic writes an ssz_static case of obj with the given root.
+func writeSSZStatic(
+	t *testing.T, dir, handler, name string, obj spectest.Object,
+	root common.Root,
+) {
+	t.Helper()
+	bz, err := obj.MarshalSSZ()
+	require.NoError(t, err)
+	writeCase(t, dir, "ssz_static", handler, name, map[string][]byte{
+		"serialized.ssz_snappy": bz,
+		"roots.yaml":            []byte("{root: '" + root.Hex() + "'}\n"),
+	})
+}
+
+func TestRunner(t *testing.T) {
+	dir := t.TempDir()
+	fork := &types.Fork{
+		PreviousVersion: common.Version{1},
+		CurrentVersion:  common.Version{2},
+		Epoch:           3,
+	}
+	writeSSZStatic(t, dir, "Fork", "case_0", fork, fork.HashTreeRoot())
+	writeSSZStatic(t, dir, "Fork", "case_1", fork, common.Root{1})
+	writeSSZStatic(t, dir, "Deposit", "case_0", fork, fork.HashTreeRoot())
+	writeSSZStatic(t, dir, "Attestation", "case_0", fork, fork.HashTreeRoot())
+	writeCase(t, dir, "sanity", "slots", "skipped", nil)
+
+	cases, err := spectest.LoadCases(dir, "mainnet", "deneb")
+	require.NoError(t, err)
+	require.Len(t, cases, 5)
+
+	r := spectest.NewRunner()
+	spectest.RegisterSSZStatic(r)
+	spectest.AllowDivergences(r)
+	r.Register("sanity", "slots", func(spectest.Case) error {
+		return spectest.ErrSkip
+	})
+	report := r.Run(cases)
+
+	require.Equal(t, []string{
+		"sanity/slots",
+		"ssz_static/Attestation",
+		"ssz_static/Deposit",
+		"ssz_static/Fork",
+	}, report.Keys())
+
+	res := report.Result("ssz_static/Fork")
+	require.Equal(t, 1, res.Passed)
+	require.Equal(t, 1, res.Failed)
+	require.Len(t, res.Failures, 1)
+	require.Equal(t, "case_1", res.Failures[0].Case.Name)
+	require.ErrorIs(t, res.Failures[0].Err, spectest.ErrRootMismatch)
+
+	res = report.Result("ssz_static/Deposit")
+	require.Equal(t, 1, res.Skipped)
+	require.Equal(t, spectest.Divergences["ssz_static/Deposit"], res.Reason)
+	require.Equal(t, "unsupported", report.Result("ssz_static/Attestation").Reason)
+	require.Equal(t, 1, report.Result("sanity/slots").Skipped)
+	require.Len(t, report.Failures(), 1)
+
+	var buf bytes.Buffer
+	require.NoError(t, report.Write(&buf))
+	require.Contains(t, buf.String(), "ssz_static/Fork")
+}
+
+func TestLoadCases_MissingFork(t *testing.T) {
+	_, err := spectest.LoadCases(t.TempDir(), "mainnet", "deneb")
+	require.ErrorIs(t, err, os.ErrNotExist)
+}
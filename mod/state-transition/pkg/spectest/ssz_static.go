@@ -0,0 +1,121 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package spectest
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+)
+
+// Unmarshaler is an object decoded from a case file.
+type Unmarshaler interface {
+	// UnmarshalSSZ decodes the object from its SSZ encoding.
+	UnmarshalSSZ(bz []byte) error
+}
+
+// Object is an SSZ container checked against the ssz_static cases.
+type Object interface {
+	Unmarshaler
+	// MarshalSSZ returns the SSZ encoding of the object.
+	MarshalSSZ() ([]byte, error)
+	// HashTreeRoot returns the hash tree root of the object.
+	HashTreeRoot() common.Root
+}
+
+// SSZStatic returns the Handler of the ssz_static cases of the container
+// created by newObj. A case passes if the serialized object decodes, has the
+// expected hash tree root and encodes back to the same bytes.
+func SSZStatic(newObj func() Object) Handler {
+	return func(c Case) error {
+		bz, err := c.SSZ("serialized")
+		if err != nil {
+			return err
+		}
+		obj := newObj()
+		if err = obj.UnmarshalSSZ(bz); err != nil {
+			return err
+		}
+
+		var roots struct {
+			Root string `yaml:"root"`
+		}
+		if err = c.YAML("roots", &roots); err != nil {
+			return err
+		}
+		if root := obj.HashTreeRoot().Hex(); !strings.EqualFold(
+			root, roots.Root,
+		) {
+			return fmt.Errorf(
+				"%w: got %s, want %s", ErrRootMismatch, root, roots.Root,
+			)
+		}
+
+		encoded, err := obj.MarshalSSZ()
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(encoded, bz) {
+			return ErrEncodingMismatch
+		}
+		return nil
+	}
+}
+
+// RegisterSSZStatic registers the ssz_static Handlers of the containers
+// BeaconKit shares with Ethereum.
+func RegisterSSZStatic(r *Runner) {
+	for name, newObj := range map[string]func() Object{
+		"BeaconBlockHeader": func() Object { return new(types.BeaconBlockHeader) },
+		"BLSToExecutionChange": func() Object {
+			return new(types.BLSToExecutionChange)
+		},
+		"DepositMessage":   func() Object { return new(types.DepositMessage) },
+		"Eth1Data":         func() Object { return new(types.Eth1Data) },
+		"ExecutionPayload": func() Object { return new(types.ExecutionPayload) },
+		"ExecutionPayloadHeader": func() Object {
+			return new(types.ExecutionPayloadHeader)
+		},
+		"Fork":             func() Object { return new(types.Fork) },
+		"ForkData":         func() Object { return new(types.ForkData) },
+		"ProposerSlashing": func() Object { return new(types.ProposerSlashing) },
+		"SignedBeaconBlockHeader": func() Object {
+			return new(types.SignedBeaconBlockHeader)
+		},
+		"SignedBLSToExecutionChange": func() Object {
+			return new(types.SignedBLSToExecutionChange)
+		},
+		"SignedVoluntaryExit": func() Object {
+			return new(types.SignedVoluntaryExit)
+		},
+		"SigningData":   func() Object { return new(types.SigningData) },
+		"Validator":     func() Object { return new(types.Validator) },
+		"VoluntaryExit": func() Object { return new(types.VoluntaryExit) },
+		"Withdrawal": func() Object {
+			return new(engineprimitives.Withdrawal)
+		},
+	} {
+		r.Register("ssz_static", name, SSZStatic(newObj))
+	}
+}
@@ -0,0 +1,279 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package spectest
+
+import (
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/karalabe/ssz"
+)
+
+// The mainnet preset values sizing the Deneb BeaconState.
+const (
+	slotsPerHistoricalRoot    = 8192
+	epochsPerHistoricalVector = 65536
+	epochsPerSlashingsVector  = 8192
+	historicalRootsLimit      = 1 << 24
+	eth1DataVotesLimit        = 2048
+	validatorRegistryLimit    = 1 << 40
+	syncCommitteeSize         = 512
+	justificationBitsLength   = 4
+)
+
+// Sizes of the static containers of the Deneb BeaconState.
+const (
+	checkpointSize         = 40
+	historicalSummarySize  = 64
+	syncCommitteeFixedSize = (syncCommitteeSize + 1) * 48
+	stateFixedSize         = 8 + 32 + 8 + 16 + types.BeaconBlockHeaderSize +
+		2*slotsPerHistoricalRoot*32 + 4 + 72 + 4 + 8 + 4 + 4 +
+		epochsPerHistoricalVector*32 + epochsPerSlashingsVector*8 + 4 + 4 +
+		1 + 3*checkpointSize + 4 + 2*syncCommitteeFixedSize + 4 + 8 + 8 + 4
+)
+
+// Checkpoint as defined in the Ethereum 2.0 specification.
+type Checkpoint struct {
+	Epoch math.Epoch
+	Root  common.Root
+}
+
+// SizeSSZ returns the size of the Checkpoint object in SSZ encoding.
+func (*Checkpoint) SizeSSZ(*ssz.Sizer) uint32 {
+	return checkpointSize
+}
+
+// DefineSSZ defines the SSZ encoding for the Checkpoint object.
+func (c *Checkpoint) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineUint64(codec, &c.Epoch)
+	ssz.DefineStaticBytes(codec, &c.Root)
+}
+
+// SyncCommittee as defined in the Ethereum 2.0 specification.
+type SyncCommittee struct {
+	Pubkeys         []crypto.BLSPubkey
+	AggregatePubkey crypto.BLSPubkey
+}
+
+// SizeSSZ returns the size of the SyncCommittee object in SSZ encoding.
+func (*SyncCommittee) SizeSSZ(*ssz.Sizer) uint32 {
+	return syncCommitteeFixedSize
+}
+
+// DefineSSZ defines the SSZ encoding for the SyncCommittee object.
+func (s *SyncCommittee) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineCheckedArrayOfStaticBytes(codec, &s.Pubkeys, syncCommitteeSize)
+	ssz.DefineStaticBytes(codec, &s.AggregatePubkey)
+}
+
+// HistoricalSummary as defined in the Ethereum 2.0 specification.
+type HistoricalSummary struct {
+	BlockSummaryRoot common.Root
+	StateSummaryRoot common.Root
+}
+
+// SizeSSZ returns the size of the HistoricalSummary object in SSZ encoding.
+func (*HistoricalSummary) SizeSSZ(*ssz.Sizer) uint32 {
+	return historicalSummarySize
+}
+
+// DefineSSZ defines the SSZ encoding for the HistoricalSummary object.
+func (h *HistoricalSummary) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineStaticBytes(codec, &h.BlockSummaryRoot)
+	ssz.DefineStaticBytes(codec, &h.StateSummaryRoot)
+}
+
+// State is the Deneb BeaconState of the mainnet preset, as defined in the
+// Ethereum 2.0 specification. It is only used to read the states of the
+// cases, which are then converted to the subset of fields BeaconKit keeps.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/deneb/beacon-chain.md#beaconstate
+//
+//nolint:lll
+type State struct {
+	// Versioning
+	GenesisTime           uint64
+	GenesisValidatorsRoot common.Root
+	Slot                  math.Slot
+	Fork                  *types.Fork
+
+	// History
+	LatestBlockHeader *types.BeaconBlockHeader
+	BlockRoots        []common.Root
+	StateRoots        []common.Root
+	HistoricalRoots   []common.Root
+
+	// Eth1
+	Eth1Data         *types.Eth1Data
+	Eth1DataVotes    []*types.Eth1Data
+	Eth1DepositIndex uint64
+
+	// Registry
+	Validators []*types.Validator
+	Balances   []uint64
+
+	// Randomness
+	RandaoMixes []common.Bytes32
+
+	// Slashings
+	Slashings [epochsPerSlashingsVector]uint64
+
+	// Participation
+	PreviousEpochParticipation []byte
+	CurrentEpochParticipation  []byte
+
+	// Finality
+	JustificationBits           [1]byte
+	PreviousJustifiedCheckpoint *Checkpoint
+	CurrentJustifiedCheckpoint  *Checkpoint
+	FinalizedCheckpoint         *Checkpoint
+
+	// Inactivity
+	InactivityScores []uint64
+
+	// Sync
+	CurrentSyncCommittee *SyncCommittee
+	NextSyncCommittee    *SyncCommittee
+
+	// Execution
+	LatestExecutionPayloadHeader *types.ExecutionPayloadHeader
+
+	// Withdrawals
+	NextWithdrawalIndex          uint64
+	NextWithdrawalValidatorIndex math.ValidatorIndex
+
+	// Deep history
+	HistoricalSummaries []*HistoricalSummary
+}
+
+// SizeSSZ returns the size of the State object in SSZ encoding.
+func (s *State) SizeSSZ(siz *ssz.Sizer, fixed bool) uint32 {
+	var size uint32 = stateFixedSize
+	if fixed {
+		return size
+	}
+
+	size += ssz.SizeSliceOfStaticBytes(siz, s.HistoricalRoots)
+	size += ssz.SizeSliceOfStaticObjects(siz, s.Eth1DataVotes)
+	size += ssz.SizeSliceOfStaticObjects(siz, s.Validators)
+	size += ssz.SizeSliceOfUint64s(siz, s.Balances)
+	size += ssz.SizeDynamicBytes(siz, s.PreviousEpochParticipation)
+	size += ssz.SizeDynamicBytes(siz, s.CurrentEpochParticipation)
+	size += ssz.SizeSliceOfUint64s(siz, s.InactivityScores)
+	size += ssz.SizeDynamicObject(siz, s.LatestExecutionPayloadHeader)
+	size += ssz.SizeSliceOfStaticObjects(siz, s.HistoricalSummaries)
+	return size
+}
+
+// DefineSSZ defines the SSZ encoding for the State object.
+func (s *State) DefineSSZ(codec *ssz.Codec) {
+	// Define the static data (fields and dynamic offsets)
+	ssz.DefineUint64(codec, &s.GenesisTime)
+	ssz.DefineStaticBytes(codec, &s.GenesisValidatorsRoot)
+	ssz.DefineUint64(codec, &s.Slot)
+	ssz.DefineStaticObject(codec, &s.Fork)
+	ssz.DefineStaticObject(codec, &s.LatestBlockHeader)
+	ssz.DefineCheckedArrayOfStaticBytes(
+		codec, &s.BlockRoots, slotsPerHistoricalRoot,
+	)
+	ssz.DefineCheckedArrayOfStaticBytes(
+		codec, &s.StateRoots, slotsPerHistoricalRoot,
+	)
+	ssz.DefineSliceOfStaticBytesOffset(
+		codec, &s.HistoricalRoots, historicalRootsLimit,
+	)
+	ssz.DefineStaticObject(codec, &s.Eth1Data)
+	ssz.DefineSliceOfStaticObjectsOffset(
+		codec, &s.Eth1DataVotes, eth1DataVotesLimit,
+	)
+	ssz.DefineUint64(codec, &s.Eth1DepositIndex)
+	ssz.DefineSliceOfStaticObjectsOffset(
+		codec, &s.Validators, validatorRegistryLimit,
+	)
+	ssz.DefineSliceOfUint64sOffset(codec, &s.Balances, validatorRegistryLimit)
+	ssz.DefineCheckedArrayOfStaticBytes(
+		codec, &s.RandaoMixes, epochsPerHistoricalVector,
+	)
+	ssz.DefineArrayOfUint64s(codec, &s.Slashings)
+	ssz.DefineDynamicBytesOffset(
+		codec, &s.PreviousEpochParticipation, validatorRegistryLimit,
+	)
+	ssz.DefineDynamicBytesOffset(
+		codec, &s.CurrentEpochParticipation, validatorRegistryLimit,
+	)
+	ssz.DefineArrayOfBits(
+		codec, &s.JustificationBits, justificationBitsLength,
+	)
+	ssz.DefineStaticObject(codec, &s.PreviousJustifiedCheckpoint)
+	ssz.DefineStaticObject(codec, &s.CurrentJustifiedCheckpoint)
+	ssz.DefineStaticObject(codec, &s.FinalizedCheckpoint)
+	ssz.DefineSliceOfUint64sOffset(
+		codec, &s.InactivityScores, validatorRegistryLimit,
+	)
+	ssz.DefineStaticObject(codec, &s.CurrentSyncCommittee)
+	ssz.DefineStaticObject(codec, &s.NextSyncCommittee)
+	ssz.DefineDynamicObjectOffset(codec, &s.LatestExecutionPayloadHeader)
+	ssz.DefineUint64(codec, &s.NextWithdrawalIndex)
+	ssz.DefineUint64(codec, &s.NextWithdrawalValidatorIndex)
+	ssz.DefineSliceOfStaticObjectsOffset(
+		codec, &s.HistoricalSummaries, historicalRootsLimit,
+	)
+
+	// Define the dynamic data (fields)
+	ssz.DefineSliceOfStaticBytesContent(
+		codec, &s.HistoricalRoots, historicalRootsLimit,
+	)
+	ssz.DefineSliceOfStaticObjectsContent(
+		codec, &s.Eth1DataVotes, eth1DataVotesLimit,
+	)
+	ssz.DefineSliceOfStaticObjectsContent(
+		codec, &s.Validators, validatorRegistryLimit,
+	)
+	ssz.DefineSliceOfUint64sContent(codec, &s.Balances, validatorRegistryLimit)
+	ssz.DefineDynamicBytesContent(
+		codec, &s.PreviousEpochParticipation, validatorRegistryLimit,
+	)
+	ssz.DefineDynamicBytesContent(
+		codec, &s.CurrentEpochParticipation, validatorRegistryLimit,
+	)
+	ssz.DefineSliceOfUint64sContent(
+		codec, &s.InactivityScores, validatorRegistryLimit,
+	)
+	ssz.DefineDynamicObjectContent(codec, &s.LatestExecutionPayloadHeader)
+	ssz.DefineSliceOfStaticObjectsContent(
+		codec, &s.HistoricalSummaries, historicalRootsLimit,
+	)
+}
+
+// MarshalSSZ marshals the State into SSZ format.
+func (s *State) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, ssz.Size(s))
+	return buf, ssz.EncodeToBytes(buf, s)
+}
+
+// UnmarshalSSZ unmarshals the State from SSZ format.
+func (s *State) UnmarshalSSZ(buf []byte) error {
+	return ssz.DecodeFromBytes(buf, s)
+}
+
+// HashTreeRoot computes the Merkleization of the State.
+func (s *State) HashTreeRoot() common.Root {
+	return ssz.HashSequential(s)
+}
@@ -0,0 +1,151 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package spectest_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/state-transition/pkg/spectest"
+	"github.com/stretchr/testify/require"
+)
+
+// staticState is a StateReader returning a fixed state.
+type staticState struct {
+	st *spectest.BeaconState
+}
+
+func (s staticState) GetMarshallable() (*spectest.BeaconState, error) {
+	return s.st, nil
+}
+
+// testState returns a mainnet Deneb state with a single validator.
+func testState() *spectest.State {
+	cs := spectest.ChainSpec()
+	committee := &spectest.SyncCommittee{
+		Pubkeys: make([]crypto.BLSPubkey, 512),
+	}
+	st := &spectest.State{
+		GenesisValidatorsRoot: common.Root{1},
+		Slot:                  33,
+		Fork:                  &types.Fork{CurrentVersion: common.Version{4}},
+		LatestBlockHeader:     &types.BeaconBlockHeader{Slot: 32},
+		BlockRoots:            make([]common.Root, cs.SlotsPerHistoricalRoot()),
+		StateRoots:            make([]common.Root, cs.SlotsPerHistoricalRoot()),
+		Eth1Data:              new(types.Eth1Data),
+		Validators: []*types.Validator{
+			{Pubkey: crypto.BLSPubkey{2}, EffectiveBalance: 32e9},
+		},
+		Balances: []uint64{32e9},
+		RandaoMixes: make(
+			[]common.Bytes32, cs.EpochsPerHistoricalVector(),
+		),
+		PreviousJustifiedCheckpoint: new(spectest.Checkpoint),
+		CurrentJustifiedCheckpoint:  new(spectest.Checkpoint),
+		FinalizedCheckpoint:         new(spectest.Checkpoint),
+		CurrentSyncCommittee:        committee,
+		NextSyncCommittee:           committee,
+		LatestExecutionPayloadHeader: new(
+			types.ExecutionPayloadHeader,
+		).Empty(),
+		NextWithdrawalIndex: 5,
+	}
+	st.RandaoMixes[1] = common.Bytes32{3}
+	st.Slashings[1] = 7
+	st.Slashings[2] = 8
+	return st
+}
+
+func TestState_Marshallable(t *testing.T) {
+	st := testState()
+	bz, err := st.MarshalSSZ()
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	writeCase(t, dir, "operations", "withdrawals", "case_0",
+		map[string][]byte{"pre.ssz_snappy": bz})
+	cases, err := spectest.LoadCases(dir, "mainnet", "deneb")
+	require.NoError(t, err)
+	require.Len(t, cases, 1)
+
+	decoded, err := spectest.ReadState(cases[0], "pre")
+	require.NoError(t, err)
+	require.Equal(t, st.HashTreeRoot(), decoded.HashTreeRoot())
+
+	m, err := decoded.Marshallable()
+	require.NoError(t, err)
+	require.Equal(t, math.Slot(33), m.Slot)
+	require.Equal(t, st.Validators, m.Validators)
+	require.Equal(t, common.Bytes32{3}, m.RandaoMixes[1])
+	require.Len(t, m.Slashings, 8192)
+	require.Equal(t, math.Gwei(15), m.TotalSlashing)
+	require.Equal(t, uint64(5), m.NextWithdrawalIndex)
+}
+
+func TestCheckPost(t *testing.T) {
+	st := testState()
+	pre, err := st.MarshalSSZ()
+	require.NoError(t, err)
+	want, err := st.Marshallable()
+	require.NoError(t, err)
+
+	st.Slot++
+	post, err := st.MarshalSSZ()
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	writeCase(t, dir, "sanity", "slots", "invalid",
+		map[string][]byte{"pre.ssz_snappy": pre})
+	writeCase(t, dir, "sanity", "slots", "valid", map[string][]byte{
+		"pre.ssz_snappy":  pre,
+		"post.ssz_snappy": post,
+	})
+	cases, err := spectest.LoadCases(dir, "mainnet", "deneb")
+	require.NoError(t, err)
+	require.Len(t, cases, 2)
+	invalid, valid := cases[0], cases[1]
+	errProcess := errors.New("rejected")
+
+	// Cases without a post state must be rejected.
+	require.NoError(t, spectest.CheckPost(
+		invalid, staticState{want}, errProcess, nil,
+	))
+	require.ErrorIs(t, spectest.CheckPost(
+		invalid, staticState{want}, nil, nil,
+	), spectest.ErrUnexpectedSuccess)
+
+	// Cases with a post state must result in it.
+	require.ErrorIs(t, spectest.CheckPost(
+		valid, staticState{want}, errProcess, nil,
+	), errProcess)
+	require.ErrorIs(t, spectest.CheckPost(
+		valid, staticState{want}, nil, nil,
+	), spectest.ErrStateMismatch)
+	require.NoError(t, spectest.CheckPost(
+		valid, staticState{want}, nil, spectest.IgnoreFields("Slot"),
+	))
+
+	want.Slot++
+	require.NoError(t, spectest.CheckPost(valid, staticState{want}, nil, nil))
+}
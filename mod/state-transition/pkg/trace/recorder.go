@@ -0,0 +1,113 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package trace
+
+import (
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
+)
+
+// BeaconState is the interface of the beacon state traced by the Recorder.
+type BeaconState[BeaconStateMarshallableT any] interface {
+	UnmerkleizedState
+	// GetMarshallable returns the marshallable version of the state.
+	GetMarshallable() (BeaconStateMarshallableT, error)
+}
+
+// BeaconStateMarshallable is the interface of the marshallable beacon state
+// the Recorder diffs between the start and the end of a step.
+type BeaconStateMarshallable[T any] interface {
+	// Diff returns the differences between the state and other.
+	Diff(other T) []types.FieldDiff
+}
+
+// Step is a traced sub-step of a state transition.
+type Step struct {
+	// Name is the name of the sub-step, e.g. processBlockHeader.
+	Name string
+	// Diffs are the fields mutated by the sub-step, with their values
+	// before and after it ran.
+	Diffs []types.FieldDiff
+	// Err is the error the sub-step failed with, if any.
+	Err error
+}
+
+// Recorder is a Tracer recording the state mutations of every sub-step of
+// the traced state transitions, both to the fields merkleized into the state
+// root and to the ones kept out of it.
+type Recorder[
+	BeaconStateT BeaconState[BeaconStateMarshallableT],
+	BeaconStateMarshallableT BeaconStateMarshallable[BeaconStateMarshallableT],
+] struct {
+	// steps are the sub-steps recorded so far.
+	steps []Step
+	// pre is the state at the start of the running sub-step.
+	pre BeaconStateMarshallableT
+	// preUnmerkleized is the unmerkleized state at the start of the running
+	// sub-step.
+	preUnmerkleized *Unmerkleized
+	// preErr is the error snapshotting the state returned, if any.
+	preErr error
+}
+
+// NewRecorder returns a new, empty Recorder.
+func NewRecorder[
+	BeaconStateT BeaconState[BeaconStateMarshallableT],
+	BeaconStateMarshallableT BeaconStateMarshallable[BeaconStateMarshallableT],
+]() *Recorder[BeaconStateT, BeaconStateMarshallableT] {
+	return &Recorder[BeaconStateT, BeaconStateMarshallableT]{}
+}
+
+// StartStep snapshots the state before the named sub-step runs.
+func (r *Recorder[BeaconStateT, _]) StartStep(_ string, st BeaconStateT) {
+	var unmerkleizedErr error
+	r.pre, r.preErr = st.GetMarshallable()
+	r.preUnmerkleized, unmerkleizedErr = NewUnmerkleized(st)
+	r.preErr = errors.Join(r.preErr, unmerkleizedErr)
+}
+
+// EndStep records the fields the named sub-step mutated.
+func (r *Recorder[BeaconStateT, _]) EndStep(
+	name string,
+	st BeaconStateT,
+	err error,
+) {
+	s := Step{Name: name, Err: err}
+	post, postErr := st.GetMarshallable()
+	postUnmerkleized, unmerkleizedErr := NewUnmerkleized(st)
+	if snapErr := errors.Join(
+		r.preErr, postErr, unmerkleizedErr,
+	); snapErr != nil {
+		s.Err = errors.Join(
+			s.Err, errors.Wrap(snapErr, "failed to snapshot state"),
+		)
+	} else {
+		s.Diffs = append(
+			r.pre.Diff(post), r.preUnmerkleized.Diff(postUnmerkleized)...,
+		)
+	}
+	r.steps = append(r.steps, s)
+}
+
+// Steps returns the sub-steps recorded so far, in execution order.
+func (r *Recorder[_, _]) Steps() []Step {
+	return r.steps
+}
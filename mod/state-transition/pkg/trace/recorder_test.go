@@ -0,0 +1,157 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package trace_test
+
+import (
+	"errors"
+	"maps"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	"github.com/berachain/beacon-kit/mod/state-transition/pkg/trace"
+	"github.com/stretchr/testify/require"
+)
+
+type beaconState = types.BeaconState[
+	*types.BeaconBlockHeader,
+	*types.Eth1Data,
+	*types.ExecutionPayloadHeader,
+	*types.Fork,
+	*types.Validator,
+	types.BeaconBlockHeader,
+	types.Eth1Data,
+	types.ExecutionPayloadHeader,
+	types.Fork,
+	types.Validator,
+]
+
+// fakeState is a traced state with a slot, balances, participation and a
+// validator set.
+type fakeState struct {
+	slot          math.Slot
+	balances      []uint64
+	participation []uint64
+	validatorSet  map[math.ValidatorIndex]math.Gwei
+}
+
+func (s *fakeState) GetMarshallable() (*beaconState, error) {
+	return &beaconState{
+		Slot:                         s.slot,
+		Fork:                         &types.Fork{},
+		LatestBlockHeader:            &types.BeaconBlockHeader{},
+		Eth1Data:                     &types.Eth1Data{},
+		LatestExecutionPayloadHeader: &types.ExecutionPayloadHeader{},
+		Balances:                     append([]uint64(nil), s.balances...),
+	}, nil
+}
+
+func (s *fakeState) GetTotalValidators() (uint64, error) {
+	return uint64(len(s.balances)), nil
+}
+
+func (s *fakeState) GetEpochParticipation(
+	idx math.ValidatorIndex,
+) (uint64, error) {
+	return s.participation[idx], nil
+}
+
+func (*fakeState) GetEpochProposals(math.ValidatorIndex) (uint64, error) {
+	return 0, nil
+}
+
+func (*fakeState) GetEpochCommits() (uint64, error) { return 0, nil }
+
+func (*fakeState) GetInactivityScore(math.ValidatorIndex) (uint64, error) {
+	return 0, nil
+}
+
+func (s *fakeState) GetValidatorSet() (
+	map[math.ValidatorIndex]math.Gwei, error,
+) {
+	return maps.Clone(s.validatorSet), nil
+}
+
+func (*fakeState) GetPendingConsolidations() (
+	map[math.ValidatorIndex]math.ValidatorIndex, error,
+) {
+	return nil, nil
+}
+
+func (*fakeState) GetProposerSet() (*transition.ProposerSet, error) {
+	return nil, nil
+}
+
+func (*fakeState) GetNextProposerSet() (*transition.ProposerSet, error) {
+	return nil, nil
+}
+
+func TestRecorder(t *testing.T) {
+	st := &fakeState{
+		balances:      []uint64{1, 2},
+		participation: []uint64{0, 0},
+		validatorSet:  map[math.ValidatorIndex]math.Gwei{1: 32},
+	}
+	recorder := trace.NewRecorder[*fakeState, *beaconState]()
+
+	recorder.StartStep("processSlot", st)
+	st.slot++
+	recorder.EndStep("processSlot", st, nil)
+
+	errStep := errors.New("step failed")
+	recorder.StartStep("processWithdrawals", st)
+	st.balances[1] = 0
+	recorder.EndStep("processWithdrawals", st, errStep)
+
+	// Fields kept out of the state root are recorded after the other ones.
+	recorder.StartStep("processParticipation", st)
+	st.participation[0] = 3
+	st.validatorSet = map[math.ValidatorIndex]math.Gwei{0: 32}
+	st.slot++
+	recorder.EndStep("processParticipation", st, nil)
+
+	steps := recorder.Steps()
+	require.Len(t, steps, 3)
+
+	require.Equal(t, "processSlot", steps[0].Name)
+	require.NoError(t, steps[0].Err)
+	require.Len(t, steps[0].Diffs, 1)
+	require.Equal(t, "Slot", steps[0].Diffs[0].Field)
+	require.Equal(t, math.Slot(0), steps[0].Diffs[0].Old)
+	require.Equal(t, math.Slot(1), steps[0].Diffs[0].New)
+
+	require.Equal(t, "processWithdrawals", steps[1].Name)
+	require.ErrorIs(t, steps[1].Err, errStep)
+	require.Equal(t, []types.FieldDiff{{
+		Field: "Balances", Index: 1, Old: uint64(2), New: uint64(0),
+	}}, steps[1].Diffs)
+
+	require.Equal(t, []types.FieldDiff{
+		{Field: "Slot", Index: -1, Old: math.Slot(1), New: math.Slot(2)},
+		{
+			Field: "EpochParticipation", Index: 0,
+			Old: uint64(0), New: uint64(3),
+		},
+		{Field: "ValidatorSet", Index: 0, New: math.Gwei(32)},
+		{Field: "ValidatorSet", Index: 1, Old: math.Gwei(32)},
+	}, steps[2].Diffs)
+}
@@ -0,0 +1,239 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package trace
+
+import (
+	"maps"
+	"slices"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+)
+
+// noIndex is the index of a FieldDiff on a field that is not a list.
+const noIndex = -1
+
+// UnmerkleizedState is the interface of the fields of the beacon state that
+// are kept out of the state root.
+type UnmerkleizedState interface {
+	// GetTotalValidators returns the number of validators.
+	GetTotalValidators() (uint64, error)
+	// GetEpochParticipation returns the number of commits the validator
+	// signed in the current epoch.
+	GetEpochParticipation(math.ValidatorIndex) (uint64, error)
+	// GetEpochProposals returns the number of blocks the validator proposed
+	// in the current epoch.
+	GetEpochProposals(math.ValidatorIndex) (uint64, error)
+	// GetEpochCommits returns the number of commits recorded in the current
+	// epoch.
+	GetEpochCommits() (uint64, error)
+	// GetInactivityScore returns the inactivity score of the validator.
+	GetInactivityScore(math.ValidatorIndex) (uint64, error)
+	// GetValidatorSet returns the voting power of each validator in the set
+	// last sent to CometBFT.
+	GetValidatorSet() (map[math.ValidatorIndex]math.Gwei, error)
+	// GetPendingConsolidations returns the target validator index of each
+	// validator whose balance is waiting to be consolidated.
+	GetPendingConsolidations() (
+		map[math.ValidatorIndex]math.ValidatorIndex, error,
+	)
+	// GetProposerSet returns the set the proposer of the current block is
+	// selected from.
+	GetProposerSet() (*transition.ProposerSet, error)
+	// GetNextProposerSet returns the set the proposer of the next block is
+	// selected from.
+	GetNextProposerSet() (*transition.ProposerSet, error)
+}
+
+// Unmerkleized is a snapshot of the fields of the beacon state that are
+// kept out of the state root. They do not show up in the diff of two
+// BeaconStateMarshallables, but the state root of the following blocks
+// depends on them.
+type Unmerkleized struct {
+	// EpochParticipation is the number of commits each validator signed in
+	// the current epoch.
+	EpochParticipation []uint64
+	// EpochProposals is the number of blocks each validator proposed in the
+	// current epoch.
+	EpochProposals []uint64
+	// EpochCommits is the number of commits recorded in the current epoch.
+	EpochCommits uint64
+	// InactivityScores is the inactivity score of each validator.
+	InactivityScores []uint64
+	// ValidatorSet is the voting power of each validator in the set last
+	// sent to CometBFT.
+	ValidatorSet map[math.ValidatorIndex]math.Gwei
+	// PendingConsolidations is the target validator index of each validator
+	// whose balance is waiting to be consolidated.
+	PendingConsolidations map[math.ValidatorIndex]math.ValidatorIndex
+	// ProposerSet is the set the proposer of the current block is selected
+	// from, nil if there is none.
+	ProposerSet *transition.ProposerSet
+	// NextProposerSet is the set the proposer of the next block is selected
+	// from, nil if there is none.
+	NextProposerSet *transition.ProposerSet
+}
+
+// NewUnmerkleized snapshots the fields of the state that are kept out of the
+// state root.
+func NewUnmerkleized(st UnmerkleizedState) (*Unmerkleized, error) {
+	numValidators, err := st.GetTotalValidators()
+	if err != nil {
+		return nil, err
+	}
+
+	u := &Unmerkleized{
+		EpochParticipation: make([]uint64, numValidators),
+		EpochProposals:     make([]uint64, numValidators),
+		InactivityScores:   make([]uint64, numValidators),
+	}
+	for i := range numValidators {
+		idx := math.ValidatorIndex(i)
+		var errs [3]error
+		u.EpochParticipation[i], errs[0] = st.GetEpochParticipation(idx)
+		u.EpochProposals[i], errs[1] = st.GetEpochProposals(idx)
+		u.InactivityScores[i], errs[2] = st.GetInactivityScore(idx)
+		if err = errors.Join(errs[:]...); err != nil {
+			return nil, err
+		}
+	}
+
+	var errs [5]error
+	u.EpochCommits, errs[0] = st.GetEpochCommits()
+	u.ValidatorSet, errs[1] = st.GetValidatorSet()
+	u.PendingConsolidations, errs[2] = st.GetPendingConsolidations()
+	u.ProposerSet, errs[3] = st.GetProposerSet()
+	u.NextProposerSet, errs[4] = st.GetNextProposerSet()
+	if err = errors.Join(errs[:]...); err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+// Diff returns the differences between the snapshot and other, field by
+// field. Fields keyed by validator index report one FieldDiff per differing
+// validator, indexed by the validator index.
+func (u *Unmerkleized) Diff(other *Unmerkleized) []types.FieldDiff {
+	var diffs []types.FieldDiff
+	diffs = diffValues(diffs, "EpochParticipation",
+		u.EpochParticipation, other.EpochParticipation)
+	diffs = diffValues(diffs, "EpochProposals",
+		u.EpochProposals, other.EpochProposals)
+	diffs = diffValue(diffs, "EpochCommits",
+		u.EpochCommits, other.EpochCommits)
+	diffs = diffValues(diffs, "InactivityScores",
+		u.InactivityScores, other.InactivityScores)
+	diffs = diffMaps(diffs, "ValidatorSet",
+		u.ValidatorSet, other.ValidatorSet)
+	diffs = diffMaps(diffs, "PendingConsolidations",
+		u.PendingConsolidations, other.PendingConsolidations)
+	diffs = diffProposerSets(diffs, "ProposerSet",
+		u.ProposerSet, other.ProposerSet)
+	return diffProposerSets(diffs, "NextProposerSet",
+		u.NextProposerSet, other.NextProposerSet)
+}
+
+// diffValue appends a FieldDiff for field if a and b differ.
+func diffValue[T comparable](
+	diffs []types.FieldDiff, field string, a, b T,
+) []types.FieldDiff {
+	if a == b {
+		return diffs
+	}
+	return append(diffs, types.FieldDiff{
+		Field: field, Index: noIndex, Old: a, New: b,
+	})
+}
+
+// diffValues appends a FieldDiff for every index at which a and b differ,
+// including the elements only present in one of them.
+func diffValues[T comparable](
+	diffs []types.FieldDiff, field string, a, b []T,
+) []types.FieldDiff {
+	for i := range max(len(a), len(b)) {
+		switch {
+		case i >= len(a):
+			diffs = append(diffs, types.FieldDiff{
+				Field: field, Index: i, New: b[i],
+			})
+		case i >= len(b):
+			diffs = append(diffs, types.FieldDiff{
+				Field: field, Index: i, Old: a[i],
+			})
+		case a[i] != b[i]:
+			diffs = append(diffs, types.FieldDiff{
+				Field: field, Index: i, Old: a[i], New: b[i],
+			})
+		}
+	}
+	return diffs
+}
+
+// diffMaps appends a FieldDiff for every validator index at which a and b
+// differ, in ascending order of index, including the validators only
+// present in one of them.
+func diffMaps[T comparable](
+	diffs []types.FieldDiff, field string, a, b map[math.ValidatorIndex]T,
+) []types.FieldDiff {
+	indices := slices.Sorted(maps.Keys(a))
+	for idx := range b {
+		if _, ok := a[idx]; !ok {
+			indices = append(indices, idx)
+		}
+	}
+	slices.Sort(indices)
+
+	for _, idx := range indices {
+		//#nosec:G115 // validator indices fit in an int.
+		diff := types.FieldDiff{Field: field, Index: int(idx)}
+		va, inA := a[idx]
+		vb, inB := b[idx]
+		if inA && inB && va == vb {
+			continue
+		}
+		if inA {
+			diff.Old = va
+		}
+		if inB {
+			diff.New = vb
+		}
+		diffs = append(diffs, diff)
+	}
+	return diffs
+}
+
+// diffProposerSets appends the FieldDiffs of the powers, priorities and
+// proposer of the proposer sets a and b, either of which may be nil.
+func diffProposerSets(
+	diffs []types.FieldDiff, field string, a, b *transition.ProposerSet,
+) []types.FieldDiff {
+	if a == nil {
+		a = new(transition.ProposerSet)
+	}
+	if b == nil {
+		b = new(transition.ProposerSet)
+	}
+	diffs = diffMaps(diffs, field+".Powers", a.Powers, b.Powers)
+	diffs = diffMaps(diffs, field+".Priorities", a.Priorities, b.Priorities)
+	return diffValue(diffs, field+".Proposer", a.Proposer, b.Proposer)
+}
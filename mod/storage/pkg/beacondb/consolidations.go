@@ -0,0 +1,69 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package beacondb
+
+import "github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+
+// GetPendingConsolidations returns the target validator index of each
+// validator whose balance is waiting to be consolidated.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) GetPendingConsolidations() (
+	map[math.ValidatorIndex]math.ValidatorIndex, error,
+) {
+	iter, err := kv.pendingConsolidations.Iterate(kv.ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	consolidations := make(map[math.ValidatorIndex]math.ValidatorIndex)
+	for ; iter.Valid(); iter.Next() {
+		entry, err := iter.KeyValue()
+		if err != nil {
+			return nil, err
+		}
+		consolidations[math.ValidatorIndex(entry.Key)] =
+			math.ValidatorIndex(entry.Value)
+	}
+	return consolidations, nil
+}
+
+// SetPendingConsolidations replaces the pending consolidations.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) SetPendingConsolidations(
+	consolidations map[math.ValidatorIndex]math.ValidatorIndex,
+) error {
+	if err := kv.pendingConsolidations.Clear(kv.ctx, nil); err != nil {
+		return err
+	}
+	for source, target := range consolidations {
+		if err := kv.pendingConsolidations.Set(
+			kv.ctx, source.Unwrap(), target.Unwrap(),
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
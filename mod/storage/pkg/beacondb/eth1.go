@@ -45,6 +45,7 @@ func (kv *KVStore[
 ]) SetLatestExecutionPayloadHeader(
 	payloadHeader ExecutionPayloadHeaderT,
 ) error {
+	kv.tree.markField(latestExecutionPayloadHeaderField)
 	if err := kv.latestExecutionPayloadVersion.Set(
 		kv.ctx, payloadHeader.Version(),
 	); err != nil {
@@ -69,6 +70,7 @@ func (kv *KVStore[
 ]) SetEth1DepositIndex(
 	index uint64,
 ) error {
+	kv.tree.markField(eth1DepositIndexField)
 	return kv.eth1DepositIndex.Set(kv.ctx, index)
 }
 
@@ -87,5 +89,6 @@ func (kv *KVStore[
 ]) SetEth1Data(
 	data Eth1DataT,
 ) error {
+	kv.tree.markField(eth1DataField)
 	return kv.eth1Data.Set(kv.ctx, data)
 }
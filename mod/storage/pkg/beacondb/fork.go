@@ -27,6 +27,7 @@ func (kv *KVStore[
 ]) SetFork(
 	fork ForkT,
 ) error {
+	kv.tree.markField(forkField)
 	return kv.fork.Set(kv.ctx, fork)
 }
 
@@ -30,6 +30,7 @@ func (kv *KVStore[
 	index uint64,
 	root common.Root,
 ) error {
+	kv.tree.markLeaf(blockRootsField, index)
 	return kv.blockRoots.Set(kv.ctx, index, root[:])
 }
 
@@ -54,6 +55,7 @@ func (kv *KVStore[
 ]) SetLatestBlockHeader(
 	header BeaconBlockHeaderT,
 ) error {
+	kv.tree.markField(latestBlockHeaderField)
 	return kv.latestBlockHeader.Set(kv.ctx, header)
 }
 
@@ -75,6 +77,7 @@ func (kv *KVStore[
 	idx uint64,
 	stateRoot common.Root,
 ) error {
+	kv.tree.markLeaf(stateRootsField, idx)
 	return kv.stateRoots.Set(kv.ctx, idx, stateRoot[:])
 }
 
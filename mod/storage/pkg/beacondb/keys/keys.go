@@ -44,6 +44,15 @@ const (
 	NextWithdrawalIndexPrefix
 	NextWithdrawalValidatorIndexPrefix
 	ForkPrefix
+	EpochParticipationPrefix
+	EpochProposalsPrefix
+	EpochCommitsPrefix
+	InactivityScoresPrefix
+	ValidatorSetPrefix
+	PendingConsolidationsPrefix
+	ProposerPowersPrefix
+	ProposerPrioritiesPrefix
+	ProposersPrefix
 )
 
 //nolint:lll
@@ -71,4 +80,13 @@ const (
 	NextWithdrawalIndexPrefixHumanReadable              = "NextWithdrawalIndexPrefix"
 	NextWithdrawalValidatorIndexPrefixHumanReadable     = "NextWithdrawalValidatorIndexPrefix"
 	ForkPrefixHumanReadable                             = "ForkPrefix"
+	EpochParticipationPrefixHumanReadable               = "EpochParticipationPrefix"
+	EpochProposalsPrefixHumanReadable                   = "EpochProposalsPrefix"
+	EpochCommitsPrefixHumanReadable                     = "EpochCommitsPrefix"
+	InactivityScoresPrefixHumanReadable                 = "InactivityScoresPrefix"
+	ValidatorSetPrefixHumanReadable                     = "ValidatorSetPrefix"
+	PendingConsolidationsPrefixHumanReadable            = "PendingConsolidationsPrefix"
+	ProposerPowersPrefixHumanReadable                   = "ProposerPowersPrefix"
+	ProposerPrioritiesPrefixHumanReadable               = "ProposerPrioritiesPrefix"
+	ProposersPrefixHumanReadable                        = "ProposersPrefix"
 )
@@ -37,20 +37,20 @@ import (
 type KVStore[
 	BeaconBlockHeaderT interface {
 		constraints.Empty[BeaconBlockHeaderT]
-		constraints.SSZMarshallable
+		constraints.SSZMarshallableRootable
 	},
 	Eth1DataT interface {
 		constraints.Empty[Eth1DataT]
-		constraints.SSZMarshallable
+		constraints.SSZMarshallableRootable
 	},
 	ExecutionPayloadHeaderT interface {
-		constraints.SSZMarshallable
+		constraints.SSZMarshallableRootable
 		NewFromSSZ([]byte, uint32) (ExecutionPayloadHeaderT, error)
 		Version() uint32
 	},
 	ForkT interface {
 		constraints.Empty[ForkT]
-		constraints.SSZMarshallable
+		constraints.SSZMarshallableRootable
 	},
 	ValidatorT Validator[ValidatorT],
 	ValidatorsT ~[]ValidatorT,
@@ -106,6 +106,43 @@ type KVStore[
 	slashings sdkcollections.Map[uint64, uint64]
 	// totalSlashing stores the total slashing in the vector range.
 	totalSlashing sdkcollections.Item[uint64]
+	// Participation
+	// epochParticipation stores the number of commits each validator signed
+	// in the current epoch.
+	epochParticipation sdkcollections.Map[uint64, uint64]
+	// epochProposals stores the number of blocks each validator proposed in
+	// the current epoch.
+	epochProposals sdkcollections.Map[uint64, uint64]
+	// epochCommits stores the number of commits recorded in the current
+	// epoch.
+	epochCommits sdkcollections.Item[uint64]
+	// inactivityScores stores the number of consecutive epochs in which each
+	// validator signed none of the commits.
+	inactivityScores sdkcollections.Map[uint64, uint64]
+	// Consensus
+	// validatorSet stores the voting power of each validator in the set last
+	// sent to CometBFT.
+	validatorSet sdkcollections.Map[uint64, uint64]
+	// pendingConsolidations stores the target validator index of each
+	// validator whose balance is waiting to be consolidated.
+	pendingConsolidations sdkcollections.Map[uint64, uint64]
+	// proposerPowers stores the voting power of each validator in the sets
+	// CometBFT selects block proposers from, keyed by set and index.
+	proposerPowers sdkcollections.Map[
+		sdkcollections.Pair[uint64, uint64], uint64,
+	]
+	// proposerPriorities stores the proposer priority of each validator in
+	// the sets CometBFT selects block proposers from, keyed by set and index.
+	proposerPriorities sdkcollections.Map[
+		sdkcollections.Pair[uint64, uint64], uint64,
+	]
+	// proposers stores the proposer selected by each of the sets.
+	proposers sdkcollections.Map[uint64, uint64]
+	// tree caches the merkle roots of the state held in the context.
+	tree *stateTree
+	// trees shares the tree of the state last merkleized with the stores
+	// created from this one.
+	trees *treeCache
 }
 
 // New creates a new instance of Store.
@@ -114,20 +151,20 @@ type KVStore[
 func New[
 	BeaconBlockHeaderT interface {
 		constraints.Empty[BeaconBlockHeaderT]
-		constraints.SSZMarshallable
+		constraints.SSZMarshallableRootable
 	},
 	Eth1DataT interface {
 		constraints.Empty[Eth1DataT]
-		constraints.SSZMarshallable
+		constraints.SSZMarshallableRootable
 	},
 	ExecutionPayloadHeaderT interface {
-		constraints.SSZMarshallable
+		constraints.SSZMarshallableRootable
 		NewFromSSZ([]byte, uint32) (ExecutionPayloadHeaderT, error)
 		Version() uint32
 	},
 	ForkT interface {
 		constraints.Empty[ForkT]
-		constraints.SSZMarshallable
+		constraints.SSZMarshallableRootable
 	},
 	ValidatorT Validator[ValidatorT],
 	ValidatorsT ~[]ValidatorT,
@@ -139,6 +176,7 @@ func New[
 	ForkT, ValidatorT, ValidatorsT,
 ] {
 	schemaBuilder := sdkcollections.NewSchemaBuilder(kss)
+	trees := new(treeCache)
 	return &KVStore[
 		BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
 		ForkT, ValidatorT, ValidatorsT,
@@ -267,6 +305,76 @@ func New[
 			keys.LatestBeaconBlockHeaderPrefixHumanReadable,
 			encoding.SSZValueCodec[BeaconBlockHeaderT]{},
 		),
+		epochParticipation: sdkcollections.NewMap(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte{keys.EpochParticipationPrefix}),
+			keys.EpochParticipationPrefixHumanReadable,
+			sdkcollections.Uint64Key,
+			sdkcollections.Uint64Value,
+		),
+		epochProposals: sdkcollections.NewMap(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte{keys.EpochProposalsPrefix}),
+			keys.EpochProposalsPrefixHumanReadable,
+			sdkcollections.Uint64Key,
+			sdkcollections.Uint64Value,
+		),
+		epochCommits: sdkcollections.NewItem(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte{keys.EpochCommitsPrefix}),
+			keys.EpochCommitsPrefixHumanReadable,
+			sdkcollections.Uint64Value,
+		),
+		inactivityScores: sdkcollections.NewMap(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte{keys.InactivityScoresPrefix}),
+			keys.InactivityScoresPrefixHumanReadable,
+			sdkcollections.Uint64Key,
+			sdkcollections.Uint64Value,
+		),
+		validatorSet: sdkcollections.NewMap(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte{keys.ValidatorSetPrefix}),
+			keys.ValidatorSetPrefixHumanReadable,
+			sdkcollections.Uint64Key,
+			sdkcollections.Uint64Value,
+		),
+		pendingConsolidations: sdkcollections.NewMap(
+			schemaBuilder,
+			sdkcollections.NewPrefix(
+				[]byte{keys.PendingConsolidationsPrefix},
+			),
+			keys.PendingConsolidationsPrefixHumanReadable,
+			sdkcollections.Uint64Key,
+			sdkcollections.Uint64Value,
+		),
+		proposerPowers: sdkcollections.NewMap(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte{keys.ProposerPowersPrefix}),
+			keys.ProposerPowersPrefixHumanReadable,
+			sdkcollections.PairKeyCodec(
+				sdkcollections.Uint64Key, sdkcollections.Uint64Key,
+			),
+			sdkcollections.Uint64Value,
+		),
+		proposerPriorities: sdkcollections.NewMap(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte{keys.ProposerPrioritiesPrefix}),
+			keys.ProposerPrioritiesPrefixHumanReadable,
+			sdkcollections.PairKeyCodec(
+				sdkcollections.Uint64Key, sdkcollections.Uint64Key,
+			),
+			sdkcollections.Uint64Value,
+		),
+		proposers: sdkcollections.NewMap(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte{keys.ProposersPrefix}),
+			keys.ProposersPrefixHumanReadable,
+			sdkcollections.Uint64Key,
+			sdkcollections.Uint64Value,
+		),
+		tree:  newStateTree(trees),
+		trees: trees,
 	}
 }
 
@@ -280,8 +388,12 @@ func (kv *KVStore[
 ] {
 	// TODO: Decouple the KVStore type from the Cosmos-SDK.
 	cctx, _ := sdk.UnwrapSDKContext(kv.ctx).CacheContext()
-	ss := kv.WithContext(cctx)
-	return ss
+	cpy := *kv
+	cpy.ctx = cctx
+	// The copy starts out holding the same state, so it can reuse the
+	// cached roots.
+	cpy.tree = kv.tree.Copy()
+	return &cpy
 }
 
 // Context returns the context of the Store.
@@ -292,7 +404,9 @@ func (kv *KVStore[
 	return kv.ctx
 }
 
-// WithContext returns a copy of the Store with the given context.
+// WithContext returns a copy of the Store with the given context. The copy
+// reuses the roots cached for the state last merkleized if the context holds
+// the same state.
 func (kv *KVStore[
 	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
 	ForkT, ValidatorT, ValidatorsT,
@@ -304,5 +418,15 @@ func (kv *KVStore[
 ] {
 	cpy := *kv
 	cpy.ctx = ctx
+	cpy.tree = nil
+	// TODO: Decouple the KVStore type from the Cosmos-SDK.
+	if _, ok := sdk.TryUnwrapSDKContext(ctx); ok {
+		if key, err := cpy.treeKey(); err == nil {
+			cpy.tree = kv.trees.load(key)
+		}
+	}
+	if cpy.tree == nil {
+		cpy.tree = newStateTree(kv.trees)
+	}
 	return &cpy
 }
@@ -0,0 +1,125 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package beacondb
+
+import (
+	"errors"
+
+	"cosmossdk.io/collections"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// GetEpochParticipation returns the number of commits the validator at the
+// given index signed in the current epoch.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) GetEpochParticipation(idx math.ValidatorIndex) (uint64, error) {
+	return getOrZero(kv.epochParticipation.Get(kv.ctx, idx.Unwrap()))
+}
+
+// SetEpochParticipation sets the number of commits the validator at the
+// given index signed in the current epoch.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) SetEpochParticipation(idx math.ValidatorIndex, count uint64) error {
+	return kv.epochParticipation.Set(kv.ctx, idx.Unwrap(), count)
+}
+
+// GetEpochProposals returns the number of blocks the validator at the given
+// index proposed in the current epoch.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) GetEpochProposals(idx math.ValidatorIndex) (uint64, error) {
+	return getOrZero(kv.epochProposals.Get(kv.ctx, idx.Unwrap()))
+}
+
+// SetEpochProposals sets the number of blocks the validator at the given
+// index proposed in the current epoch.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) SetEpochProposals(idx math.ValidatorIndex, count uint64) error {
+	return kv.epochProposals.Set(kv.ctx, idx.Unwrap(), count)
+}
+
+// GetEpochCommits returns the number of commits recorded in the current
+// epoch.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) GetEpochCommits() (uint64, error) {
+	return getOrZero(kv.epochCommits.Get(kv.ctx))
+}
+
+// SetEpochCommits sets the number of commits recorded in the current epoch.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) SetEpochCommits(count uint64) error {
+	return kv.epochCommits.Set(kv.ctx, count)
+}
+
+// ResetEpochParticipation clears the participation and proposals recorded in
+// the current epoch.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) ResetEpochParticipation() error {
+	if err := kv.epochParticipation.Clear(kv.ctx, nil); err != nil {
+		return err
+	}
+	if err := kv.epochProposals.Clear(kv.ctx, nil); err != nil {
+		return err
+	}
+	return kv.epochCommits.Set(kv.ctx, 0)
+}
+
+// GetInactivityScore returns the number of consecutive epochs in which the
+// validator at the given index signed none of the commits.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) GetInactivityScore(idx math.ValidatorIndex) (uint64, error) {
+	return getOrZero(kv.inactivityScores.Get(kv.ctx, idx.Unwrap()))
+}
+
+// SetInactivityScore sets the number of consecutive epochs in which the
+// validator at the given index signed none of the commits.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) SetInactivityScore(idx math.ValidatorIndex, score uint64) error {
+	if score == 0 {
+		return kv.inactivityScores.Remove(kv.ctx, idx.Unwrap())
+	}
+	return kv.inactivityScores.Set(kv.ctx, idx.Unwrap(), score)
+}
+
+// getOrZero returns zero instead of an error for missing values.
+func getOrZero(v uint64, err error) (uint64, error) {
+	if errors.Is(err, collections.ErrNotFound) {
+		return 0, nil
+	}
+	return v, err
+}
@@ -0,0 +1,154 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package beacondb
+
+import (
+	"errors"
+
+	"cosmossdk.io/collections"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+)
+
+const (
+	// currentProposerSet keys the set the proposer of the next block is
+	// selected from.
+	currentProposerSet uint64 = iota
+	// nextProposerSet keys the set that replaces the current set after the
+	// next block.
+	nextProposerSet
+)
+
+// GetProposerSet returns the set CometBFT selects the proposer of the next
+// block from, or nil if none is stored.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) GetProposerSet() (*transition.ProposerSet, error) {
+	return kv.getProposerSet(currentProposerSet)
+}
+
+// SetProposerSet replaces the set CometBFT selects the proposer of the next
+// block from.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) SetProposerSet(set *transition.ProposerSet) error {
+	return kv.setProposerSet(currentProposerSet, set)
+}
+
+// GetNextProposerSet returns the set that replaces the proposer set after
+// the next block, or nil if none is stored.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) GetNextProposerSet() (*transition.ProposerSet, error) {
+	return kv.getProposerSet(nextProposerSet)
+}
+
+// SetNextProposerSet replaces the set that replaces the proposer set after
+// the next block.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) SetNextProposerSet(set *transition.ProposerSet) error {
+	return kv.setProposerSet(nextProposerSet, set)
+}
+
+// getProposerSet reads the proposer set stored under the given key.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) getProposerSet(key uint64) (*transition.ProposerSet, error) {
+	proposer, err := kv.proposers.Get(kv.ctx, key)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	set := &transition.ProposerSet{
+		Powers:     make(map[math.ValidatorIndex]math.Gwei),
+		Priorities: make(map[math.ValidatorIndex]int64),
+		Proposer:   math.ValidatorIndex(proposer),
+	}
+	rng := collections.NewPrefixedPairRange[uint64, uint64](key)
+
+	powers, err := kv.proposerPowers.Iterate(kv.ctx, rng)
+	if err != nil {
+		return nil, err
+	}
+	defer powers.Close()
+	for ; powers.Valid(); powers.Next() {
+		entry, err := powers.KeyValue()
+		if err != nil {
+			return nil, err
+		}
+		set.Powers[math.ValidatorIndex(entry.Key.K2())] =
+			math.Gwei(entry.Value)
+	}
+
+	priorities, err := kv.proposerPriorities.Iterate(kv.ctx, rng)
+	if err != nil {
+		return nil, err
+	}
+	defer priorities.Close()
+	for ; priorities.Valid(); priorities.Next() {
+		entry, err := priorities.KeyValue()
+		if err != nil {
+			return nil, err
+		}
+		//#nosec:G701 // priorities are stored as their two's complement.
+		set.Priorities[math.ValidatorIndex(entry.Key.K2())] =
+			int64(entry.Value)
+	}
+	return set, nil
+}
+
+// setProposerSet replaces the proposer set stored under the given key.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) setProposerSet(key uint64, set *transition.ProposerSet) error {
+	rng := collections.NewPrefixedPairRange[uint64, uint64](key)
+	if err := kv.proposerPowers.Clear(kv.ctx, rng); err != nil {
+		return err
+	}
+	if err := kv.proposerPriorities.Clear(kv.ctx, rng); err != nil {
+		return err
+	}
+	for idx, power := range set.Powers {
+		if err := kv.proposerPowers.Set(
+			kv.ctx, collections.Join(key, idx.Unwrap()), power.Unwrap(),
+		); err != nil {
+			return err
+		}
+	}
+	for idx, priority := range set.Priorities {
+		//#nosec:G701 // priorities are stored as their two's complement.
+		if err := kv.proposerPriorities.Set(
+			kv.ctx, collections.Join(key, idx.Unwrap()), uint64(priority),
+		); err != nil {
+			return err
+		}
+	}
+	return kv.proposers.Set(kv.ctx, key, set.Proposer.Unwrap())
+}
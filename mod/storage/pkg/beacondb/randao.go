@@ -30,6 +30,7 @@ func (kv *KVStore[
 	index uint64,
 	mix common.Bytes32,
 ) error {
+	kv.tree.markLeaf(randaoMixesField, index)
 	return kv.randaoMix.Set(kv.ctx, index, mix[:])
 }
 
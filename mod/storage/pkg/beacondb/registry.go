@@ -40,10 +40,12 @@ func (kv *KVStore[
 	}
 
 	// Push onto the validators list.
+	kv.tree.markLeaf(validatorsField, idx)
 	if err = kv.validators.Set(kv.ctx, idx, val); err != nil {
 		return err
 	}
 
+	kv.tree.markLeaf(balancesField, idx)
 	return kv.balances.Set(kv.ctx, idx, 0)
 }
 
@@ -59,11 +61,13 @@ func (kv *KVStore[
 	}
 
 	// Push onto the validators list.
+	kv.tree.markLeaf(validatorsField, idx)
 	if err = kv.validators.Set(kv.ctx, idx, val); err != nil {
 		return err
 	}
 
 	// Push onto the balances list.
+	kv.tree.markLeaf(balancesField, idx)
 	return kv.balances.Set(kv.ctx, idx, val.GetEffectiveBalance().Unwrap())
 }
 
@@ -75,6 +79,7 @@ func (kv *KVStore[
 	index math.ValidatorIndex,
 	val ValidatorT,
 ) error {
+	kv.tree.markLeaf(validatorsField, index.Unwrap())
 	return kv.validators.Set(kv.ctx, index.Unwrap(), val)
 }
 
@@ -233,6 +238,7 @@ func (kv *KVStore[
 	idx math.ValidatorIndex,
 	balance math.Gwei,
 ) error {
+	kv.tree.markLeaf(balancesField, idx.Unwrap())
 	return kv.balances.Set(kv.ctx, idx.Unwrap(), balance.Unwrap())
 }
 
@@ -206,7 +206,7 @@ func initTestStore() (
 		*types.Validator,
 		[]*types.Validator,
 	], error) {
-	db, err := db.OpenDB("", dbm.MemDBBackend)
+	db, err := db.OpenDB("", db.Config{Backend: dbm.MemDBBackend})
 	if err != nil {
 		return nil, fmt.Errorf("failed opening mem db: %w", err)
 	}
@@ -0,0 +1,239 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package beacondb
+
+import (
+	"encoding/binary"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/merkle"
+)
+
+// errUnknownStateField is returned when the root of a field that is not part
+// of the beacon state is requested.
+var errUnknownStateField = errors.New("unknown beacon state field")
+
+// CachedHashTreeRoot returns the hash tree root of the beacon state held by
+// the store, whose block roots, state roots and randao mixes hold the given
+// number of entries. Only the fields and list leaves written through the
+// store since the last call are read back and rehashed, and the resulting
+// tree is shared with the stores later created over the same state.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) CachedHashTreeRoot(
+	historicalRoots, historicalMixes uint64,
+) (common.Root, error) {
+	t := kv.tree
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for field, dirty := range t.dirty {
+		if !dirty {
+			continue
+		}
+		root, err := kv.fieldRoot(field, historicalRoots, historicalMixes)
+		if err != nil {
+			return common.Root{}, err
+		}
+		t.roots[field], t.dirty[field] = root, false
+	}
+
+	tree, err := merkle.NewTreeFromLeavesWithDepth(t.roots[:], stateDepth)
+	if err != nil {
+		return common.Root{}, err
+	}
+	t.share()
+	return tree.Root(), nil
+}
+
+// treeKey returns the key of the state held by the store in the cache of
+// the trees.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) treeKey() (treeKey, error) {
+	slot, err := kv.fieldRoot(slotField, 0, 0)
+	if err != nil {
+		return treeKey{}, err
+	}
+	header, err := kv.fieldRoot(latestBlockHeaderField, 0, 0)
+	if err != nil {
+		return treeKey{}, err
+	}
+	return treeKey{slot, header}, nil
+}
+
+// fieldRoot computes the hash tree root of the given beacon state field.
+//
+//nolint:funlen,gocognit,cyclop // one case per field.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) fieldRoot(
+	field int,
+	historicalRoots, historicalMixes uint64,
+) (common.Root, error) {
+	t := kv.tree
+	switch field {
+	case genesisValidatorsRootField:
+		return kv.GetGenesisValidatorsRoot()
+	case slotField:
+		slot, err := kv.GetSlot()
+		return uint64Root(slot.Unwrap()), err
+	case forkField:
+		fork, err := kv.GetFork()
+		if err != nil {
+			return common.Root{}, err
+		}
+		return fork.HashTreeRoot(), nil
+	case latestBlockHeaderField:
+		header, err := kv.GetLatestBlockHeader()
+		if err != nil {
+			return common.Root{}, err
+		}
+		return header.HashTreeRoot(), nil
+	case blockRootsField:
+		root, err := t.blockRoots.Root(historicalRoots, kv.GetBlockRootAtIndex)
+		return mixInLength(root, historicalRoots), err
+	case stateRootsField:
+		root, err := t.stateRoots.Root(historicalRoots, kv.StateRootAtIndex)
+		return mixInLength(root, historicalRoots), err
+	case eth1DataField:
+		eth1Data, err := kv.GetEth1Data()
+		if err != nil {
+			return common.Root{}, err
+		}
+		return eth1Data.HashTreeRoot(), nil
+	case eth1DepositIndexField:
+		index, err := kv.GetEth1DepositIndex()
+		return uint64Root(index), err
+	case latestExecutionPayloadHeaderField:
+		header, err := kv.GetLatestExecutionPayloadHeader()
+		if err != nil {
+			return common.Root{}, err
+		}
+		return header.HashTreeRoot(), nil
+	case validatorsField:
+		numValidators, err := kv.validatorIndex.Peek(kv.ctx)
+		if err != nil {
+			return common.Root{}, err
+		}
+		root, err := t.validators.Root(numValidators, kv.validatorRoot)
+		return mixInLength(root, numValidators), err
+	case balancesField:
+		// Every validator is registered along with its balance.
+		numBalances, err := kv.validatorIndex.Peek(kv.ctx)
+		if err != nil {
+			return common.Root{}, err
+		}
+		root, err := t.balances.Root(
+			numChunks(numBalances),
+			func(chunk uint64) (common.Root, error) {
+				return kv.packedChunk(chunk, numBalances, kv.GetBalance)
+			},
+		)
+		return mixInLength(root, numBalances), err
+	case randaoMixesField:
+		root, err := t.randaoMixes.Root(
+			historicalMixes,
+			func(index uint64) (common.Root, error) {
+				mix, err := kv.GetRandaoMixAtIndex(index)
+				return common.Root(mix), err
+			},
+		)
+		return mixInLength(root, historicalMixes), err
+	case nextWithdrawalIndexField:
+		index, err := kv.GetNextWithdrawalIndex()
+		return uint64Root(index), err
+	case nextWithdrawalValidatorIndexField:
+		index, err := kv.GetNextWithdrawalValidatorIndex()
+		return uint64Root(index.Unwrap()), err
+	case slashingsField:
+		// Slashings are stored sparsely, so the list is rebuilt in full
+		// whenever one of them is written.
+		slashings, err := kv.GetSlashings()
+		if err != nil {
+			return common.Root{}, err
+		}
+		//#nosec:G115 // the number of slashings is never negative.
+		numSlashings := uint64(len(slashings))
+		t.slashings.Invalidate()
+		root, err := t.slashings.Root(
+			numChunks(numSlashings),
+			func(chunk uint64) (common.Root, error) {
+				var leaf common.Root
+				for i := range uint64(uint64sPerChunk) {
+					if idx := chunk*uint64sPerChunk + i; idx < numSlashings {
+						binary.LittleEndian.PutUint64(
+							leaf[i*8:], slashings[idx].Unwrap(),
+						)
+					}
+				}
+				return leaf, nil
+			},
+		)
+		return mixInLength(root, numSlashings), err
+	case totalSlashingField:
+		total, err := kv.GetTotalSlashing()
+		return uint64Root(total.Unwrap()), err
+	default:
+		return common.Root{}, errUnknownStateField
+	}
+}
+
+// validatorRoot returns the hash tree root of the validator at the given
+// index.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) validatorRoot(index uint64) (common.Root, error) {
+	val, err := kv.validators.Get(kv.ctx, index)
+	if err != nil {
+		return common.Root{}, err
+	}
+	return val.HashTreeRoot(), nil
+}
+
+// packedChunk returns the given chunk of a list of n uint64s, read with get.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) packedChunk(
+	chunk, n uint64,
+	get func(math.ValidatorIndex) (math.Gwei, error),
+) (common.Root, error) {
+	var leaf common.Root
+	for i := range uint64(uint64sPerChunk) {
+		idx := chunk*uint64sPerChunk + i
+		if idx >= n {
+			break
+		}
+		v, err := get(math.ValidatorIndex(idx))
+		if err != nil {
+			return common.Root{}, err
+		}
+		binary.LittleEndian.PutUint64(leaf[i*8:], v.Unwrap())
+	}
+	return leaf, nil
+}
@@ -74,6 +74,7 @@ func (kv *KVStore[
 	index uint64,
 	amount math.Gwei,
 ) error {
+	kv.tree.markField(slashingsField)
 	return kv.slashings.Set(kv.ctx, index, amount.Unwrap())
 }
 
@@ -98,5 +99,6 @@ func (kv *KVStore[
 ]) SetTotalSlashing(
 	amount math.Gwei,
 ) error {
+	kv.tree.markField(totalSlashingField)
 	return kv.totalSlashing.Set(kv.ctx, amount.Unwrap())
 }
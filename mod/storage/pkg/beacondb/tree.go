@@ -0,0 +1,389 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package beacondb
+
+import (
+	"encoding/binary"
+	"slices"
+	"sync"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto/sha256"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/merkle"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/merkle/zero"
+)
+
+// The indices of the fields of the beacon state container, in the order
+// they are merkleized.
+const (
+	genesisValidatorsRootField = iota
+	slotField
+	forkField
+	latestBlockHeaderField
+	blockRootsField
+	stateRootsField
+	eth1DataField
+	eth1DepositIndexField
+	latestExecutionPayloadHeaderField
+	validatorsField
+	balancesField
+	randaoMixesField
+	nextWithdrawalIndexField
+	nextWithdrawalValidatorIndexField
+	slashingsField
+	totalSlashingField
+	numStateFields
+)
+
+// The depths of the merkle trees backing the beacon state, derived from the
+// list limits of the beacon state SSZ schema.
+const (
+	// stateDepth is the depth of the beacon state container.
+	stateDepth = 4
+	// historicalRootsDepth is the depth of the block and state roots lists,
+	// which are limited to 8192 roots.
+	historicalRootsDepth = 13
+	// randaoMixesDepth is the depth of the randao mixes list, which is
+	// limited to 65536 mixes.
+	randaoMixesDepth = 16
+	// validatorsDepth is the depth of the validators list, which is limited
+	// to 2^40 validators.
+	validatorsDepth = 40
+	// packedUint64sDepth is the depth of the balances and slashings lists,
+	// which hold up to 2^40 uint64s packed four to a chunk.
+	packedUint64sDepth = 38
+	// uint64sPerChunk is the number of uint64s packed into a single chunk.
+	uint64sPerChunk = 4
+)
+
+// stateTree caches the field roots of the beacon state, along with the
+// merkle trees of its lists, so that the hash tree root of the state can be
+// recomputed from the fields and leaves written since the last computation.
+// The cache is only valid for the context of the store it belongs to, and
+// is shared with the stores created later over the same state through a
+// treeCache.
+type stateTree struct {
+	mu sync.Mutex
+	// cache is the treeCache the tree is shared through.
+	cache *treeCache
+	// detached reports whether the tree belongs to a copy of the state,
+	// whose writes leave the state of the context untouched.
+	detached bool
+	// roots are the cached roots of the fields of the beacon state.
+	roots [numStateFields]common.Root
+	// dirty marks the fields whose cached root is stale.
+	dirty [numStateFields]bool
+	// blockRoots is the tree of the block roots.
+	blockRoots *listTree
+	// stateRoots is the tree of the state roots.
+	stateRoots *listTree
+	// validators is the tree of the validator roots.
+	validators *listTree
+	// balances is the tree of the packed balances.
+	balances *listTree
+	// randaoMixes is the tree of the randao mixes.
+	randaoMixes *listTree
+	// slashings is the tree of the packed slashings.
+	slashings *listTree
+}
+
+// newStateTree returns a stateTree with every field marked as dirty.
+func newStateTree(cache *treeCache) *stateTree {
+	t := &stateTree{
+		cache:       cache,
+		blockRoots:  newListTree(historicalRootsDepth),
+		stateRoots:  newListTree(historicalRootsDepth),
+		validators:  newListTree(validatorsDepth),
+		balances:    newListTree(packedUint64sDepth),
+		randaoMixes: newListTree(randaoMixesDepth),
+		slashings:   newListTree(packedUint64sDepth),
+	}
+	for i := range t.dirty {
+		t.dirty[i] = true
+	}
+	return t
+}
+
+// Copy returns a deep copy of the stateTree.
+func (t *stateTree) Copy() *stateTree {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.copy()
+}
+
+// copy returns a deep copy of the stateTree, detached from the state of the
+// context. It must be called with the lock held.
+func (t *stateTree) copy() *stateTree {
+	return &stateTree{
+		cache:       t.cache,
+		detached:    true,
+		roots:       t.roots,
+		dirty:       t.dirty,
+		blockRoots:  t.blockRoots.Copy(),
+		stateRoots:  t.stateRoots.Copy(),
+		validators:  t.validators.Copy(),
+		balances:    t.balances.Copy(),
+		randaoMixes: t.randaoMixes.Copy(),
+		slashings:   t.slashings.Copy(),
+	}
+}
+
+// markField marks the root of the given field as stale.
+func (t *stateTree) markField(field int) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.unshare()
+	t.dirty[field] = true
+}
+
+// markLeaf marks the root of the given list field as stale, along with the
+// leaf at the given index of its tree.
+func (t *stateTree) markLeaf(field int, index uint64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.unshare()
+	t.dirty[field] = true
+	switch field {
+	case blockRootsField:
+		t.blockRoots.Mark(index)
+	case stateRootsField:
+		t.stateRoots.Mark(index)
+	case validatorsField:
+		t.validators.Mark(index)
+	case balancesField:
+		t.balances.Mark(index / uint64sPerChunk)
+	case randaoMixesField:
+		t.randaoMixes.Mark(index)
+	}
+}
+
+// share caches a copy of the tree for the stores created later over the
+// same state, it must be called with the lock held and every field clean.
+func (t *stateTree) share() {
+	t.cache.store(
+		treeKey{t.roots[slotField], t.roots[latestBlockHeaderField]}, t,
+	)
+}
+
+// unshare drops the cached trees once the state of the context is written
+// to, since one of them may have been computed on it. It must be called
+// with the lock held.
+func (t *stateTree) unshare() {
+	if !t.detached {
+		t.cache.clear()
+	}
+}
+
+// treeCacheSize is the number of trees held by a treeCache, enough for the
+// state last committed along with the states proposed on top of it.
+const treeCacheSize = 4
+
+// treeKey identifies the state a cached tree was computed on by the roots
+// of its slot and latest block header, since the blocks processed up to the
+// slot determine the rest of the state.
+type treeKey [2]common.Root
+
+// cachedTree is a tree held by a treeCache.
+type cachedTree struct {
+	// key identifies the state the tree was computed on.
+	key treeKey
+	// tree is a copy of the tree computed on the state.
+	tree *stateTree
+}
+
+// treeCache holds copies of the trees of the states merkleized last, so
+// that the stores created over a context holding one of them start out from
+// its roots rather than from scratch. The trees are dropped whenever the
+// state of a context is written to, while writes to the copies of a state
+// leave them in place.
+type treeCache struct {
+	mu sync.Mutex
+	// entries holds the cached trees, from the least to the most recently
+	// used.
+	entries []cachedTree
+}
+
+// store caches a copy of the given tree, computed on the state identified
+// by key, unless a tree is already cached for that state. The tree must be
+// locked by the caller.
+func (c *treeCache) store(key treeKey, t *stateTree) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, entry := range c.entries {
+		if entry.key == key {
+			return
+		}
+	}
+	if len(c.entries) == treeCacheSize {
+		c.entries = slices.Delete(c.entries, 0, 1)
+	}
+	c.entries = append(c.entries, cachedTree{key: key, tree: t.copy()})
+}
+
+// clear drops every cached tree.
+func (c *treeCache) clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	clear(c.entries)
+	c.entries = c.entries[:0]
+}
+
+// load returns a copy of the tree cached for the state identified by key,
+// or nil if there is none.
+func (c *treeCache) load(key treeKey) *stateTree {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for i, entry := range c.entries {
+		if entry.key == key {
+			c.entries = append(slices.Delete(c.entries, i, i+1), entry)
+			tree := entry.tree.Copy()
+			tree.detached = false
+			return tree
+		}
+	}
+	return nil
+}
+
+// listTree is an incrementally updated merkle tree of the leaves of an SSZ
+// list.
+type listTree struct {
+	depth uint8
+	// tree is the merkle tree of the leaves, it is nil until the list is
+	// first merkleized with at least one leaf.
+	tree *merkle.Tree[common.Root]
+	// numLeaves is the number of leaves in the tree.
+	numLeaves uint64
+	// dirty holds the indices of the leaves written since the tree was last
+	// updated.
+	dirty map[uint64]struct{}
+}
+
+// newListTree returns an empty listTree of the given depth.
+func newListTree(depth uint8) *listTree {
+	return &listTree{
+		depth: depth,
+		dirty: make(map[uint64]struct{}),
+	}
+}
+
+// Copy returns a deep copy of the listTree.
+func (l *listTree) Copy() *listTree {
+	cpy := &listTree{
+		depth:     l.depth,
+		numLeaves: l.numLeaves,
+		dirty:     make(map[uint64]struct{}, len(l.dirty)),
+	}
+	if l.tree != nil {
+		cpy.tree = l.tree.Copy()
+	}
+	for idx := range l.dirty {
+		cpy.dirty[idx] = struct{}{}
+	}
+	return cpy
+}
+
+// Mark marks the leaf at the given index as stale.
+func (l *listTree) Mark(index uint64) {
+	if l.tree != nil {
+		l.dirty[index] = struct{}{}
+	}
+}
+
+// Invalidate drops the tree, so that it is rebuilt from every leaf the next
+// time it is merkleized.
+func (l *listTree) Invalidate() {
+	l.tree = nil
+	l.numLeaves = 0
+	clear(l.dirty)
+}
+
+// Root returns the root of the tree once updated to hold numLeaves leaves,
+// fetching the leaves that are stale or new with leafAt.
+func (l *listTree) Root(
+	numLeaves uint64,
+	leafAt func(uint64) (common.Root, error),
+) (common.Root, error) {
+	if numLeaves == 0 {
+		l.Invalidate()
+		return zero.Hashes[l.depth], nil
+	}
+
+	// The tree can only grow, so it is rebuilt if the list shrank.
+	if l.tree == nil || numLeaves < l.numLeaves {
+		leaves := make([]common.Root, numLeaves)
+		for i := range numLeaves {
+			leaf, err := leafAt(i)
+			if err != nil {
+				return common.Root{}, err
+			}
+			leaves[i] = leaf
+		}
+		tree, err := merkle.NewTreeFromLeavesWithDepth(leaves, l.depth)
+		if err != nil {
+			return common.Root{}, err
+		}
+		l.tree, l.numLeaves = tree, numLeaves
+		clear(l.dirty)
+		return tree.Root(), nil
+	}
+
+	// New leaves must be inserted in ascending order after the stale ones.
+	indices := make([]uint64, 0, len(l.dirty))
+	for idx := range l.dirty {
+		if idx < l.numLeaves {
+			indices = append(indices, idx)
+		}
+	}
+	slices.Sort(indices)
+	for idx := l.numLeaves; idx < numLeaves; idx++ {
+		indices = append(indices, idx)
+	}
+	for _, idx := range indices {
+		leaf, err := leafAt(idx)
+		if err != nil {
+			return common.Root{}, err
+		}
+		//#nosec:G115 // the index is bounded by the depth of the tree.
+		if err = l.tree.Insert(leaf, int(idx)); err != nil {
+			return common.Root{}, err
+		}
+	}
+	l.numLeaves = numLeaves
+	clear(l.dirty)
+	return l.tree.Root(), nil
+}
+
+// uint64Root returns the hash tree root of a uint64.
+func uint64Root(v uint64) common.Root {
+	var root common.Root
+	binary.LittleEndian.PutUint64(root[:], v)
+	return root
+}
+
+// mixInLength mixes the length of a list into the root of its leaves.
+func mixInLength(root common.Root, length uint64) common.Root {
+	return merkle.NewHasher[common.Root](sha256.Hash).MixIn(root, length)
+}
+
+// numChunks returns the number of chunks needed to pack n uint64s.
+func numChunks(n uint64) uint64 {
+	return (n + uint64sPerChunk - 1) / uint64sPerChunk
+}
@@ -29,7 +29,7 @@ import (
 // Validator represents an interface for a validator in the beacon chain.
 type Validator[SelfT any] interface {
 	constraints.Empty[SelfT]
-	constraints.SSZMarshallable
+	constraints.SSZMarshallableRootable
 	// GetPubkey returns the BLS public key of the validator.
 	GetPubkey() crypto.BLSPubkey
 	// GetEffectiveBalance returns the effective balance of the validator in
@@ -0,0 +1,64 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package beacondb
+
+import "github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+
+// GetValidatorSet returns the voting power of each validator in the set last
+// sent to CometBFT.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) GetValidatorSet() (map[math.ValidatorIndex]math.Gwei, error) {
+	iter, err := kv.validatorSet.Iterate(kv.ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	set := make(map[math.ValidatorIndex]math.Gwei)
+	for ; iter.Valid(); iter.Next() {
+		entry, err := iter.KeyValue()
+		if err != nil {
+			return nil, err
+		}
+		set[math.ValidatorIndex(entry.Key)] = math.Gwei(entry.Value)
+	}
+	return set, nil
+}
+
+// SetValidatorSet replaces the validator set last sent to CometBFT.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) SetValidatorSet(set map[math.ValidatorIndex]math.Gwei) error {
+	if err := kv.validatorSet.Clear(kv.ctx, nil); err != nil {
+		return err
+	}
+	for idx, power := range set {
+		if err := kv.validatorSet.Set(
+			kv.ctx, idx.Unwrap(), power.Unwrap(),
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
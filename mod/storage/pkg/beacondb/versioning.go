@@ -33,6 +33,7 @@ func (kv *KVStore[
 ]) SetGenesisValidatorsRoot(
 	root common.Root,
 ) error {
+	kv.tree.markField(genesisValidatorsRootField)
 	return kv.genesisValidatorsRoot.Set(kv.ctx, root[:])
 }
 
@@ -65,5 +66,6 @@ func (kv *KVStore[
 ]) SetSlot(
 	slot math.Slot,
 ) error {
+	kv.tree.markField(slotField)
 	return kv.slot.Set(kv.ctx, slot.Unwrap())
 }
@@ -37,6 +37,7 @@ func (kv *KVStore[
 ]) SetNextWithdrawalIndex(
 	index uint64,
 ) error {
+	kv.tree.markField(nextWithdrawalIndexField)
 	return kv.nextWithdrawalIndex.Set(kv.ctx, index)
 }
 
@@ -58,5 +59,6 @@ func (kv *KVStore[
 ]) SetNextWithdrawalValidatorIndex(
 	index math.ValidatorIndex,
 ) error {
+	kv.tree.markField(nextWithdrawalValidatorIndexField)
 	return kv.nextWithdrawalValidatorIndex.Set(kv.ctx, index.Unwrap())
 }
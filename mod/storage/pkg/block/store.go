@@ -73,6 +73,18 @@ func NewStore[BeaconBlockT BeaconBlock](
 	}
 }
 
+// Resize changes the availability window of the store, evicting the oldest
+// entries if the window shrinks.
+func (kv *KVStore[BeaconBlockT]) Resize(availabilityWindow int) {
+	evicted := kv.blockRoots.Resize(availabilityWindow)
+	kv.timestamps.Resize(availabilityWindow)
+	kv.stateRoots.Resize(availabilityWindow)
+	kv.logger.Info(
+		"Block store availability window updated",
+		"availability_window", availabilityWindow, "evicted", evicted,
+	)
+}
+
 // Set sets the block by a given index in the store, storing the block root,
 // timestamp, and state root. Only this function may potentially evict
 // entries from the store if the availability window is reached.
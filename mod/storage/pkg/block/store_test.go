@@ -86,3 +86,29 @@ func TestBlockStore(t *testing.T) {
 	_, err = blockStore.GetParentSlotByTimestamp(2)
 	require.ErrorContains(t, err, "not found")
 }
+
+func TestBlockStore_Resize(t *testing.T) {
+	blockStore := block.NewStore[*MockBeaconBlock](noop.NewLogger[any](), 5)
+	for i := 1; i <= 5; i++ {
+		require.NoError(t, blockStore.Set(&MockBeaconBlock{slot: math.Slot(i)}))
+	}
+
+	// Shrinking the window evicts the oldest blocks.
+	blockStore.Resize(2)
+	_, err := blockStore.GetSlotByBlockRoot([32]byte{byte(3)})
+	require.ErrorContains(t, err, "not found")
+	slot, err := blockStore.GetSlotByStateRoot([32]byte{byte(5)})
+	require.NoError(t, err)
+	require.Equal(t, math.Slot(5), slot)
+
+	// Growing the window retains new blocks.
+	blockStore.Resize(4)
+	for i := 6; i <= 7; i++ {
+		require.NoError(t, blockStore.Set(&MockBeaconBlock{slot: math.Slot(i)}))
+	}
+	for i := math.Slot(4); i <= 7; i++ {
+		slot, err = blockStore.GetSlotByBlockRoot([32]byte{byte(i)})
+		require.NoError(t, err)
+		require.Equal(t, i, slot)
+	}
+}
@@ -0,0 +1,138 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package db
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/spf13/viper"
+)
+
+const (
+	// BlobStoreFile stores every blob sidecar in its own file.
+	BlobStoreFile = "file"
+	// BlobStoreKV stores blob sidecars in a single key-value database.
+	BlobStoreKV = "kv"
+
+	// configKey is the key of the storage configuration in app.toml.
+	configKey = "beacon-kit.storage"
+)
+
+const (
+	defaultCacheSize                = 8
+	defaultWriteBufferSize          = 4
+	defaultMaxConcurrentCompactions = 3
+)
+
+//nolint:gochecknoglobals // the backends a node can run on.
+var backends = []dbm.BackendType{
+	dbm.PebbleDBBackend, dbm.GoLevelDBBackend, dbm.MemDBBackend,
+}
+
+// Config is the configuration of the databases of the node.
+type Config struct {
+	// Backend is the database backend of the application state.
+	Backend dbm.BackendType `mapstructure:"backend"`
+	// CacheSize is the size in MiB of the block cache.
+	CacheSize int `mapstructure:"cache-size"`
+	// WriteBufferSize is the size in MiB of the memtable writes are
+	// buffered in before being flushed to disk.
+	WriteBufferSize int `mapstructure:"write-buffer-size"`
+	// MaxOpenFiles is the maximum number of files kept open, 0 for the
+	// default of the backend.
+	MaxOpenFiles int `mapstructure:"max-open-files"`
+	// MaxConcurrentCompactions is the maximum number of compactions run at
+	// once. Only used by pebbledb.
+	MaxConcurrentCompactions int `mapstructure:"max-concurrent-compactions"`
+	// DisableAutomaticCompactions disables background compactions. Only
+	// used by pebbledb.
+	DisableAutomaticCompactions bool `mapstructure:"disable-automatic-compactions"`
+	// BlobStore is the layout of the blob availability store, either "file"
+	// or "kv".
+	BlobStore string `mapstructure:"blob-store"`
+}
+
+// DefaultConfig returns the default configuration of the databases.
+func DefaultConfig() Config {
+	return Config{
+		Backend:                  dbm.PebbleDBBackend,
+		CacheSize:                defaultCacheSize,
+		WriteBufferSize:          defaultWriteBufferSize,
+		MaxConcurrentCompactions: defaultMaxConcurrentCompactions,
+		BlobStore:                BlobStoreFile,
+	}
+}
+
+// Validate returns an error describing every invalid field of the
+// configuration.
+func (c Config) Validate() error {
+	var errs []error
+	if !slices.Contains(backends, c.Backend) {
+		errs = append(errs, fmt.Errorf(
+			"backend must be one of %v, got %q", backends, c.Backend,
+		))
+	}
+	if c.CacheSize <= 0 {
+		errs = append(errs, fmt.Errorf(
+			"cache-size must be greater than zero, got %d", c.CacheSize,
+		))
+	}
+	if c.WriteBufferSize <= 0 {
+		errs = append(errs, fmt.Errorf(
+			"write-buffer-size must be greater than zero, got %d",
+			c.WriteBufferSize,
+		))
+	}
+	if c.MaxOpenFiles < 0 {
+		errs = append(errs, fmt.Errorf(
+			"max-open-files must not be negative, got %d", c.MaxOpenFiles,
+		))
+	}
+	if c.MaxConcurrentCompactions <= 0 {
+		errs = append(errs, fmt.Errorf(
+			"max-concurrent-compactions must be greater than zero, got %d",
+			c.MaxConcurrentCompactions,
+		))
+	}
+	if c.BlobStore != BlobStoreFile && c.BlobStore != BlobStoreKV {
+		errs = append(errs, fmt.Errorf(
+			"blob-store must be one of [%s %s], got %q",
+			BlobStoreFile, BlobStoreKV, c.BlobStore,
+		))
+	}
+	return errors.Join(errs...)
+}
+
+// ReadConfig reads the storage configuration from app.toml, as loaded in
+// the given viper instance.
+func ReadConfig(v *viper.Viper) (Config, error) {
+	cfg := DefaultConfig()
+	if err := v.UnmarshalKey(configKey, &cfg); err != nil {
+		return cfg, err
+	}
+	if err := cfg.Validate(); err != nil {
+		return cfg, fmt.Errorf("invalid %s: %w", configKey, err)
+	}
+	return cfg, nil
+}
@@ -21,13 +21,62 @@
 package db
 
 import (
+	"fmt"
 	"path/filepath"
 
 	dbm "github.com/cosmos/cosmos-db"
+	"github.com/syndtr/goleveldb/leveldb/filter"
+	"github.com/syndtr/goleveldb/leveldb/opt"
 )
 
-// OpenDB opens the application database using the appropriate driver.
-func OpenDB(rootDir string, backendType dbm.BackendType) (dbm.DB, error) {
-	dataDir := filepath.Join(rootDir, "data")
-	return dbm.NewDB("application", backendType, dataDir)
+const (
+	// mib is the number of bytes in a MiB.
+	mib = 1 << 20
+	// bloomFilterBits is the number of bits per key of the bloom filter of
+	// goleveldb.
+	bloomFilterBits = 10
+)
+
+// OpenDB opens the application database using the backend and tuning of the
+// given configuration.
+func OpenDB(rootDir string, cfg Config) (dbm.DB, error) {
+	return NewDB("application", filepath.Join(rootDir, "data"), cfg)
+}
+
+// OpenDBReadOnly opens the application database like OpenDB, but in
+// read-only mode, so that it can be inspected without being modified.
+func OpenDBReadOnly(rootDir string, cfg Config) (dbm.DB, error) {
+	return newDB("application", filepath.Join(rootDir, "data"), cfg, true)
+}
+
+// NewDB opens the database of the given name in dir using the backend and
+// tuning of the given configuration.
+func NewDB(name string, dir string, cfg Config) (dbm.DB, error) {
+	return newDB(name, dir, cfg, false)
+}
+
+// newDB opens the database of the given name in dir, in read-only mode if
+// readOnly is set.
+func newDB(
+	name string,
+	dir string,
+	cfg Config,
+	readOnly bool,
+) (dbm.DB, error) {
+	switch cfg.Backend {
+	case dbm.PebbleDBBackend:
+		return newPebbleDB(name, dir, cfg, readOnly)
+	case dbm.GoLevelDBBackend:
+		return dbm.NewGoLevelDBWithOpts(name, dir, &opt.Options{
+			BlockCacheCapacity:     cfg.CacheSize * mib,
+			WriteBuffer:            cfg.WriteBufferSize * mib,
+			OpenFilesCacheCapacity: cfg.MaxOpenFiles,
+			Filter:                 filter.NewBloomFilter(bloomFilterBits),
+			ReadOnly:               readOnly,
+		})
+	case dbm.MemDBBackend:
+		return dbm.NewMemDB(), nil
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnsupportedBackend, cfg.Backend)
+	}
 }
@@ -0,0 +1,169 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package db_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/storage/pkg/db"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewDB(t *testing.T) {
+	for _, backend := range []dbm.BackendType{
+		dbm.PebbleDBBackend, dbm.GoLevelDBBackend, dbm.MemDBBackend,
+	} {
+		t.Run(string(backend), func(t *testing.T) {
+			cfg := db.DefaultConfig()
+			cfg.Backend = backend
+			store, err := db.NewDB("test", t.TempDir(), cfg)
+			require.NoError(t, err)
+			defer store.Close()
+
+			for _, key := range []string{"a", "b", "c", "d"} {
+				require.NoError(t, store.Set([]byte(key), []byte("v"+key)))
+			}
+			value, err := store.Get([]byte("b"))
+			require.NoError(t, err)
+			require.Equal(t, []byte("vb"), value)
+			value, err = store.Get([]byte("e"))
+			require.NoError(t, err)
+			require.Nil(t, value)
+			require.ErrorContains(t, store.Set(nil, []byte("v")), "empty")
+
+			batch := store.NewBatch()
+			require.NoError(t, batch.Delete([]byte("a")))
+			require.NoError(t, batch.Set([]byte("e"), []byte("ve")))
+			require.NoError(t, batch.Write())
+			require.NoError(t, batch.Close())
+
+			require.Equal(
+				t, []string{"b", "c", "d"}, keys(t, store, false, "b", "e"),
+			)
+			require.Equal(
+				t, []string{"d", "c"}, keys(t, store, true, "c", "e"),
+			)
+			require.Equal(
+				t, []string{"e", "d", "c", "b"}, keys(t, store, true, "", ""),
+			)
+		})
+	}
+}
+
+func TestOpenDBReadOnly(t *testing.T) {
+	for _, backend := range []dbm.BackendType{
+		dbm.PebbleDBBackend, dbm.GoLevelDBBackend,
+	} {
+		t.Run(string(backend), func(t *testing.T) {
+			home := t.TempDir()
+			cfg := db.DefaultConfig()
+			cfg.Backend = backend
+			store, err := db.OpenDB(home, cfg)
+			require.NoError(t, err)
+			require.NoError(t, store.Set([]byte("a"), []byte("va")))
+			require.NoError(t, store.Close())
+
+			store, err = db.OpenDBReadOnly(home, cfg)
+			require.NoError(t, err)
+			defer store.Close()
+			value, err := store.Get([]byte("a"))
+			require.NoError(t, err)
+			require.Equal(t, []byte("va"), value)
+			require.Error(t, store.Set([]byte("b"), []byte("vb")))
+		})
+	}
+}
+
+func TestNewDBUnsupportedBackend(t *testing.T) {
+	cfg := db.DefaultConfig()
+	cfg.Backend = dbm.RocksDBBackend
+	_, err := db.NewDB("test", t.TempDir(), cfg)
+	require.ErrorIs(t, err, db.ErrUnsupportedBackend)
+}
+
+func TestReadConfig(t *testing.T) {
+	v := viper.New()
+	v.Set("beacon-kit.storage.backend", "goleveldb")
+	v.Set("beacon-kit.storage.cache-size", "64")
+	cfg, err := db.ReadConfig(v)
+	require.NoError(t, err)
+	require.Equal(t, dbm.GoLevelDBBackend, cfg.Backend)
+	require.Equal(t, 64, cfg.CacheSize)
+	require.Equal(t, db.BlobStoreFile, cfg.BlobStore)
+
+	v.Set("beacon-kit.storage.blob-store", "sqlite")
+	_, err = db.ReadConfig(v)
+	require.ErrorContains(t, err, "blob-store")
+}
+
+func TestMigrate(t *testing.T) {
+	dir := t.TempDir()
+	src, err := db.NewDB("src", dir, db.DefaultConfig())
+	require.NoError(t, err)
+	defer src.Close()
+	for _, key := range []string{"a", "b", "c"} {
+		require.NoError(t, src.Set([]byte(key), []byte("v"+key)))
+	}
+
+	cfg := db.DefaultConfig()
+	cfg.Backend = dbm.GoLevelDBBackend
+	dst, err := db.NewDB("dst", dir, cfg)
+	require.NoError(t, err)
+	defer dst.Close()
+
+	count, err := db.Migrate(src, dst)
+	require.NoError(t, err)
+	require.Equal(t, 3, count)
+	require.Equal(t, []string{"a", "b", "c"}, keys(t, dst, false, "", ""))
+}
+
+// keys returns the keys of the given domain of the database, an empty
+// bound leaving the domain unbounded.
+func keys(
+	t *testing.T,
+	store dbm.DB,
+	reverse bool,
+	start, end string,
+) []string {
+	t.Helper()
+	bound := func(key string) []byte {
+		if key == "" {
+			return nil
+		}
+		return []byte(key)
+	}
+	newIterator := store.Iterator
+	if reverse {
+		newIterator = store.ReverseIterator
+	}
+	itr, err := newIterator(bound(start), bound(end))
+	require.NoError(t, err)
+	defer itr.Close()
+
+	var keys []string
+	for ; itr.Valid(); itr.Next() {
+		keys = append(keys, string(itr.Key()))
+	}
+	require.NoError(t, itr.Error())
+	return keys
+}
@@ -0,0 +1,39 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package db
+
+import "github.com/berachain/beacon-kit/mod/errors"
+
+var (
+	// ErrUnsupportedBackend is returned when a database is opened with a
+	// backend the node does not support.
+	ErrUnsupportedBackend = errors.New("unsupported database backend")
+
+	// ErrKeyEmpty is returned when an empty key is read or written.
+	ErrKeyEmpty = errors.New("key cannot be empty")
+
+	// ErrValueNil is returned when a nil value is written.
+	ErrValueNil = errors.New("value cannot be nil")
+
+	// ErrBatchClosed is returned when a batch is used after being written
+	// or closed.
+	ErrBatchClosed = errors.New("batch has been written or closed")
+)
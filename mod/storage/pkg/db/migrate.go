@@ -0,0 +1,64 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package db
+
+import dbm "github.com/cosmos/cosmos-db"
+
+// migrateBatchSize is the number of bytes written to the target database
+// of a migration at once.
+const migrateBatchSize = 16 * mib
+
+// Migrate copies every key of src to dst and returns the number of keys
+// copied. Writes are flushed to disk in batches of migrateBatchSize bytes.
+func Migrate(src dbm.DB, dst dbm.DB) (int, error) {
+	itr, err := src.Iterator(nil, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer itr.Close()
+
+	var count int
+	batch := dst.NewBatch()
+	defer func() { batch.Close() }()
+	for ; itr.Valid(); itr.Next() {
+		if err = batch.Set(itr.Key(), itr.Value()); err != nil {
+			return count, err
+		}
+		count++
+
+		size, sizeErr := batch.GetByteSize()
+		if sizeErr != nil {
+			return count, sizeErr
+		}
+		if size < migrateBatchSize {
+			continue
+		}
+		if err = batch.WriteSync(); err != nil {
+			return count, err
+		}
+		batch.Close()
+		batch = dst.NewBatch()
+	}
+	if err = itr.Error(); err != nil {
+		return count, err
+	}
+	return count, batch.WriteSync()
+}
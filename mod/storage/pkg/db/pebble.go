@@ -0,0 +1,348 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package db
+
+import (
+	"bytes"
+	"fmt"
+	"path/filepath"
+
+	"github.com/cockroachdb/pebble"
+	dbm "github.com/cosmos/cosmos-db"
+)
+
+// Compile-time assertion of the database interfaces.
+var (
+	_ dbm.DB       = (*PebbleDB)(nil)
+	_ dbm.Batch    = (*pebbleBatch)(nil)
+	_ dbm.Iterator = (*pebbleIterator)(nil)
+)
+
+// PebbleDB is a pebble database whose cache, memtable and compactions are
+// tuned from the storage configuration. It is stored in the same layout as
+// the pebble backend of cosmos-db.
+type PebbleDB struct {
+	db *pebble.DB
+}
+
+// NewPebbleDB opens the pebble database of the given name in dir.
+func NewPebbleDB(name string, dir string, cfg Config) (*PebbleDB, error) {
+	return newPebbleDB(name, dir, cfg, false)
+}
+
+// newPebbleDB opens the pebble database of the given name in dir, in
+// read-only mode if readOnly is set.
+func newPebbleDB(
+	name string,
+	dir string,
+	cfg Config,
+	readOnly bool,
+) (*PebbleDB, error) {
+	cache := pebble.NewCache(int64(cfg.CacheSize) * mib)
+	// The database holds its own reference to the cache.
+	defer cache.Unref()
+
+	opts := &pebble.Options{
+		Cache: cache,
+		//#nosec:G115 // validated to be positive.
+		MemTableSize:                uint64(cfg.WriteBufferSize) * mib,
+		MaxOpenFiles:                cfg.MaxOpenFiles,
+		DisableAutomaticCompactions: cfg.DisableAutomaticCompactions,
+		ReadOnly:                    readOnly,
+		MaxConcurrentCompactions: func() int {
+			return cfg.MaxConcurrentCompactions
+		},
+	}
+	opts.EnsureDefaults()
+
+	db, err := pebble.Open(filepath.Join(dir, name+dbm.DBFileSuffix), opts)
+	if err != nil {
+		return nil, err
+	}
+	return &PebbleDB{db: db}, nil
+}
+
+// Get returns the value of the given key, or nil if it does not exist.
+func (db *PebbleDB) Get(key []byte) ([]byte, error) {
+	if len(key) == 0 {
+		return nil, ErrKeyEmpty
+	}
+	value, closer, err := db.db.Get(key)
+	if err == pebble.ErrNotFound {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	defer closer.Close()
+	return bytes.Clone(value), nil
+}
+
+// Has returns whether the given key exists.
+func (db *PebbleDB) Has(key []byte) (bool, error) {
+	value, err := db.Get(key)
+	return value != nil, err
+}
+
+// Set sets the value of the given key.
+func (db *PebbleDB) Set(key []byte, value []byte) error {
+	return db.set(key, value, pebble.NoSync)
+}
+
+// SetSync sets the value of the given key and flushes it to disk.
+func (db *PebbleDB) SetSync(key []byte, value []byte) error {
+	return db.set(key, value, pebble.Sync)
+}
+
+// set sets the value of the given key with the given write options.
+func (db *PebbleDB) set(
+	key []byte,
+	value []byte,
+	opts *pebble.WriteOptions,
+) error {
+	if len(key) == 0 {
+		return ErrKeyEmpty
+	}
+	if value == nil {
+		return ErrValueNil
+	}
+	return db.db.Set(key, value, opts)
+}
+
+// Delete deletes the given key.
+func (db *PebbleDB) Delete(key []byte) error {
+	return db.delete(key, pebble.NoSync)
+}
+
+// DeleteSync deletes the given key and flushes the deletion to disk.
+func (db *PebbleDB) DeleteSync(key []byte) error {
+	return db.delete(key, pebble.Sync)
+}
+
+// delete deletes the given key with the given write options.
+func (db *PebbleDB) delete(key []byte, opts *pebble.WriteOptions) error {
+	if len(key) == 0 {
+		return ErrKeyEmpty
+	}
+	return db.db.Delete(key, opts)
+}
+
+// Iterator returns an iterator over the keys in [start, end) in ascending
+// order. A nil start or end leaves the domain unbounded on that side.
+func (db *PebbleDB) Iterator(start, end []byte) (dbm.Iterator, error) {
+	return db.newIterator(start, end, false)
+}
+
+// ReverseIterator returns an iterator over the keys in [start, end) in
+// descending order.
+func (db *PebbleDB) ReverseIterator(start, end []byte) (dbm.Iterator, error) {
+	return db.newIterator(start, end, true)
+}
+
+// newIterator returns an iterator over the keys in [start, end).
+func (db *PebbleDB) newIterator(
+	start, end []byte,
+	reverse bool,
+) (dbm.Iterator, error) {
+	if (start != nil && len(start) == 0) || (end != nil && len(end) == 0) {
+		return nil, ErrKeyEmpty
+	}
+	source, err := db.db.NewIter(&pebble.IterOptions{
+		LowerBound: start,
+		UpperBound: end,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if reverse {
+		source.Last()
+	} else {
+		source.First()
+	}
+	return &pebbleIterator{
+		source:  source,
+		start:   start,
+		end:     end,
+		reverse: reverse,
+	}, nil
+}
+
+// Close closes the database.
+func (db *PebbleDB) Close() error {
+	return db.db.Close()
+}
+
+// NewBatch returns a batch of writes applied atomically.
+func (db *PebbleDB) NewBatch() dbm.Batch {
+	return &pebbleBatch{batch: db.db.NewBatch()}
+}
+
+// NewBatchWithSize returns a batch of writes whose buffer is preallocated
+// to the given size.
+func (db *PebbleDB) NewBatchWithSize(size int) dbm.Batch {
+	return &pebbleBatch{batch: db.db.NewBatchWithSize(size)}
+}
+
+// Print prints every key and value of the database.
+func (db *PebbleDB) Print() error {
+	itr, err := db.Iterator(nil, nil)
+	if err != nil {
+		return err
+	}
+	defer itr.Close()
+	for ; itr.Valid(); itr.Next() {
+		//nolint:forbidigo // debugging helper of the interface.
+		fmt.Printf("[%X]:\t[%X]\n", itr.Key(), itr.Value())
+	}
+	return itr.Error()
+}
+
+// Stats returns the metrics of the database.
+func (db *PebbleDB) Stats() map[string]string {
+	return map[string]string{"pebble.stats": db.db.Metrics().String()}
+}
+
+// pebbleBatch is a batch of writes to a pebble database.
+type pebbleBatch struct {
+	batch *pebble.Batch
+}
+
+// Set sets the value of the given key in the batch.
+func (b *pebbleBatch) Set(key, value []byte) error {
+	if len(key) == 0 {
+		return ErrKeyEmpty
+	}
+	if value == nil {
+		return ErrValueNil
+	}
+	if b.batch == nil {
+		return ErrBatchClosed
+	}
+	return b.batch.Set(key, value, nil)
+}
+
+// Delete deletes the given key in the batch.
+func (b *pebbleBatch) Delete(key []byte) error {
+	if len(key) == 0 {
+		return ErrKeyEmpty
+	}
+	if b.batch == nil {
+		return ErrBatchClosed
+	}
+	return b.batch.Delete(key, nil)
+}
+
+// Write applies the batch to the database.
+func (b *pebbleBatch) Write() error {
+	return b.commit(pebble.NoSync)
+}
+
+// WriteSync applies the batch to the database and flushes it to disk.
+func (b *pebbleBatch) WriteSync() error {
+	return b.commit(pebble.Sync)
+}
+
+// commit applies the batch with the given write options and closes it.
+func (b *pebbleBatch) commit(opts *pebble.WriteOptions) error {
+	if b.batch == nil {
+		return ErrBatchClosed
+	}
+	if err := b.batch.Commit(opts); err != nil {
+		return err
+	}
+	return b.Close()
+}
+
+// Close releases the batch. It is a no-op once the batch is closed.
+func (b *pebbleBatch) Close() error {
+	if b.batch == nil {
+		return nil
+	}
+	err := b.batch.Close()
+	b.batch = nil
+	return err
+}
+
+// GetByteSize returns the size of the batch in bytes.
+func (b *pebbleBatch) GetByteSize() (int, error) {
+	if b.batch == nil {
+		return 0, ErrBatchClosed
+	}
+	return b.batch.Len(), nil
+}
+
+// pebbleIterator iterates over a domain of a pebble database.
+type pebbleIterator struct {
+	source     *pebble.Iterator
+	start, end []byte
+	reverse    bool
+}
+
+// Domain returns the bounds of the iterator.
+func (itr *pebbleIterator) Domain() ([]byte, []byte) {
+	return itr.start, itr.end
+}
+
+// Valid returns whether the iterator points to a key of its domain.
+func (itr *pebbleIterator) Valid() bool {
+	return itr.source.Error() == nil && itr.source.Valid()
+}
+
+// Key returns the key the iterator points to. It panics if the iterator is
+// not valid.
+func (itr *pebbleIterator) Key() []byte {
+	itr.assertIsValid()
+	return bytes.Clone(itr.source.Key())
+}
+
+// Value returns the value the iterator points to. It panics if the
+// iterator is not valid.
+func (itr *pebbleIterator) Value() []byte {
+	itr.assertIsValid()
+	return bytes.Clone(itr.source.Value())
+}
+
+// Next moves the iterator to the next key of its domain. It panics if the
+// iterator is not valid.
+func (itr *pebbleIterator) Next() {
+	itr.assertIsValid()
+	if itr.reverse {
+		itr.source.Prev()
+	} else {
+		itr.source.Next()
+	}
+}
+
+// Error returns the error the iterator encountered, if any.
+func (itr *pebbleIterator) Error() error {
+	return itr.source.Error()
+}
+
+// Close releases the iterator.
+func (itr *pebbleIterator) Close() error {
+	return itr.source.Close()
+}
+
+// assertIsValid panics if the iterator is not valid.
+func (itr *pebbleIterator) assertIsValid() {
+	if !itr.Valid() {
+		panic("iterator is invalid")
+	}
+}
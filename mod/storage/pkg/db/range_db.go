@@ -0,0 +1,107 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package db
+
+import (
+	"encoding/binary"
+
+	"github.com/berachain/beacon-kit/mod/storage/pkg/pruner"
+	dbm "github.com/cosmos/cosmos-db"
+)
+
+// indexLength is the length of the index prefix of the keys of a RangeDB.
+const indexLength = 8
+
+// Compile-time assertion of prunable interface.
+var _ pruner.Prunable = (*RangeDB)(nil)
+
+// RangeDB is a key-value database that stores versioned data. It prefixes
+// keys with their big endian encoded index so that the data of a range of
+// indexes is stored contiguously.
+type RangeDB struct {
+	db dbm.DB
+}
+
+// NewRangeDB creates a new RangeDB on top of the given database.
+func NewRangeDB(db dbm.DB) *RangeDB {
+	return &RangeDB{db: db}
+}
+
+// Get retrieves the value associated with the given index and key.
+func (db *RangeDB) Get(index uint64, key []byte) ([]byte, error) {
+	return db.db.Get(prefix(index, key))
+}
+
+// Has checks if the given index and key exist in the database.
+func (db *RangeDB) Has(index uint64, key []byte) (bool, error) {
+	return db.db.Has(prefix(index, key))
+}
+
+// Set stores the value with the given index and key in the database.
+func (db *RangeDB) Set(index uint64, key []byte, value []byte) error {
+	return db.db.Set(prefix(index, key), value)
+}
+
+// Delete removes the value associated with the given index and key from the
+// database.
+func (db *RangeDB) Delete(index uint64, key []byte) error {
+	return db.db.Delete(prefix(index, key))
+}
+
+// Prune removes all values in the given range [start, end) from the db.
+func (db *RangeDB) Prune(start, end uint64) error {
+	if start > end {
+		return pruner.ErrInvalidRange
+	}
+	itr, err := db.db.Iterator(prefix(start, nil), prefix(end, nil))
+	if err != nil {
+		return err
+	}
+	batch := db.db.NewBatch()
+	defer batch.Close()
+	for ; itr.Valid(); itr.Next() {
+		if err = batch.Delete(itr.Key()); err != nil {
+			itr.Close()
+			return err
+		}
+	}
+	if err = itr.Error(); err != nil {
+		itr.Close()
+		return err
+	}
+	// The iterator must be released before the batch is written.
+	if err = itr.Close(); err != nil {
+		return err
+	}
+	return batch.Write()
+}
+
+// Close closes the underlying database.
+func (db *RangeDB) Close() error {
+	return db.db.Close()
+}
+
+// prefix prefixes the given key with the big endian encoded index.
+func prefix(index uint64, key []byte) []byte {
+	bz := make([]byte, indexLength, indexLength+len(key))
+	binary.BigEndian.PutUint64(bz, index)
+	return append(bz, key...)
+}
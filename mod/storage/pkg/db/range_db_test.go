@@ -0,0 +1,55 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package db_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/storage/pkg/db"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/pruner"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRangeDB(t *testing.T) {
+	rdb := db.NewRangeDB(dbm.NewMemDB())
+	for index := range uint64(5) {
+		require.NoError(t, rdb.Set(index, []byte("blob"), []byte{byte(index)}))
+	}
+	value, err := rdb.Get(3, []byte("blob"))
+	require.NoError(t, err)
+	require.Equal(t, []byte{3}, value)
+
+	require.NoError(t, rdb.Prune(1, 3))
+	for index, want := range []bool{true, false, false, true, true} {
+		//#nosec:G115 // small test index.
+		ok, hasErr := rdb.Has(uint64(index), []byte("blob"))
+		require.NoError(t, hasErr)
+		require.Equal(t, want, ok, "index %d", index)
+	}
+
+	require.NoError(t, rdb.Delete(4, []byte("blob")))
+	ok, err := rdb.Has(4, []byte("blob"))
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	require.ErrorIs(t, rdb.Prune(3, 1), pruner.ErrInvalidRange)
+}
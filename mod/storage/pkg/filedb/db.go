@@ -29,9 +29,18 @@ import (
 	"github.com/spf13/afero"
 )
 
+// tempFileMarker is part of the name of the temporary file a value is written
+// to before being renamed to the file of its key.
+const tempFileMarker = ".tmp-"
+
 // DB represents a filesystem backed key-value store.
 // It is useful for storing amounts of data that exceed what is
 // performant to store in a traditional key-value database.
+//
+// Every value is written with a header holding its checksum and length, which
+// Get verifies. Values are written to a temporary file that is synced and
+// renamed over the file of the key, so a crash never leaves a partially
+// written value behind under a key.
 type DB struct {
 	fs        afero.Fs
 	logger    log.Logger
@@ -53,9 +62,26 @@ func NewDB(opts ...Option) *DB {
 	return db
 }
 
-// Get retrieves the value for a key.
+// Get retrieves the value for a key. A value that does not match its
+// checksum is moved to the quarantine directory and ErrCorruptedFile is
+// returned.
 func (db *DB) Get(key []byte) ([]byte, error) {
-	return afero.ReadFile(db.fs, db.pathForKey(key))
+	path := db.pathForKey(key)
+	data, err := afero.ReadFile(db.fs, path)
+	if err != nil {
+		return nil, err
+	}
+
+	value, err := decode(data)
+	if err != nil {
+		db.logger.Warn("Quarantining corrupted file", "path", path,
+			"error", err)
+		return nil, errors.Join(
+			errors.Wrapf(err, "failed to read %s", path),
+			db.quarantine(path),
+		)
+	}
+	return value, nil
 }
 
 // Has returns true if the key exists in the database.
@@ -74,35 +100,59 @@ func (db *DB) Set(key []byte, value []byte) error {
 	} else if exists {
 		db.logger.Warn("Overriding existing key", "key", key)
 	}
+	return db.writeFile(db.pathForKey(key), encode(value))
+}
 
-	if err := db.fs.MkdirAll(
-		filepath.Dir(db.pathForKey(key)), db.dirPerms,
-	); err != nil {
+// Delete removes the value for a key.
+func (db *DB) Delete(key []byte) error {
+	return db.fs.RemoveAll(db.pathForKey(key))
+}
+
+// writeFile atomically replaces the file at path with data. The data is
+// written and synced to a temporary file in the same directory, which is
+// then renamed to path.
+func (db *DB) writeFile(path string, data []byte) error {
+	dir := filepath.Dir(path)
+	if err := db.fs.MkdirAll(dir, db.dirPerms); err != nil {
 		return err
 	}
 
-	file, err := db.fs.Create(db.pathForKey(key))
+	file, err := afero.TempFile(
+		db.fs, dir, filepath.Base(path)+tempFileMarker+"*",
+	)
 	if err != nil {
 		return errors.Wrap(err, "failed to create file")
 	}
-	defer file.Close()
-
-	n, err := file.Write(value)
-	if err != nil {
-		return errors.Wrap(err, "failed to write to file")
+	n, err := file.Write(data)
+	if err == nil {
+		err = file.Sync()
+	}
+	if err = errors.Join(err, file.Close()); err != nil {
+		return errors.Join(
+			errors.Wrap(err, "failed to write to file"),
+			db.fs.Remove(file.Name()),
+		)
 	}
-	db.logger.Debug("wrote %d bytes to %s", n, db.pathForKey(key))
 
-	return nil
+	if err = db.fs.Rename(file.Name(), path); err != nil {
+		return errors.Join(err, db.fs.Remove(file.Name()))
+	}
+	db.logger.Debug("Wrote file", "path", path, "bytes", n)
+	return db.syncDir(dir)
 }
 
-// Delete removes the value for a key.
-func (db *DB) Delete(key []byte) error {
-	return db.fs.RemoveAll(db.pathForKey(key))
+// syncDir syncs a directory, making the renames within it durable.
+func (db *DB) syncDir(dir string) error {
+	file, err := db.fs.Open(dir)
+	if err != nil {
+		return err
+	}
+	return errors.Join(file.Sync(), file.Close())
 }
 
-// pathForKey returns the path for a key.
-// TODO: for efficient storage we should expand this path
+// pathForKey returns the path for a key. Keys are relative paths, so callers
+// shard their keys into directories by separating them with slashes, as
+// RangeDB does with its indexes.
 func (db *DB) pathForKey(key []byte) string {
 	return string(key) + "." + db.extension
 }
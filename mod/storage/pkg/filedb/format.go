@@ -0,0 +1,83 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package filedb
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+)
+
+// headerSize is the size of the header every value is written with: a magic
+// number, the CRC-32C checksum of the value and its length.
+const headerSize = 16
+
+var (
+	// magic identifies files written with a header.
+	//
+	//nolint:gochecknoglobals // read-only.
+	magic = []byte("bkfd")
+
+	// castagnoli is the CRC-32C table values are checksummed with.
+	//
+	//nolint:gochecknoglobals // read-only.
+	castagnoli = crc32.MakeTable(crc32.Castagnoli)
+)
+
+// encode prepends the header to a value.
+func encode(value []byte) []byte {
+	data := make([]byte, headerSize, headerSize+len(value))
+	copy(data, magic)
+	binary.BigEndian.PutUint32(data[4:8], crc32.Checksum(value, castagnoli))
+	binary.BigEndian.PutUint64(data[8:headerSize], uint64(len(value)))
+	return append(data, value...)
+}
+
+// decode verifies the header of data against the value following it and
+// returns the value.
+func decode(data []byte) ([]byte, error) {
+	if err := checkHeader(data, int64(len(data))); err != nil {
+		return nil, err
+	}
+	value := data[headerSize:]
+	if crc32.Checksum(value, castagnoli) !=
+		binary.BigEndian.Uint32(data[4:8]) {
+		return nil, errors.Wrap(ErrCorruptedFile, "checksum mismatch")
+	}
+	return value, nil
+}
+
+// checkHeader verifies that header starts a file of the given size, which
+// catches files that were truncated or never written with a header.
+func checkHeader(header []byte, size int64) error {
+	switch {
+	case len(header) < headerSize || !bytes.Equal(header[:4], magic):
+		return errors.Wrap(ErrCorruptedFile, "missing header")
+	case size < headerSize ||
+		binary.BigEndian.Uint64(header[8:headerSize]) !=
+			uint64(size-headerSize):
+		return errors.Wrap(ErrCorruptedFile, "length mismatch")
+	default:
+		return nil
+	}
+}
@@ -22,6 +22,7 @@ package filedb
 
 import (
 	"bytes"
+	"encoding/binary"
 	"fmt"
 	"strconv"
 
@@ -31,14 +32,26 @@ import (
 	"github.com/berachain/beacon-kit/mod/storage/pkg/pruner"
 )
 
-// two is a constant for the number 2.
-const two = 2
+const (
+	// two is a constant for the number 2.
+	two = 2
+
+	// indexesPerShard is the number of consecutive indexes whose directories
+	// are grouped into a shard directory, which keeps the number of entries
+	// per directory bounded.
+	indexesPerShard = 4096
+
+	// watermarkKey is the key firstNonNilIndex is persisted at, so pruning
+	// resumes from it after a restart.
+	watermarkKey = "pruned"
+)
 
 // Compile-time assertion of prunable interface.
 var _ pruner.Prunable = (*RangeDB)(nil)
 
 // RangeDB is a database that stores versioned data.
-// It prefixes keys with an index.
+// It prefixes keys with an index and the shard of the index, so that keys are
+// laid out as <shard>/<index>/<key> in a file database.
 // Invariant: No index below firstNonNilIndex should be populated.
 type RangeDB struct {
 	db.DB
@@ -71,9 +84,12 @@ func (db *RangeDB) Has(index uint64, key []byte) (bool, error) {
 // It prefixes the key with the index and a slash before storing it in the
 // underlying database.
 func (db *RangeDB) Set(index uint64, key []byte, value []byte) error {
-	// enforce invariant
+	// enforce invariant, persisting it before the index is populated
 	if index < db.firstNonNilIndex {
 		db.firstNonNilIndex = index
+		if err := db.setWatermark(index); err != nil {
+			return err
+		}
 	}
 	return db.DB.Set(db.prefix(index, key), value)
 }
@@ -87,19 +103,30 @@ func (db *RangeDB) Delete(index uint64, key []byte) error {
 
 // DeleteRange removes all values associated with the given index from the
 // filesystem. It is INCLUSIVE of the `from` index and EXCLUSIVE of
-// the `to“ index.
+// the `to“ index. Shards entirely within the range are removed at once.
 func (db *RangeDB) DeleteRange(from, to uint64) error {
 	f, ok := db.DB.(*DB)
 	if !ok {
-		return errors.New("rangedb: delete range not supported for this db")
+		return ErrDeleteRangeNotSupported
 	}
 	if from > to {
 		return pruner.ErrInvalidRange
 	}
-	for ; from < to; from++ {
-		path := strconv.FormatUint(from, 10) + "/"
-		if err := f.fs.RemoveAll(path); err != nil {
-			return err
+	for from < to {
+		shard := from / indexesPerShard
+		next := min((shard+1)*indexesPerShard, to)
+		if from == shard*indexesPerShard &&
+			next == (shard+1)*indexesPerShard {
+			if err := f.fs.RemoveAll(shardDir(from)); err != nil {
+				return err
+			}
+			from = next
+			continue
+		}
+		for ; from < next; from++ {
+			if err := f.fs.RemoveAll(indexDir(from)); err != nil {
+				return err
+			}
 		}
 	}
 	return nil
@@ -120,23 +147,50 @@ func (db *RangeDB) Prune(start, end uint64) error {
 		db.firstNonNilIndex = 0
 		return err
 	}
+	if end == db.firstNonNilIndex {
+		return nil
+	}
 	db.firstNonNilIndex = end
-	return nil
+	return db.setWatermark(end)
 }
 
-// prefix prefixes the given key with the index and a slash.
+// setWatermark persists firstNonNilIndex, if the underlying database is a
+// file database. It is stored as an SSZ uint64.
+func (db *RangeDB) setWatermark(index uint64) error {
+	f, ok := db.DB.(*DB)
+	if !ok {
+		return nil
+	}
+	return f.writeFile(
+		f.pathForKey([]byte(watermarkKey)),
+		encode(binary.LittleEndian.AppendUint64(nil, index)),
+	)
+}
+
+// prefix prefixes the given key with the shard and the index of the key.
 func (db *RangeDB) prefix(index uint64, key []byte) []byte {
-	return []byte(fmt.Sprintf("%d/%s", index, hex.EncodeBytes(key)))
+	return []byte(indexDir(index) + "/" + hex.EncodeBytes(key))
+}
+
+// shardDir returns the directory of the shard an index belongs to.
+func shardDir(index uint64) string {
+	return strconv.FormatUint(index/indexesPerShard, 10)
+}
+
+// indexDir returns the directory of an index.
+func indexDir(index uint64) string {
+	return fmt.Sprintf("%s/%d", shardDir(index), index)
 }
 
-// ExtractIndex extracts the index from a prefixed key.
+// ExtractIndex extracts the index from a prefixed key, which is the path
+// segment preceding the key.
 func ExtractIndex(prefixedKey []byte) (uint64, error) {
-	parts := bytes.SplitN(prefixedKey, []byte("/"), two)
+	parts := bytes.Split(prefixedKey, []byte("/"))
 	if len(parts) < two {
 		return 0, errors.New("invalid key format")
 	}
 
-	indexStr := string(parts[0])
+	indexStr := string(parts[len(parts)-two])
 	index, err := strconv.ParseUint(indexStr, 10, 64)
 	if err != nil {
 		return 0, err
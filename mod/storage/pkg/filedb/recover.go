@@ -0,0 +1,183 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package filedb
+
+import (
+	"encoding/binary"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/spf13/afero"
+)
+
+// QuarantineDir is the directory, relative to the root directory, that
+// corrupted and partially written files are moved to.
+const QuarantineDir = "quarantine"
+
+// Recover scans the database for temporary files left behind by an
+// interrupted write and for files whose header does not match their size, and
+// moves them to the quarantine directory. Checksums are verified by Get
+// rather than here, so that the scan does not read every value on startup.
+// It returns the number of quarantined files.
+func (db *DB) Recover() (int, error) {
+	var paths []string
+	if err := afero.Walk(
+		db.fs, ".", func(path string, info os.FileInfo, err error) error {
+			switch {
+			case errors.Is(err, os.ErrNotExist):
+				return nil
+			case err != nil:
+				return err
+			case info.IsDir() && path == QuarantineDir:
+				return filepath.SkipDir
+			case info.IsDir():
+				return nil
+			case strings.Contains(info.Name(), tempFileMarker):
+				paths = append(paths, path)
+			case strings.HasSuffix(info.Name(), "."+db.extension):
+				if err = db.checkFile(path, info.Size()); err != nil {
+					paths = append(paths, path)
+				}
+			}
+			return nil
+		},
+	); err != nil {
+		return 0, err
+	}
+
+	for _, path := range paths {
+		db.logger.Warn("Quarantining incomplete file", "path", path)
+		if err := db.quarantine(path); err != nil {
+			return 0, err
+		}
+	}
+	return len(paths), nil
+}
+
+// checkFile verifies the header of the file at path against its size.
+func (db *DB) checkFile(path string, size int64) error {
+	file, err := db.fs.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	header := make([]byte, headerSize)
+	if _, err = io.ReadFull(file, header); err != nil {
+		return errors.Wrap(ErrCorruptedFile, "missing header")
+	}
+	return checkHeader(header, size)
+}
+
+// quarantine moves the file at path into the quarantine directory, keeping
+// its path relative to the root directory.
+func (db *DB) quarantine(path string) error {
+	target := filepath.Join(QuarantineDir, path)
+	if err := db.fs.MkdirAll(filepath.Dir(target), db.dirPerms); err != nil {
+		return err
+	}
+	return db.fs.Rename(path, target)
+}
+
+// Recover prepares the database for use after a restart, if the underlying
+// database is a file database. It moves the values of earlier versions, which
+// were laid out as <index>/<key> without a header, into their shard,
+// quarantines incomplete files and restores the persisted firstNonNilIndex.
+func (db *RangeDB) Recover() error {
+	f, ok := db.DB.(*DB)
+	if !ok {
+		return nil
+	}
+	if err := db.migrateUnsharded(f); err != nil {
+		return errors.Wrap(err, "failed to migrate unsharded layout")
+	}
+	if _, err := f.Recover(); err != nil {
+		return err
+	}
+
+	watermark, err := f.Get([]byte(watermarkKey))
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+		return nil
+	case err != nil:
+		return err
+	case len(watermark) != 8:
+		return errors.Wrap(ErrCorruptedFile, "invalid pruning watermark")
+	}
+	db.firstNonNilIndex = binary.LittleEndian.Uint64(watermark)
+	return nil
+}
+
+// migrateUnsharded rewrites the values found directly within an index
+// directory at the root of the database to their shard. Directories of the
+// sharded layout only hold directories at that depth.
+func (db *RangeDB) migrateUnsharded(f *DB) error {
+	entries, err := afero.ReadDir(f.fs, ".")
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		index, err := strconv.ParseUint(entry.Name(), 10, 64)
+		if !entry.IsDir() || err != nil {
+			continue
+		}
+		files, err := afero.ReadDir(f.fs, entry.Name())
+		if err != nil {
+			return err
+		}
+
+		var migrated int
+		for _, file := range files {
+			if file.IsDir() ||
+				!strings.HasSuffix(file.Name(), "."+f.extension) {
+				continue
+			}
+			path := filepath.Join(entry.Name(), file.Name())
+			value, err := afero.ReadFile(f.fs, path)
+			if err != nil {
+				return err
+			}
+			if err = f.writeFile(
+				filepath.Join(indexDir(index), file.Name()), encode(value),
+			); err != nil {
+				return err
+			}
+			if err = f.fs.Remove(path); err != nil {
+				return err
+			}
+			migrated++
+		}
+		// The directory of a low index may also be the shard it moved to.
+		if migrated == len(files) && shardDir(index) != entry.Name() {
+			if err = f.fs.Remove(entry.Name()); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
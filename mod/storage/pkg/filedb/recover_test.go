@@ -0,0 +1,140 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package filedb_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"cosmossdk.io/log"
+	file "github.com/berachain/beacon-kit/mod/storage/pkg/filedb"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDB_Recover(t *testing.T) {
+	root := t.TempDir()
+	db := newRecoverTestDB(root)
+	require.NoError(t, db.Set([]byte("a/complete"), []byte("value")))
+	require.NoError(t, db.Set([]byte("a/truncated"), []byte("value")))
+
+	truncated := filepath.Join(root, "a", "truncated.txt")
+	require.NoError(t, os.Truncate(truncated, 18))
+	partial := filepath.Join(root, "a", "partial.txt.tmp-123")
+	require.NoError(t, os.WriteFile(partial, []byte("val"), 0600))
+
+	quarantined, err := db.Recover()
+	require.NoError(t, err)
+	require.Equal(t, 2, quarantined)
+	require.NoFileExists(t, truncated)
+	require.NoFileExists(t, partial)
+	require.FileExists(t, filepath.Join(
+		root, file.QuarantineDir, "a", "truncated.txt",
+	))
+
+	value, err := db.Get([]byte("a/complete"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("value"), value)
+
+	// A second scan finds nothing, the quarantine directory is skipped.
+	quarantined, err = db.Recover()
+	require.NoError(t, err)
+	require.Zero(t, quarantined)
+}
+
+func TestDB_GetCorrupted(t *testing.T) {
+	root := t.TempDir()
+	db := newRecoverTestDB(root)
+	require.NoError(t, db.Set([]byte("key"), []byte("value")))
+
+	path := filepath.Join(root, "key.txt")
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	data[len(data)-1] ^= 0xff
+	require.NoError(t, os.WriteFile(path, data, 0600))
+
+	_, err = db.Get([]byte("key"))
+	require.ErrorIs(t, err, file.ErrCorruptedFile)
+	exists, err := db.Has([]byte("key"))
+	require.NoError(t, err)
+	require.False(t, exists)
+}
+
+func TestRangeDB_PersistedWatermark(t *testing.T) {
+	root := t.TempDir()
+	rdb := file.NewRangeDB(newRecoverTestDB(root))
+	require.NoError(t, populateTestDB(rdb, 0, 10))
+	require.NoError(t, rdb.Prune(0, 5))
+
+	// A restarted database resumes pruning from the persisted index.
+	rdb = file.NewRangeDB(newRecoverTestDB(root))
+	require.Zero(t, getFirstNonNilIndex(rdb))
+	require.NoError(t, rdb.Recover())
+	require.Equal(t, uint64(5), getFirstNonNilIndex(rdb))
+
+	// Populating a pruned index lowers the persisted index.
+	require.NoError(t, rdb.Set(2, []byte("key"), []byte("value")))
+	rdb = file.NewRangeDB(newRecoverTestDB(root))
+	require.NoError(t, rdb.Recover())
+	require.Equal(t, uint64(2), getFirstNonNilIndex(rdb))
+}
+
+func TestRangeDB_DeleteRangeAcrossShards(t *testing.T) {
+	rdb := file.NewRangeDB(newRecoverTestDB(t.TempDir()))
+	for _, index := range []uint64{4095, 4096, 8191, 8192, 8193} {
+		require.NoError(t, rdb.Set(index, []byte("key"), []byte("value")))
+	}
+
+	require.NoError(t, rdb.DeleteRange(4095, 8193))
+	requireNotExist(t, rdb, 4095, 4095)
+	requireNotExist(t, rdb, 4096, 4096)
+	requireNotExist(t, rdb, 8191, 8192)
+	requireExist(t, rdb, 8193, 8193)
+}
+
+func TestRangeDB_RecoverUnshardedLayout(t *testing.T) {
+	root := t.TempDir()
+	for _, index := range []string{"1", "5000"} {
+		require.NoError(t, os.MkdirAll(filepath.Join(root, index), 0700))
+		require.NoError(t, os.WriteFile(
+			filepath.Join(root, index, "0x6b6579.txt"), []byte("value"), 0600,
+		))
+	}
+
+	rdb := file.NewRangeDB(newRecoverTestDB(root))
+	require.NoError(t, rdb.Recover())
+	for _, index := range []uint64{1, 5000} {
+		value, err := rdb.Get(index, []byte("key"))
+		require.NoError(t, err)
+		require.Equal(t, []byte("value"), value)
+	}
+	require.NoDirExists(t, filepath.Join(root, "5000"))
+}
+
+// newRecoverTestDB returns a new file DB instance rooted at path.
+func newRecoverTestDB(path string) *file.DB {
+	return file.NewDB(
+		file.WithRootDirectory(path),
+		file.WithFileExtension("txt"),
+		file.WithDirectoryPermissions(0700),
+		file.WithLogger(log.NewNopLogger()),
+	)
+}
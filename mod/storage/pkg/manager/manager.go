@@ -27,11 +27,16 @@ package manager
 
 import (
 	"context"
+	"errors"
 
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/pruner"
 )
 
+// ErrPrunerNotFound is returned when no pruner with the given name is
+// managed by the DBManager.
+var ErrPrunerNotFound = errors.New("pruner not found")
+
 // DBManager is a manager for all pruners.
 type DBManager struct {
 	pruners []pruner.Pruner[pruner.Prunable]
@@ -60,3 +65,54 @@ func (m *DBManager) Start(ctx context.Context) error {
 	}
 	return nil
 }
+
+// PrunerStatuses returns whether each managed pruner is paused, keyed by
+// pruner name.
+func (m *DBManager) PrunerStatuses() map[string]bool {
+	statuses := make(map[string]bool, len(m.pruners))
+	for _, pruner := range m.pruners {
+		statuses[pruner.Name()] = pruner.Paused()
+	}
+	return statuses
+}
+
+// PausePruner pauses the pruner with the given name.
+func (m *DBManager) PausePruner(name string) error {
+	pruner, err := m.getPruner(name)
+	if err != nil {
+		return err
+	}
+	pruner.Pause()
+	return nil
+}
+
+// ResumePruner resumes the pruner with the given name.
+func (m *DBManager) ResumePruner(name string) error {
+	pruner, err := m.getPruner(name)
+	if err != nil {
+		return err
+	}
+	pruner.Resume()
+	return nil
+}
+
+// Prune triggers the pruner with the given name to prune [start, end).
+func (m *DBManager) Prune(name string, start, end uint64) error {
+	pruner, err := m.getPruner(name)
+	if err != nil {
+		return err
+	}
+	return pruner.Prune(start, end)
+}
+
+// getPruner returns the pruner with the given name.
+func (m *DBManager) getPruner(
+	name string,
+) (pruner.Pruner[pruner.Prunable], error) {
+	for _, p := range m.pruners {
+		if p.Name() == name {
+			return p, nil
+		}
+	}
+	return nil, ErrPrunerNotFound
+}
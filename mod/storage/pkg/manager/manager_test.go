@@ -68,3 +68,34 @@ func TestDBManager_Start(t *testing.T) {
 	time.Sleep(100 * time.Millisecond)
 	mockPrunable.AssertNotCalled(t, "PruneFromInclusive")
 }
+
+func TestDBManager_PrunerControls(t *testing.T) {
+	mockPrunable := new(mocks.Prunable)
+	mockPrunable.On("Prune", uint64(1), uint64(5)).Return(nil)
+	ch := make(chan async.Event[manager.BeaconBlock])
+	pruneParamsFn := func(
+		_ async.Event[manager.BeaconBlock],
+	) (uint64, uint64) {
+		return 0, 0
+	}
+
+	logger := log.NewNopLogger()
+	p1 := pruner.NewPruner[
+		manager.BeaconBlock,
+		*mocks.Prunable,
+	](logger, mockPrunable, "pruner1", ch, pruneParamsFn)
+
+	m, err := manager.NewDBManager(logger, p1)
+	require.NoError(t, err)
+
+	require.NoError(t, m.PausePruner("pruner1"))
+	require.Equal(t, map[string]bool{"pruner1": true}, m.PrunerStatuses())
+
+	require.NoError(t, m.ResumePruner("pruner1"))
+	require.Equal(t, map[string]bool{"pruner1": false}, m.PrunerStatuses())
+
+	require.NoError(t, m.Prune("pruner1", 1, 5))
+	mockPrunable.AssertCalled(t, "Prune", uint64(1), uint64(5))
+
+	require.ErrorIs(t, m.PausePruner("unknown"), manager.ErrPrunerNotFound)
+}
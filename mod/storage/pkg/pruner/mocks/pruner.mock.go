@@ -67,6 +67,162 @@ func (_c *Pruner_Name_Call[PrunableT]) RunAndReturn(run func() string) *Pruner_N
 	return _c
 }
 
+// Pause provides a mock function with given fields:
+func (_m *Pruner[PrunableT]) Pause() {
+	_m.Called()
+}
+
+// Pruner_Pause_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Pause'
+type Pruner_Pause_Call[PrunableT pruner.Prunable] struct {
+	*mock.Call
+}
+
+// Pause is a helper method to define mock.On call
+func (_e *Pruner_Expecter[PrunableT]) Pause() *Pruner_Pause_Call[PrunableT] {
+	return &Pruner_Pause_Call[PrunableT]{Call: _e.mock.On("Pause")}
+}
+
+func (_c *Pruner_Pause_Call[PrunableT]) Run(run func()) *Pruner_Pause_Call[PrunableT] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Pruner_Pause_Call[PrunableT]) Return() *Pruner_Pause_Call[PrunableT] {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Pruner_Pause_Call[PrunableT]) RunAndReturn(run func()) *Pruner_Pause_Call[PrunableT] {
+	_c.Run(run)
+	return _c
+}
+
+// Paused provides a mock function with given fields:
+func (_m *Pruner[PrunableT]) Paused() bool {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Paused")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// Pruner_Paused_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Paused'
+type Pruner_Paused_Call[PrunableT pruner.Prunable] struct {
+	*mock.Call
+}
+
+// Paused is a helper method to define mock.On call
+func (_e *Pruner_Expecter[PrunableT]) Paused() *Pruner_Paused_Call[PrunableT] {
+	return &Pruner_Paused_Call[PrunableT]{Call: _e.mock.On("Paused")}
+}
+
+func (_c *Pruner_Paused_Call[PrunableT]) Run(run func()) *Pruner_Paused_Call[PrunableT] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Pruner_Paused_Call[PrunableT]) Return(_a0 bool) *Pruner_Paused_Call[PrunableT] {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Pruner_Paused_Call[PrunableT]) RunAndReturn(run func() bool) *Pruner_Paused_Call[PrunableT] {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Prune provides a mock function with given fields: start, end
+func (_m *Pruner[PrunableT]) Prune(start uint64, end uint64) error {
+	ret := _m.Called(start, end)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Prune")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint64, uint64) error); ok {
+		r0 = rf(start, end)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Pruner_Prune_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Prune'
+type Pruner_Prune_Call[PrunableT pruner.Prunable] struct {
+	*mock.Call
+}
+
+// Prune is a helper method to define mock.On call
+//   - start uint64
+//   - end uint64
+func (_e *Pruner_Expecter[PrunableT]) Prune(start interface{}, end interface{}) *Pruner_Prune_Call[PrunableT] {
+	return &Pruner_Prune_Call[PrunableT]{Call: _e.mock.On("Prune", start, end)}
+}
+
+func (_c *Pruner_Prune_Call[PrunableT]) Run(run func(start uint64, end uint64)) *Pruner_Prune_Call[PrunableT] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uint64), args[1].(uint64))
+	})
+	return _c
+}
+
+func (_c *Pruner_Prune_Call[PrunableT]) Return(_a0 error) *Pruner_Prune_Call[PrunableT] {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Pruner_Prune_Call[PrunableT]) RunAndReturn(run func(uint64, uint64) error) *Pruner_Prune_Call[PrunableT] {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Resume provides a mock function with given fields:
+func (_m *Pruner[PrunableT]) Resume() {
+	_m.Called()
+}
+
+// Pruner_Resume_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Resume'
+type Pruner_Resume_Call[PrunableT pruner.Prunable] struct {
+	*mock.Call
+}
+
+// Resume is a helper method to define mock.On call
+func (_e *Pruner_Expecter[PrunableT]) Resume() *Pruner_Resume_Call[PrunableT] {
+	return &Pruner_Resume_Call[PrunableT]{Call: _e.mock.On("Resume")}
+}
+
+func (_c *Pruner_Resume_Call[PrunableT]) Run(run func()) *Pruner_Resume_Call[PrunableT] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Pruner_Resume_Call[PrunableT]) Return() *Pruner_Resume_Call[PrunableT] {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Pruner_Resume_Call[PrunableT]) RunAndReturn(run func()) *Pruner_Resume_Call[PrunableT] {
+	_c.Run(run)
+	return _c
+}
+
 // Start provides a mock function with given fields: ctx
 func (_m *Pruner[PrunableT]) Start(ctx context.Context) {
 	_m.Called(ctx)
@@ -96,7 +252,7 @@ func (_c *Pruner_Start_Call[PrunableT]) Return() *Pruner_Start_Call[PrunableT] {
 }
 
 func (_c *Pruner_Start_Call[PrunableT]) RunAndReturn(run func(context.Context)) *Pruner_Start_Call[PrunableT] {
-	_c.Call.Return(run)
+	_c.Run(run)
 	return _c
 }
 
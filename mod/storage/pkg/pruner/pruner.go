@@ -28,6 +28,7 @@ package pruner
 
 import (
 	"context"
+	"sync/atomic"
 
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/async"
@@ -47,6 +48,8 @@ type pruner[
 	name                    string
 	subBeaconBlockFinalized chan async.Event[BeaconBlockT]
 	pruneRangeFn            func(async.Event[BeaconBlockT]) (uint64, uint64)
+	// paused is set when pruning on finalized blocks is paused.
+	paused atomic.Bool
 }
 
 // NewPruner creates a new Pruner.
@@ -92,12 +95,41 @@ func (p *pruner[_, PrunableT]) listen(ctx context.Context) {
 func (p *pruner[BeaconBlockT, PrunableT]) onFinalizeBlock(
 	event async.Event[BeaconBlockT],
 ) {
+	if p.paused.Load() {
+		return
+	}
 	start, end := p.pruneRangeFn(event)
 	if err := p.prunable.Prune(start, end); err != nil {
 		p.logger.Error("‼️ error pruning index ‼️", "error", err)
 	}
 }
 
+// Pause stops the pruner from pruning on finalized blocks.
+func (p *pruner[_, _]) Pause() {
+	if !p.paused.Swap(true) {
+		p.logger.Info("Pruner paused", "name", p.name)
+	}
+}
+
+// Resume resumes pruning on finalized blocks.
+func (p *pruner[_, _]) Resume() {
+	if p.paused.Swap(false) {
+		p.logger.Info("Pruner resumed", "name", p.name)
+	}
+}
+
+// Paused returns whether the pruner is currently paused.
+func (p *pruner[_, _]) Paused() bool {
+	return p.paused.Load()
+}
+
+// Prune prunes the prunable store from [start, end) on demand.
+func (p *pruner[_, _]) Prune(start, end uint64) error {
+	p.logger.Info("Pruning on demand", "name", p.name,
+		"start", start, "end", end)
+	return p.prunable.Prune(start, end)
+}
+
 // Name returns the name of the Pruner.
 func (p *pruner[_, _]) Name() string {
 	return p.name
@@ -59,4 +59,14 @@ type Prunable interface {
 type Pruner[PrunableT Prunable] interface {
 	Name() string
 	Start(ctx context.Context)
+	// Pause stops the pruner from pruning on finalized blocks until it is
+	// resumed.
+	Pause()
+	// Resume resumes pruning on finalized blocks.
+	Resume()
+	// Paused returns whether the pruner is currently paused.
+	Paused() bool
+	// Prune prunes the prunable store from [start, end) on demand,
+	// regardless of whether the pruner is paused.
+	Prune(start, end uint64) error
 }